@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func init() {
+	Commands["batch"] = BatchCommand
+}
+
+// MapIndex lists converted maps so the game doesn't have to guess file names.
+type MapIndex struct {
+	Maps []MapIndexEntry `json:"maps"`
+}
+
+type MapIndexEntry struct {
+	LevelNumber int      `json:"levelNumber"`
+	Name        string   `json:"name"` // what warp gates refer to the map by
+	File        string   `json:"file"`
+	Title       string   `json:"title"`
+	Width       uint     `json:"width"`
+	Height      uint     `json:"height"`
+	Warps       []string `json:"warps"` // names of the maps warp gates lead to
+}
+
+// MapName returns the name a level is known by in warp gates and file names.
+func MapName(levelNo int, title string) string {
+	if levelNo >= 1 && levelNo <= len(MapNames) {
+		return MapNames[levelNo-1]
+	}
+	return strings.Replace(title, " ", "_", -1)
+}
+
+func NewMapIndexEntry(m *JsonMap, file string) MapIndexEntry {
+	entry := MapIndexEntry{
+		LevelNumber: m.LevelNumber,
+		Name:        MapName(m.LevelNumber, m.Title),
+		File:        file,
+		Title:       m.Title,
+		Width:       m.Width,
+		Height:      m.Height,
+		Warps:       []string{},
+	}
+	seen := make(map[string]bool)
+	for _, e := range m.Entities {
+		if dest, ok := e.Value.(string); ok && e.Type == "WarpGate" && !seen[dest] {
+			entry.Warps = append(entry.Warps, dest)
+			seen[dest] = true
+		}
+	}
+	return entry
+}
+
+func BatchCommand(args []string) {
+	flags := flag.NewFlagSet("batch", flag.ExitOnError)
+	outdir := flags.String("o", "build/maps", "output directory")
+	indent := flags.Bool("indent", false, "indent JSON output")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	v1 := flags.Bool("v1", false, "write the version 1 format, which is limited to 52 kinds of tile")
+	overridesFile := flags.String("overrides", "", "JSON file of per-level settings to use instead of the defaults")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json batch [flags] dir")
+		fmt.Fprintln(flags.Output(), "Converts every .c3dmap in dir and writes index.json next to the maps.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	filenames, err := filepath.Glob(filepath.Join(flags.Arg(0), "*.c3dmap"))
+	if err != nil {
+		panic(err)
+	}
+	if len(filenames) == 0 {
+		panic(fmt.Errorf("no .c3dmap files in %v", flags.Arg(0)))
+	}
+	graphics, err := OpenEGAGraph(*assets)
+	if err != nil {
+		panic(err)
+	}
+	overrides := make(map[int]LevelOverride)
+	if *overridesFile != "" {
+		if overrides, err = ReadOverrides(*overridesFile); err != nil {
+			panic(err)
+		}
+	}
+	if err := os.MkdirAll(*outdir, 0755); err != nil {
+		panic(err)
+	}
+
+	var index MapIndex
+	levels := make(map[int]string)
+	for _, filename := range filenames {
+		m := ConvertFile(filename, graphics)
+		if other, ok := levels[m.LevelNumber]; ok {
+			panic(fmt.Errorf("%v and %v are both level %v", other, filename, m.LevelNumber))
+		}
+		levels[m.LevelNumber] = filename
+		overrides[m.LevelNumber].Apply(m)
+		if *v1 {
+			m.Version = 1
+		}
+
+		var out []byte
+		if *indent {
+			out, err = MarshalIndent(m)
+		} else {
+			out, err = json.Marshal(m)
+		}
+		if err != nil {
+			panic(fmt.Errorf("%v: %v", filename, err))
+		}
+		entry := NewMapIndexEntry(m, MapName(m.LevelNumber, m.Title)+".map.json")
+		err = ioutil.WriteFile(filepath.Join(*outdir, entry.File), append(out, '\n'), 0666)
+		if err != nil {
+			panic(err)
+		}
+		index.Maps = append(index.Maps, entry)
+	}
+	for levelNo := range overrides {
+		if _, ok := levels[levelNo]; !ok {
+			fmt.Fprintf(os.Stderr, "warning: %v has overrides for level %v, which isn't in %v\n", *overridesFile, levelNo, flags.Arg(0))
+		}
+	}
+	sort.Slice(index.Maps, func(i, j int) bool {
+		return index.Maps[i].LevelNumber < index.Maps[j].LevelNumber
+	})
+	if err := writeJSONFile(filepath.Join(*outdir, "index.json"), index); err != nil {
+		panic(err)
+	}
+	fmt.Printf("converted %v maps into %v\n", len(index.Maps), *outdir)
+}
@@ -2,5 +2,5 @@ var Catacomb3D=function(t){"use strict";function e(){}void 0===Number.EPSILON&&(
 //!\ DECLARE ALIAS AFTER assign prototype !
 Object.assign(_a.prototype,{beforeStart_:_a.prototype.copySampleValue_,afterEnd_:_a.prototype.copySampleValue_}),Ma.prototype=Object.assign(Object.create(_a.prototype),{constructor:Ma,interpolate_:function(t,e,i,n){for(var r=this.resultBuffer,a=this.sampleValues,o=this.valueSize,s=t*o,c=(i-e)/(n-e),h=s+o;s!==h;s+=4)B.slerpFlat(r,0,a,s-o,a,s,c);return r}}),Ea.prototype=Object.assign(Object.create(Ca.prototype),{constructor:Ea,ValueTypeName:"quaternion",DefaultInterpolation:P,InterpolantFactoryMethodLinear:function(t){return new Ma(this.times,this.values,this.getValueSize(),t)},InterpolantFactoryMethodSmooth:void 0}),Ta.prototype=Object.assign(Object.create(Ca.prototype),{constructor:Ta,ValueTypeName:"color"}),Sa.prototype=Object.assign(Object.create(Ca.prototype),{constructor:Sa,ValueTypeName:"number"}),Aa.prototype=Object.assign(Object.create(_a.prototype),{constructor:Aa,DefaultSettings_:{endingStart:O,endingEnd:O},intervalChanged_:function(t,e,i){var n=this.parameterPositions,r=t-2,a=t+1,o=n[r],s=n[a];if(void 0===o)switch(this.getSettings_().endingStart){case k:r=t,o=2*e-i;break;case I:o=e+n[r=n.length-2]-n[r+1];break;default:r=t,o=i}if(void 0===s)switch(this.getSettings_().endingEnd){case k:a=t,s=2*i-e;break;case I:a=1,s=i+n[1]-n[0];break;default:a=t-1,s=e}var c=.5*(i-e),h=this.valueSize;this._weightPrev=c/(e-o),this._weightNext=c/(s-i),this._offsetPrev=r*h,this._offsetNext=a*h},interpolate_:function(t,e,i,n){for(var r=this.resultBuffer,a=this.sampleValues,o=this.valueSize,s=t*o,c=s-o,h=this._offsetPrev,l=this._offsetNext,u=this._weightPrev,p=this._weightNext,d=(i-e)/(n-e),f=d*d,m=f*d,g=-u*m+2*u*f-u*d,v=(1+u)*m+(-1.5-2*u)*f+(-.5+u)*d+1,y=(-1-p)*m+(1.5+p)*f+.5*d,x=p*m-p*f,b=0;b!==o;++b)r[b]=g*a[h+b]+v*a[c+b]+y*a[s+b]+x*a[l+b];return r}}),La.prototype=Object.assign(Object.create(_a.prototype),{constructor:La,interpolate_:function(t,e,i,n){for(var r=this.resultBuffer,a=this.sampleValues,o=this.valueSize,s=t*o,c=s-o,h=(i-e)/(n-e),l=1-h,u=0;u!==o;++u)r[u]=a[c+u]*l+a[s+u]*h;return r}}),Ra.prototype=Object.assign(Object.create(_a.prototype),{constructor:Ra,interpolate_:function(t){return this.copySampleValue_(t-1)}});var Pa={arraySlice:function(t,e,i){return Pa.isTypedArray(t)?new t.constructor(t.subarray(e,void 0!==i?i:t.length)):t.slice(e,i)},convertArray:function(t,e,i){return!t||!i&&t.constructor===e?t:"number"==typeof e.BYTES_PER_ELEMENT?new e(t):Array.prototype.slice.call(t)},isTypedArray:function(t){return ArrayBuffer.isView(t)&&!(t instanceof DataView)},getKeyframeOrder:function(t){for(var e=t.length,i=new Array(e),n=0;n!==e;++n)i[n]=n;return i.sort((function(e,i){return t[e]-t[i]})),i},sortedArray:function(t,e,i){for(var n=t.length,r=new t.constructor(n),a=0,o=0;o!==n;++a)for(var s=i[a]*e,c=0;c!==e;++c)r[o++]=t[s+c];return r},flattenJSON:function(t,e,i,n){for(var r=1,a=t[0];void 0!==a&&void 0===a[n];)a=t[r++];if(void 0!==a){var o=a[n];if(void 0!==o)if(Array.isArray(o))do{void 0!==(o=a[n])&&(e.push(a.time),i.push.apply(i,o)),a=t[r++]}while(void 0!==a);else if(void 0!==o.toArray)do{void 0!==(o=a[n])&&(e.push(a.time),o.toArray(i,i.length)),a=t[r++]}while(void 0!==a);else do{void 0!==(o=a[n])&&(e.push(a.time),i.push(o)),a=t[r++]}while(void 0!==a)}}};function Ca(t,e,i,n){if(void 0===t)throw new Error("THREE.KeyframeTrack: track name is undefined");if(void 0===e||0===e.length)throw new Error("THREE.KeyframeTrack: no keyframes in track named "+t);this.name=t,this.times=Pa.convertArray(e,this.TimeBufferType),this.values=Pa.convertArray(i,this.ValueBufferType),this.setInterpolation(n||this.DefaultInterpolation),this.validate(),this.optimize()}function Oa(t,e,i,n){Ca.call(this,t,e,i,n)}function ka(t,e,i){this.name=t,this.tracks=i,this.duration=void 0!==e?e:-1,this.uuid=D.generateUUID(),this.duration<0&&this.resetDuration(),this.optimize()}function Ia(t){this.manager=void 0!==t?t:Dr,this.textures={}}function Na(t){this.manager=void 0!==t?t:Dr}Object.assign(Ca,{parse:function(t){if(void 0===t.type)throw new Error("THREE.KeyframeTrack: track type undefined, can not parse");var e=Ca._getTrackTypeForValueTypeName(t.type);if(void 0===t.times){var i=[],n=[];Pa.flattenJSON(t.keys,i,n,"value"),t.times=i,t.values=n}return void 0!==e.parse?e.parse(t):new e(t.name,t.times,t.values,t.interpolation)},toJSON:function(t){var e,i=t.constructor;if(void 0!==i.toJSON)e=i.toJSON(t);else{e={name:t.name,times:Pa.convertArray(t.times,Array),values:Pa.convertArray(t.values,Array)};var n=t.getInterpolation();n!==t.DefaultInterpolation&&(e.interpolation=n)}return e.type=t.ValueTypeName,e},_getTrackTypeForValueTypeName:function(t){switch(t.toLowerCase()){case"scalar":case"double":case"float":case"number":case"integer":return Sa;case"vector":case"vector2":case"vector3":case"vector4":return Oa;case"color":return Ta;case"quaternion":return Ea;case"bool":case"boolean":return wa;case"string":return ba}throw new Error("THREE.KeyframeTrack: Unsupported typeName: "+t)}}),Object.assign(Ca.prototype,{constructor:Ca,TimeBufferType:Float32Array,ValueBufferType:Float32Array,DefaultInterpolation:P,InterpolantFactoryMethodDiscrete:function(t){return new Ra(this.times,this.values,this.getValueSize(),t)},InterpolantFactoryMethodLinear:function(t){return new La(this.times,this.values,this.getValueSize(),t)},InterpolantFactoryMethodSmooth:function(t){return new Aa(this.times,this.values,this.getValueSize(),t)},setInterpolation:function(t){var e;switch(t){case R:e=this.InterpolantFactoryMethodDiscrete;break;case P:e=this.InterpolantFactoryMethodLinear;break;case C:e=this.InterpolantFactoryMethodSmooth}if(void 0!==e)this.createInterpolant=e;else{var i="unsupported interpolation for "+this.ValueTypeName+" keyframe track named "+this.name;if(void 0===this.createInterpolant){if(t===this.DefaultInterpolation)throw new Error(i);this.setInterpolation(this.DefaultInterpolation)}console.warn("THREE.KeyframeTrack:",i)}},getInterpolation:function(){switch(this.createInterpolant){case this.InterpolantFactoryMethodDiscrete:return R;case this.InterpolantFactoryMethodLinear:return P;case this.InterpolantFactoryMethodSmooth:return C}},getValueSize:function(){return this.values.length/this.times.length},shift:function(t){if(0!==t)for(var e=this.times,i=0,n=e.length;i!==n;++i)e[i]+=t;return this},scale:function(t){if(1!==t)for(var e=this.times,i=0,n=e.length;i!==n;++i)e[i]*=t;return this},trim:function(t,e){for(var i=this.times,n=i.length,r=0,a=n-1;r!==n&&i[r]<t;)++r;for(;-1!==a&&i[a]>e;)--a;if(++a,0!==r||a!==n){r>=a&&(r=(a=Math.max(a,1))-1);var o=this.getValueSize();this.times=Pa.arraySlice(i,r,a),this.values=Pa.arraySlice(this.values,r*o,a*o)}return this},validate:function(){var t=!0,e=this.getValueSize();e-Math.floor(e)!=0&&(console.error("THREE.KeyframeTrack: Invalid value size in track.",this),t=!1);var i=this.times,n=this.values,r=i.length;0===r&&(console.error("THREE.KeyframeTrack: Track is empty.",this),t=!1);for(var a=null,o=0;o!==r;o++){var s=i[o];if("number"==typeof s&&isNaN(s)){console.error("THREE.KeyframeTrack: Time is not a valid number.",this,o,s),t=!1;break}if(null!==a&&a>s){console.error("THREE.KeyframeTrack: Out of order keys.",this,o,s,a),t=!1;break}a=s}if(void 0!==n&&Pa.isTypedArray(n)){o=0;for(var c=n.length;o!==c;++o){var h=n[o];if(isNaN(h)){console.error("THREE.KeyframeTrack: Value is not a valid number.",this,o,h),t=!1;break}}}return t},optimize:function(){for(var t=this.times,e=this.values,i=this.getValueSize(),n=this.getInterpolation()===C,r=1,a=t.length-1,o=1;o<a;++o){var s=!1,c=t[o];if(c!==t[o+1]&&(1!==o||c!==c[0]))if(n)s=!0;else for(var h=o*i,l=h-i,u=h+i,p=0;p!==i;++p){var d=e[h+p];if(d!==e[l+p]||d!==e[u+p]){s=!0;break}}if(s){if(o!==r){t[r]=t[o];var f=o*i,m=r*i;for(p=0;p!==i;++p)e[m+p]=e[f+p]}++r}}if(a>0){t[r]=t[a];for(f=a*i,m=r*i,p=0;p!==i;++p)e[m+p]=e[f+p];++r}return r!==t.length&&(this.times=Pa.arraySlice(t,0,r),this.values=Pa.arraySlice(e,0,r*i)),this}}),Oa.prototype=Object.assign(Object.create(Ca.prototype),{constructor:Oa,ValueTypeName:"vector"}),Object.assign(ka,{parse:function(t){for(var e=[],i=t.tracks,n=1/(t.fps||1),r=0,a=i.length;r!==a;++r)e.push(Ca.parse(i[r]).scale(n));return new ka(t.name,t.duration,e)},toJSON:function(t){for(var e=[],i=t.tracks,n={name:t.name,duration:t.duration,tracks:e},r=0,a=i.length;r!==a;++r)e.push(Ca.toJSON(i[r]));return n},CreateFromMorphTargetSequence:function(t,e,i,n){for(var r=e.length,a=[],o=0;o<r;o++){var s=[],c=[];s.push((o+r-1)%r,o,(o+1)%r),c.push(0,1,0);var h=Pa.getKeyframeOrder(s);s=Pa.sortedArray(s,1,h),c=Pa.sortedArray(c,1,h),n||0!==s[0]||(s.push(r),c.push(c[0])),a.push(new Sa(".morphTargetInfluences["+e[o].name+"]",s,c).scale(1/i))}return new ka(t,-1,a)},findByName:function(t,e){var i=t;if(!Array.isArray(t)){var n=t;i=n.geometry&&n.geometry.animations||n.animations}for(var r=0;r<i.length;r++)if(i[r].name===e)return i[r];return null},CreateClipsFromMorphTargetSequences:function(t,e,i){for(var n={},r=/^([\w-]*?)([\d]+)$/,a=0,o=t.length;a<o;a++){var s=t[a],c=s.name.match(r);if(c&&c.length>1){var h=n[u=c[1]];h||(n[u]=h=[]),h.push(s)}}var l=[];for(var u in n)l.push(ka.CreateFromMorphTargetSequence(u,n[u],e,i));return l},parseAnimation:function(t,e){if(!t)return console.error("THREE.AnimationClip: No animation in JSONLoader data."),null;for(var i=function(t,e,i,n,r){if(0!==i.length){var a=[],o=[];Pa.flattenJSON(i,a,o,n),0!==a.length&&r.push(new t(e,a,o))}},n=[],r=t.name||"default",a=t.length||-1,o=t.fps||30,s=t.hierarchy||[],c=0;c<s.length;c++){var h=s[c].keys;if(h&&0!==h.length)if(h[0].morphTargets){for(var l={},u=0;u<h.length;u++)if(h[u].morphTargets)for(var p=0;p<h[u].morphTargets.length;p++)l[h[u].morphTargets[p]]=-1;for(var d in l){var f=[],m=[];for(p=0;p!==h[u].morphTargets.length;++p){var g=h[u];f.push(g.time),m.push(g.morphTarget===d?1:0)}n.push(new Sa(".morphTargetInfluence["+d+"]",f,m))}a=l.length*(o||1)}else{var v=".bones["+e[c].name+"]";i(Oa,v+".position",h,"pos",n),i(Ea,v+".quaternion",h,"rot",n),i(Oa,v+".scale",h,"scl",n)}}return 0===n.length?null:new ka(r,a,n)}}),Object.assign(ka.prototype,{resetDuration:function(){for(var t=0,e=0,i=this.tracks.length;e!==i;++e){var n=this.tracks[e];t=Math.max(t,n.times[n.times.length-1])}this.duration=t},trim:function(){for(var t=0;t<this.tracks.length;t++)this.tracks[t].trim(0,this.duration);return this},optimize:function(){for(var t=0;t<this.tracks.length;t++)this.tracks[t].optimize();return this}}),Object.assign(Ia.prototype,{load:function(t,e,i,n){var r=this;new zr(r.manager).load(t,(function(t){e(r.parse(JSON.parse(t)))}),i,n)},setTextures:function(t){this.textures=t},parse:function(t){var e=this.textures;function i(t){return void 0===e[t]&&console.warn("THREE.MaterialLoader: Undefined texture",t),e[t]}var n=new kr[t.type];if(void 0!==t.uuid&&(n.uuid=t.uuid),void 0!==t.name&&(n.name=t.name),void 0!==t.color&&n.color.setHex(t.color),void 0!==t.roughness&&(n.roughness=t.roughness),void 0!==t.metalness&&(n.metalness=t.metalness),void 0!==t.emissive&&n.emissive.setHex(t.emissive),void 0!==t.specular&&n.specular.setHex(t.specular),void 0!==t.shininess&&(n.shininess=t.shininess),void 0!==t.clearCoat&&(n.clearCoat=t.clearCoat),void 0!==t.clearCoatRoughness&&(n.clearCoatRoughness=t.clearCoatRoughness),void 0!==t.uniforms&&(n.uniforms=t.uniforms),void 0!==t.vertexShader&&(n.vertexShader=t.vertexShader),void 0!==t.fragmentShader&&(n.fragmentShader=t.fragmentShader),void 0!==t.vertexColors&&(n.vertexColors=t.vertexColors),void 0!==t.fog&&(n.fog=t.fog),void 0!==t.flatShading&&(n.flatShading=t.flatShading),void 0!==t.blending&&(n.blending=t.blending),void 0!==t.side&&(n.side=t.side),void 0!==t.opacity&&(n.opacity=t.opacity),void 0!==t.transparent&&(n.transparent=t.transparent),void 0!==t.alphaTest&&(n.alphaTest=t.alphaTest),void 0!==t.depthTest&&(n.depthTest=t.depthTest),void 0!==t.depthWrite&&(n.depthWrite=t.depthWrite),void 0!==t.colorWrite&&(n.colorWrite=t.colorWrite),void 0!==t.wireframe&&(n.wireframe=t.wireframe),void 0!==t.wireframeLinewidth&&(n.wireframeLinewidth=t.wireframeLinewidth),void 0!==t.wireframeLinecap&&(n.wireframeLinecap=t.wireframeLinecap),void 0!==t.wireframeLinejoin&&(n.wireframeLinejoin=t.wireframeLinejoin),void 0!==t.rotation&&(n.rotation=t.rotation),1!==t.linewidth&&(n.linewidth=t.linewidth),void 0!==t.dashSize&&(n.dashSize=t.dashSize),void 0!==t.gapSize&&(n.gapSize=t.gapSize),void 0!==t.scale&&(n.scale=t.scale),void 0!==t.polygonOffset&&(n.polygonOffset=t.polygonOffset),void 0!==t.polygonOffsetFactor&&(n.polygonOffsetFactor=t.polygonOffsetFactor),void 0!==t.polygonOffsetUnits&&(n.polygonOffsetUnits=t.polygonOffsetUnits),void 0!==t.skinning&&(n.skinning=t.skinning),void 0!==t.morphTargets&&(n.morphTargets=t.morphTargets),void 0!==t.dithering&&(n.dithering=t.dithering),void 0!==t.visible&&(n.visible=t.visible),void 0!==t.userData&&(n.userData=t.userData),void 0!==t.shading&&(n.flatShading=1===t.shading),void 0!==t.size&&(n.size=t.size),void 0!==t.sizeAttenuation&&(n.sizeAttenuation=t.sizeAttenuation),void 0!==t.map&&(n.map=i(t.map)),void 0!==t.alphaMap&&(n.alphaMap=i(t.alphaMap),n.transparent=!0),void 0!==t.bumpMap&&(n.bumpMap=i(t.bumpMap)),void 0!==t.bumpScale&&(n.bumpScale=t.bumpScale),void 0!==t.normalMap&&(n.normalMap=i(t.normalMap)),void 0!==t.normalScale){var r=t.normalScale;!1===Array.isArray(r)&&(r=[r,r]),n.normalScale=(new U).fromArray(r)}return void 0!==t.displacementMap&&(n.displacementMap=i(t.displacementMap)),void 0!==t.displacementScale&&(n.displacementScale=t.displacementScale),void 0!==t.displacementBias&&(n.displacementBias=t.displacementBias),void 0!==t.roughnessMap&&(n.roughnessMap=i(t.roughnessMap)),void 0!==t.metalnessMap&&(n.metalnessMap=i(t.metalnessMap)),void 0!==t.emissiveMap&&(n.emissiveMap=i(t.emissiveMap)),void 0!==t.emissiveIntensity&&(n.emissiveIntensity=t.emissiveIntensity),void 0!==t.specularMap&&(n.specularMap=i(t.specularMap)),void 0!==t.envMap&&(n.envMap=i(t.envMap)),void 0!==t.reflectivity&&(n.reflectivity=t.reflectivity),void 0!==t.lightMap&&(n.lightMap=i(t.lightMap)),void 0!==t.lightMapIntensity&&(n.lightMapIntensity=t.lightMapIntensity),void 0!==t.aoMap&&(n.aoMap=i(t.aoMap)),void 0!==t.aoMapIntensity&&(n.aoMapIntensity=t.aoMapIntensity),void 0!==t.gradientMap&&(n.gradientMap=i(t.gradientMap)),n}}),Object.assign(Na.prototype,{load:function(t,e,i,n){var r=this;new zr(r.manager).load(t,(function(t){e(r.parse(JSON.parse(t)))}),i,n)},parse:function(t){var e=new Gt,i=t.data.index;if(void 0!==i){var n=new Fa[i.type](i.array);e.setIndex(new Lt(n,1))}var r=t.data.attributes;for(var a in r){var o=r[a];n=new Fa[o.type](o.array);e.addAttribute(a,new Lt(n,o.itemSize,o.normalized))}var s=t.data.groups||t.data.drawcalls||t.data.offsets;if(void 0!==s)for(var c=0,h=s.length;c!==h;++c){var l=s[c];e.addGroup(l.start,l.count,l.materialIndex)}var u=t.data.boundingSphere;if(void 0!==u){var p=new F;void 0!==u.center&&p.fromArray(u.center),e.boundingSphere=new et(p,u.radius)}return e}});var Da,Ua,za,Ba,Fa={Int8Array:Int8Array,Uint8Array:Uint8Array,Uint8ClampedArray:"undefined"!=typeof Uint8ClampedArray?Uint8ClampedArray:Uint8Array,Int16Array:Int16Array,Uint16Array:Uint16Array,Int32Array:Int32Array,Uint32Array:Uint32Array,Float32Array:Float32Array,Float64Array:Float64Array};function Ga(){}Ga.Handlers={handlers:[],add:function(t,e){this.handlers.push(t,e)},get:function(t){for(var e=this.handlers,i=0,n=e.length;i<n;i+=2){var r=e[i],a=e[i+1];if(r.test(t))return a}return null}},Object.assign(Ga.prototype,{crossOrigin:void 0,onLoadStart:function(){},onLoadProgress:function(){},onLoadComplete:function(){},initMaterials:function(t,e,i){for(var n=[],r=0;r<t.length;++r)n[r]=this.createMaterial(t[r],e,i);return n},createMaterial:(Da={NoBlending:0,NormalBlending:1,AdditiveBlending:2,SubtractiveBlending:3,MultiplyBlending:4,CustomBlending:5},Ua=new ct,za=new Fr,Ba=new Ia,function(t,e,i){var n={};function r(t,r,a,o,s){var c,h=e+t,l=Ga.Handlers.get(h);null!==l?c=l.load(h):(za.setCrossOrigin(i),c=za.load(h)),void 0!==r&&(c.repeat.fromArray(r),1!==r[0]&&(c.wrapS=u),1!==r[1]&&(c.wrapT=u)),void 0!==a&&c.offset.fromArray(a),void 0!==o&&("repeat"===o[0]&&(c.wrapS=u),"mirror"===o[0]&&(c.wrapS=d),"repeat"===o[1]&&(c.wrapT=u),"mirror"===o[1]&&(c.wrapT=d)),void 0!==s&&(c.anisotropy=s);var p=D.generateUUID();return n[p]=c,p}var a={uuid:D.generateUUID(),type:"MeshLambertMaterial"};for(var o in t){var s=t[o];switch(o){case"DbgColor":case"DbgIndex":case"opticalDensity":case"illumination":break;case"DbgName":a.name=s;break;case"blending":a.blending=Da[s];break;case"colorAmbient":case"mapAmbient":console.warn("THREE.Loader.createMaterial:",o,"is no longer supported.");break;case"colorDiffuse":a.color=Ua.fromArray(s).getHex();break;case"colorSpecular":a.specular=Ua.fromArray(s).getHex();break;case"colorEmissive":a.emissive=Ua.fromArray(s).getHex();break;case"specularCoef":a.shininess=s;break;case"shading":"basic"===s.toLowerCase()&&(a.type="MeshBasicMaterial"),"phong"===s.toLowerCase()&&(a.type="MeshPhongMaterial"),"standard"===s.toLowerCase()&&(a.type="MeshStandardMaterial");break;case"mapDiffuse":a.map=r(s,t.mapDiffuseRepeat,t.mapDiffuseOffset,t.mapDiffuseWrap,t.mapDiffuseAnisotropy);break;case"mapDiffuseRepeat":case"mapDiffuseOffset":case"mapDiffuseWrap":case"mapDiffuseAnisotropy":break;case"mapEmissive":a.emissiveMap=r(s,t.mapEmissiveRepeat,t.mapEmissiveOffset,t.mapEmissiveWrap,t.mapEmissiveAnisotropy);break;case"mapEmissiveRepeat":case"mapEmissiveOffset":case"mapEmissiveWrap":case"mapEmissiveAnisotropy":break;case"mapLight":a.lightMap=r(s,t.mapLightRepeat,t.mapLightOffset,t.mapLightWrap,t.mapLightAnisotropy);break;case"mapLightRepeat":case"mapLightOffset":case"mapLightWrap":case"mapLightAnisotropy":break;case"mapAO":a.aoMap=r(s,t.mapAORepeat,t.mapAOOffset,t.mapAOWrap,t.mapAOAnisotropy);break;case"mapAORepeat":case"mapAOOffset":case"mapAOWrap":case"mapAOAnisotropy":break;case"mapBump":a.bumpMap=r(s,t.mapBumpRepeat,t.mapBumpOffset,t.mapBumpWrap,t.mapBumpAnisotropy);break;case"mapBumpScale":a.bumpScale=s;break;case"mapBumpRepeat":case"mapBumpOffset":case"mapBumpWrap":case"mapBumpAnisotropy":break;case"mapNormal":a.normalMap=r(s,t.mapNormalRepeat,t.mapNormalOffset,t.mapNormalWrap,t.mapNormalAnisotropy);break;case"mapNormalFactor":a.normalScale=s;break;case"mapNormalRepeat":case"mapNormalOffset":case"mapNormalWrap":case"mapNormalAnisotropy":break;case"mapSpecular":a.specularMap=r(s,t.mapSpecularRepeat,t.mapSpecularOffset,t.mapSpecularWrap,t.mapSpecularAnisotropy);break;case"mapSpecularRepeat":case"mapSpecularOffset":case"mapSpecularWrap":case"mapSpecularAnisotropy":break;case"mapMetalness":a.metalnessMap=r(s,t.mapMetalnessRepeat,t.mapMetalnessOffset,t.mapMetalnessWrap,t.mapMetalnessAnisotropy);break;case"mapMetalnessRepeat":case"mapMetalnessOffset":case"mapMetalnessWrap":case"mapMetalnessAnisotropy":break;case"mapRoughness":a.roughnessMap=r(s,t.mapRoughnessRepeat,t.mapRoughnessOffset,t.mapRoughnessWrap,t.mapRoughnessAnisotropy);break;case"mapRoughnessRepeat":case"mapRoughnessOffset":case"mapRoughnessWrap":case"mapRoughnessAnisotropy":break;case"mapAlpha":a.alphaMap=r(s,t.mapAlphaRepeat,t.mapAlphaOffset,t.mapAlphaWrap,t.mapAlphaAnisotropy);break;case"mapAlphaRepeat":case"mapAlphaOffset":case"mapAlphaWrap":case"mapAlphaAnisotropy":break;case"flipSided":a.side=1;break;case"doubleSided":a.side=2;break;case"transparency":console.warn("THREE.Loader.createMaterial: transparency has been renamed to opacity"),a.opacity=s;break;case"depthTest":case"depthWrite":case"colorWrite":case"opacity":case"reflectivity":case"transparent":case"visible":case"wireframe":a[o]=s;break;case"vertexColors":!0===s&&(a.vertexColors=2),"face"===s&&(a.vertexColors=1);break;default:console.error("THREE.Loader.createMaterial: Unsupported",o,s)}}return"MeshBasicMaterial"===a.type&&delete a.emissive,"MeshPhongMaterial"!==a.type&&delete a.specular,a.opacity<1&&(a.transparent=!0),Ba.setTextures(n),Ba.parse(a)})});var Ha=function(t){var e=t.lastIndexOf("/");return-1===e?"./":t.substr(0,e+1)};function Va(t){"boolean"==typeof t&&(console.warn("THREE.JSONLoader: showStatus parameter has been removed from constructor."),t=void 0),this.manager=void 0!==t?t:Dr,this.withCredentials=!1}Object.assign(Va.prototype,{load:function(t,e,i,n){var r=this,a=this.texturePath&&"string"==typeof this.texturePath?this.texturePath:Ha(t),o=new zr(this.manager);o.setWithCredentials(this.withCredentials),o.load(t,(function(i){var n=JSON.parse(i),o=n.metadata;if(void 0!==o){var s=o.type;if(void 0!==s&&"object"===s.toLowerCase())return void console.error("THREE.JSONLoader: "+t+" should be loaded with THREE.ObjectLoader instead.")}var c=r.parse(n,a);e(c.geometry,c.materials)}),i,n)},setTexturePath:function(t){this.texturePath=t},parse:function(t,e){void 0!==t.data&&(t=t.data),void 0!==t.scale?t.scale=1/t.scale:t.scale=1;var i=new At;return function(t,e){function i(t,e){return t&1<<e}var n,r,a,o,s,c,h,l,u,p,d,f,m,g,v,y,x,b,w,_,M,E,T,S,A,L=t.faces,R=t.vertices,P=t.normals,C=t.colors,O=t.scale,k=0;if(void 0!==t.uvs){for(n=0;n<t.uvs.length;n++)t.uvs[n].length&&k++;for(n=0;n<k;n++)e.faceVertexUvs[n]=[]}for(o=0,s=R.length;o<s;)(b=new F).x=R[o++]*O,b.y=R[o++]*O,b.z=R[o++]*O,e.vertices.push(b);for(o=0,s=L.length;o<s;)if(d=i(p=L[o++],0),f=i(p,1),m=i(p,3),g=i(p,4),v=i(p,5),y=i(p,6),x=i(p,7),d){if((_=new Mt).a=L[o],_.b=L[o+1],_.c=L[o+3],(M=new Mt).a=L[o+1],M.b=L[o+2],M.c=L[o+3],o+=4,f&&(u=L[o++],_.materialIndex=u,M.materialIndex=u),a=e.faces.length,m)for(n=0;n<k;n++)for(S=t.uvs[n],e.faceVertexUvs[n][a]=[],e.faceVertexUvs[n][a+1]=[],r=0;r<4;r++)A=new U(S[2*(l=L[o++])],S[2*l+1]),2!==r&&e.faceVertexUvs[n][a].push(A),0!==r&&e.faceVertexUvs[n][a+1].push(A);if(g&&(h=3*L[o++],_.normal.set(P[h++],P[h++],P[h]),M.normal.copy(_.normal)),v)for(n=0;n<4;n++)h=3*L[o++],T=new F(P[h++],P[h++],P[h]),2!==n&&_.vertexNormals.push(T),0!==n&&M.vertexNormals.push(T);if(y&&(E=C[c=L[o++]],_.color.setHex(E),M.color.setHex(E)),x)for(n=0;n<4;n++)E=C[c=L[o++]],2!==n&&_.vertexColors.push(new ct(E)),0!==n&&M.vertexColors.push(new ct(E));e.faces.push(_),e.faces.push(M)}else{if((w=new Mt).a=L[o++],w.b=L[o++],w.c=L[o++],f&&(u=L[o++],w.materialIndex=u),a=e.faces.length,m)for(n=0;n<k;n++)for(S=t.uvs[n],e.faceVertexUvs[n][a]=[],r=0;r<3;r++)A=new U(S[2*(l=L[o++])],S[2*l+1]),e.faceVertexUvs[n][a].push(A);if(g&&(h=3*L[o++],w.normal.set(P[h++],P[h++],P[h])),v)for(n=0;n<3;n++)h=3*L[o++],T=new F(P[h++],P[h++],P[h]),w.vertexNormals.push(T);if(y&&(c=L[o++],w.color.setHex(C[c])),x)for(n=0;n<3;n++)c=L[o++],w.vertexColors.push(new ct(C[c]));e.faces.push(w)}}(t,i),function(t,e){var i=void 0!==t.influencesPerVertex?t.influencesPerVertex:2;if(t.skinWeights)for(var n=0,r=t.skinWeights.length;n<r;n+=i){var a=t.skinWeights[n],o=i>1?t.skinWeights[n+1]:0,s=i>2?t.skinWeights[n+2]:0,c=i>3?t.skinWeights[n+3]:0;e.skinWeights.push(new J(a,o,s,c))}if(t.skinIndices)for(n=0,r=t.skinIndices.length;n<r;n+=i){var h=t.skinIndices[n],l=i>1?t.skinIndices[n+1]:0,u=i>2?t.skinIndices[n+2]:0,p=i>3?t.skinIndices[n+3]:0;e.skinIndices.push(new J(h,l,u,p))}e.bones=t.bones,e.bones&&e.bones.length>0&&(e.skinWeights.length!==e.skinIndices.length||e.skinIndices.length!==e.vertices.length)&&console.warn("When skinning, number of vertices ("+e.vertices.length+"), skinIndices ("+e.skinIndices.length+"), and skinWeights ("+e.skinWeights.length+") should match.")}(t,i),function(t,e){var i=t.scale;if(void 0!==t.morphTargets)for(var n=0,r=t.morphTargets.length;n<r;n++){e.morphTargets[n]={},e.morphTargets[n].name=t.morphTargets[n].name,e.morphTargets[n].vertices=[];for(var a=e.morphTargets[n].vertices,o=t.morphTargets[n].vertices,s=0,c=o.length;s<c;s+=3){var h=new F;h.x=o[s]*i,h.y=o[s+1]*i,h.z=o[s+2]*i,a.push(h)}}if(void 0!==t.morphColors&&t.morphColors.length>0){console.warn('THREE.JSONLoader: "morphColors" no longer supported. Using them as face colors.');var l=e.faces,u=t.morphColors[0].colors;for(n=0,r=l.length;n<r;n++)l[n].color.fromArray(u,3*n)}}(t,i),function(t,e){var i=[],n=[];void 0!==t.animation&&n.push(t.animation),void 0!==t.animations&&(t.animations.length?n=n.concat(t.animations):n.push(t.animations));for(var r=0;r<n.length;r++){var a=ka.parseAnimation(n[r],e.bones);a&&i.push(a)}if(e.morphTargets){var o=ka.CreateClipsFromMorphTargetSequences(e.morphTargets,10);i=i.concat(o)}i.length>0&&(e.animations=i)}(t,i),i.computeFaceNormals(),i.computeBoundingSphere(),void 0===t.materials||0===t.materials.length?{geometry:i}:{geometry:i,materials:Ga.prototype.initMaterials(t.materials,e,this.crossOrigin)}}}),Object.assign(function(t){this.manager=void 0!==t?t:Dr,this.texturePath=""}.prototype,{load:function(t,e,i,n){""===this.texturePath&&(this.texturePath=t.substring(0,t.lastIndexOf("/")+1));var r=this;new zr(r.manager).load(t,(function(i){var a=null;try{a=JSON.parse(i)}catch(e){return void 0!==n&&n(e),void console.error("THREE:ObjectLoader: Can't parse "+t+".",e.message)}var o=a.metadata;void 0!==o&&void 0!==o.type&&"geometry"!==o.type.toLowerCase()?r.parse(a,e):console.error("THREE.ObjectLoader: Can't load "+t+". Use THREE.JSONLoader instead.")}),i,n)},setTexturePath:function(t){this.texturePath=t},setCrossOrigin:function(t){this.crossOrigin=t},parse:function(t,e){var i=this.parseShape(t.shapes),n=this.parseGeometries(t.geometries,i),r=this.parseImages(t.images,(function(){void 0!==e&&e(s)})),a=this.parseTextures(t.textures,r),o=this.parseMaterials(t.materials,a),s=this.parseObject(t.object,n,o);return t.animations&&(s.animations=this.parseAnimations(t.animations)),void 0!==t.images&&0!==t.images.length||void 0!==e&&e(s),s},parseShape:function(t){var e={};if(void 0!==t)for(var i=0,n=t.length;i<n;i++){var r=(new ha).fromJSON(t[i]);e[r.uuid]=r}return e},parseGeometries:function(t,e){var i={};if(void 0!==t)for(var n=new Va,r=new Na,a=0,o=t.length;a<o;a++){var s,c=t[a];switch(c.type){case"PlaneGeometry":case"PlaneBufferGeometry":s=new Mr[c.type](c.width,c.height,c.widthSegments,c.heightSegments);break;case"BoxGeometry":case"BoxBufferGeometry":case"CubeGeometry":s=new Mr[c.type](c.width,c.height,c.depth,c.widthSegments,c.heightSegments,c.depthSegments);break;case"CircleGeometry":case"CircleBufferGeometry":s=new Mr[c.type](c.radius,c.segments,c.thetaStart,c.thetaLength);break;case"CylinderGeometry":case"CylinderBufferGeometry":s=new Mr[c.type](c.radiusTop,c.radiusBottom,c.height,c.radialSegments,c.heightSegments,c.openEnded,c.thetaStart,c.thetaLength);break;case"ConeGeometry":case"ConeBufferGeometry":s=new Mr[c.type](c.radius,c.height,c.radialSegments,c.heightSegments,c.openEnded,c.thetaStart,c.thetaLength);break;case"SphereGeometry":case"SphereBufferGeometry":s=new Mr[c.type](c.radius,c.widthSegments,c.heightSegments,c.phiStart,c.phiLength,c.thetaStart,c.thetaLength);break;case"DodecahedronGeometry":case"DodecahedronBufferGeometry":case"IcosahedronGeometry":case"IcosahedronBufferGeometry":case"OctahedronGeometry":case"OctahedronBufferGeometry":case"TetrahedronGeometry":case"TetrahedronBufferGeometry":s=new Mr[c.type](c.radius,c.detail);break;case"RingGeometry":case"RingBufferGeometry":s=new Mr[c.type](c.innerRadius,c.outerRadius,c.thetaSegments,c.phiSegments,c.thetaStart,c.thetaLength);break;case"TorusGeometry":case"TorusBufferGeometry":s=new Mr[c.type](c.radius,c.tube,c.radialSegments,c.tubularSegments,c.arc);break;case"TorusKnotGeometry":case"TorusKnotBufferGeometry":s=new Mr[c.type](c.radius,c.tube,c.tubularSegments,c.radialSegments,c.p,c.q);break;case"LatheGeometry":case"LatheBufferGeometry":s=new Mr[c.type](c.points,c.segments,c.phiStart,c.phiLength);break;case"PolyhedronGeometry":case"PolyhedronBufferGeometry":s=new Mr[c.type](c.vertices,c.indices,c.radius,c.details);break;case"ShapeGeometry":case"ShapeBufferGeometry":for(var h=[],l=0,u=c.shapes.length;l<u;l++){var p=e[c.shapes[l]];h.push(p)}s=new Mr[c.type](h,c.curveSegments);break;case"BufferGeometry":s=r.parse(c);break;case"Geometry":s=n.parse(c,this.texturePath).geometry;break;default:console.warn('THREE.ObjectLoader: Unsupported geometry type "'+c.type+'"');continue}s.uuid=c.uuid,void 0!==c.name&&(s.name=c.name),i[c.uuid]=s}return i},parseMaterials:function(t,e){var i={};if(void 0!==t){var n=new Ia;n.setTextures(e);for(var r=0,a=t.length;r<a;r++){var o=t[r];if("MultiMaterial"===o.type){for(var s=[],c=0;c<o.materials.length;c++)s.push(n.parse(o.materials[c]));i[o.uuid]=s}else i[o.uuid]=n.parse(o)}}return i},parseAnimations:function(t){for(var e=[],i=0;i<t.length;i++){var n=ka.parse(t[i]);e.push(n)}return e},parseImages:function(t,e){var i=this,n={};function r(t){return i.manager.itemStart(t),a.load(t,(function(){i.manager.itemEnd(t)}),void 0,(function(){i.manager.itemEnd(t),i.manager.itemError(t)}))}if(void 0!==t&&t.length>0){var a=new Br(new Nr(e));a.setCrossOrigin(this.crossOrigin);for(var o=0,s=t.length;o<s;o++){var c=t[o],h=/^(\/\/)|([a-z]+:(\/\/)?)/i.test(c.url)?c.url:i.texturePath+c.url;n[c.uuid]=r(h)}}return n},parseTextures:function(t,e){function i(t,e){return"number"==typeof t?t:(console.warn("THREE.ObjectLoader.parseTexture: Constant should be in numeric form.",t),e[t])}var n={};if(void 0!==t)for(var r=0,a=t.length;r<a;r++){var o=t[r];void 0===o.image&&console.warn('THREE.ObjectLoader: No "image" specified for',o.uuid),void 0===e[o.image]&&console.warn("THREE.ObjectLoader: Undefined image",o.image);var s=new Z(e[o.image]);s.needsUpdate=!0,s.uuid=o.uuid,void 0!==o.name&&(s.name=o.name),void 0!==o.mapping&&(s.mapping=i(o.mapping,Wa)),void 0!==o.offset&&s.offset.fromArray(o.offset),void 0!==o.repeat&&s.repeat.fromArray(o.repeat),void 0!==o.center&&s.center.fromArray(o.center),void 0!==o.rotation&&(s.rotation=o.rotation),void 0!==o.wrap&&(s.wrapS=i(o.wrap[0],Xa),s.wrapT=i(o.wrap[1],Xa)),void 0!==o.format&&(s.format=o.format),void 0!==o.minFilter&&(s.minFilter=i(o.minFilter,qa)),void 0!==o.magFilter&&(s.magFilter=i(o.magFilter,qa)),void 0!==o.anisotropy&&(s.anisotropy=o.anisotropy),void 0!==o.flipY&&(s.flipY=o.flipY),n[o.uuid]=s}return n},parseObject:function(t,e,i){var n;function r(t){return void 0===e[t]&&console.warn("THREE.ObjectLoader: Undefined geometry",t),e[t]}function a(t){if(void 0!==t){if(Array.isArray(t)){for(var e=[],n=0,r=t.length;n<r;n++){var a=t[n];void 0===i[a]&&console.warn("THREE.ObjectLoader: Undefined material",a),e.push(i[a])}return e}return void 0===i[t]&&console.warn("THREE.ObjectLoader: Undefined material",t),i[t]}}switch(t.type){case"Scene":n=new Yi,void 0!==t.background&&Number.isInteger(t.background)&&(n.background=new ct(t.background)),void 0!==t.fog&&("Fog"===t.fog.type?n.fog=new qi(t.fog.color,t.fog.near,t.fog.far):"FogExp2"===t.fog.type&&(n.fog=new Xi(t.fog.color,t.fog.density)));break;case"PerspectiveCamera":n=new Hi(t.fov,t.aspect,t.near,t.far),void 0!==t.focus&&(n.focus=t.focus),void 0!==t.zoom&&(n.zoom=t.zoom),void 0!==t.filmGauge&&(n.filmGauge=t.filmGauge),void 0!==t.filmOffset&&(n.filmOffset=t.filmOffset),void 0!==t.view&&(n.view=Object.assign({},t.view));break;case"OrthographicCamera":n=new _t(t.left,t.right,t.top,t.bottom,t.near,t.far),void 0!==t.zoom&&(n.zoom=t.zoom),void 0!==t.view&&(n.view=Object.assign({},t.view));break;case"AmbientLight":n=new ya(t.color,t.intensity);break;case"DirectionalLight":n=new va(t.color,t.intensity);break;case"PointLight":n=new ma(t.color,t.intensity,t.distance,t.decay);break;case"RectAreaLight":n=new xa(t.color,t.intensity,t.width,t.height);break;case"SpotLight":n=new fa(t.color,t.intensity,t.distance,t.angle,t.penumbra,t.decay);break;case"HemisphereLight":n=new ua(t.color,t.groundColor,t.intensity);break;case"SkinnedMesh":console.warn("THREE.ObjectLoader.parseObject() does not support SkinnedMesh yet.");case"Mesh":var o=r(t.geometry),s=a(t.material);n=o.bones&&o.bones.length>0?new tn(o,s):new oe(o,s);break;case"LOD":n=new Qi;break;case"Line":n=new nn(r(t.geometry),a(t.material),t.mode);break;case"LineLoop":n=new an(r(t.geometry),a(t.material));break;case"LineSegments":n=new rn(r(t.geometry),a(t.material));break;case"PointCloud":case"Points":n=new sn(r(t.geometry),a(t.material));break;case"Sprite":n=new Ji(a(t.material));break;case"Group":n=new cn;break;default:n=new bt}if(n.uuid=t.uuid,void 0!==t.name&&(n.name=t.name),void 0!==t.matrix?(n.matrix.fromArray(t.matrix),void 0!==t.matrixAutoUpdate&&(n.matrixAutoUpdate=t.matrixAutoUpdate),n.matrixAutoUpdate&&n.matrix.decompose(n.position,n.quaternion,n.scale)):(void 0!==t.position&&n.position.fromArray(t.position),void 0!==t.rotation&&n.rotation.fromArray(t.rotation),void 0!==t.quaternion&&n.quaternion.fromArray(t.quaternion),void 0!==t.scale&&n.scale.fromArray(t.scale)),void 0!==t.castShadow&&(n.castShadow=t.castShadow),void 0!==t.receiveShadow&&(n.receiveShadow=t.receiveShadow),t.shadow&&(void 0!==t.shadow.bias&&(n.shadow.bias=t.shadow.bias),void 0!==t.shadow.radius&&(n.shadow.radius=t.shadow.radius),void 0!==t.shadow.mapSize&&n.shadow.mapSize.fromArray(t.shadow.mapSize),void 0!==t.shadow.camera&&(n.shadow.camera=this.parseObject(t.shadow.camera))),void 0!==t.visible&&(n.visible=t.visible),void 0!==t.frustumCulled&&(n.frustumCulled=t.frustumCulled),void 0!==t.renderOrder&&(n.renderOrder=t.renderOrder),void 0!==t.userData&&(n.userData=t.userData),void 0!==t.children)for(var c=t.children,h=0;h<c.length;h++)n.add(this.parseObject(c[h],e,i));if("LOD"===t.type)for(var l=t.levels,u=0;u<l.length;u++){var p=l[u],d=n.getObjectByProperty("uuid",p.object);void 0!==d&&n.addLevel(d,p.distance)}return n}});var ja,Wa={UVMapping:300,CubeReflectionMapping:301,CubeRefractionMapping:302,EquirectangularReflectionMapping:303,EquirectangularRefractionMapping:304,SphericalReflectionMapping:305,CubeUVReflectionMapping:306,CubeUVRefractionMapping:307},Xa={RepeatWrapping:u,ClampToEdgeWrapping:p,MirroredRepeatWrapping:d},qa={NearestFilter:f,NearestMipMapNearestFilter:m,NearestMipMapLinearFilter:g,LinearFilter:v,LinearMipMapNearestFilter:1007,LinearMipMapLinearFilter:y};function Ya(){this.type="ShapePath",this.color=new ct,this.subPaths=[],this.currentPath=null}function Za(t){this.type="Font",this.data=t}function Ja(t,e,i,n,r,a){var o=a.glyphs[t]||a.glyphs["?"];if(o){var s,c,h,l,u,p,d,f,m=new Ya;if(o.o)for(var g=o._cachedOutline||(o._cachedOutline=o.o.split(" ")),v=0,y=g.length;v<y;){switch(g[v++]){case"m":s=g[v++]*i+n,c=g[v++]*i+r,m.moveTo(s,c);break;case"l":s=g[v++]*i+n,c=g[v++]*i+r,m.lineTo(s,c);break;case"q":h=g[v++]*i+n,l=g[v++]*i+r,u=g[v++]*i+n,p=g[v++]*i+r,m.quadraticCurveTo(u,p,h,l);break;case"b":h=g[v++]*i+n,l=g[v++]*i+r,u=g[v++]*i+n,p=g[v++]*i+r,d=g[v++]*i+n,f=g[v++]*i+r,m.bezierCurveTo(u,p,d,f,h,l)}}return{offsetX:o.ha*i,path:m}}}Object.assign(Ya.prototype,{moveTo:function(t,e){this.currentPath=new ca,this.subPaths.push(this.currentPath),this.currentPath.moveTo(t,e)},lineTo:function(t,e){this.currentPath.lineTo(t,e)},quadraticCurveTo:function(t,e,i,n){this.currentPath.quadraticCurveTo(t,e,i,n)},bezierCurveTo:function(t,e,i,n,r,a){this.currentPath.bezierCurveTo(t,e,i,n,r,a)},splineThru:function(t){this.currentPath.splineThru(t)},toShapes:function(t,e){function i(t){for(var e=[],i=0,n=t.length;i<n;i++){var r=t[i],a=new ha;a.curves=r.curves,e.push(a)}return e}function n(t,e){for(var i=e.length,n=!1,r=i-1,a=0;a<i;r=a++){var o=e[r],s=e[a],c=s.x-o.x,h=s.y-o.y;if(Math.abs(h)>Number.EPSILON){if(h<0&&(o=e[a],c=-c,s=e[r],h=-h),t.y<o.y||t.y>s.y)continue;if(t.y===o.y){if(t.x===o.x)return!0}else{var l=h*(t.x-o.x)-c*(t.y-o.y);if(0===l)return!0;if(l<0)continue;n=!n}}else{if(t.y!==o.y)continue;if(s.x<=t.x&&t.x<=o.x||o.x<=t.x&&t.x<=s.x)return!0}}return n}var r=$n.isClockWise,a=this.subPaths;if(0===a.length)return[];if(!0===e)return i(a);var o,s,c,h=[];if(1===a.length)return s=a[0],(c=new ha).curves=s.curves,h.push(c),h;var l=!r(a[0].getPoints());l=t?!l:l;var u,p,d=[],f=[],m=[],g=0;f[g]=void 0,m[g]=[];for(var v=0,y=a.length;v<y;v++)o=r(u=(s=a[v]).getPoints()),(o=t?!o:o)?(!l&&f[g]&&g++,f[g]={s:new ha,p:u},f[g].s.curves=s.curves,l&&g++,m[g]=[]):m[g].push({h:s,p:u[0]});if(!f[0])return i(a);if(f.length>1){for(var x=!1,b=[],w=0,_=f.length;w<_;w++)d[w]=[];for(w=0,_=f.length;w<_;w++)for(var M=m[w],E=0;E<M.length;E++){for(var T=M[E],S=!0,A=0;A<f.length;A++)n(T.p,f[A].p)&&(w!==A&&b.push({froms:w,tos:A,hole:E}),S?(S=!1,d[A].push(T)):x=!0);S&&d[w].push(T)}b.length>0&&(x||(m=d))}v=0;for(var L=f.length;v<L;v++){c=f[v].s,h.push(c);for(var R=0,P=(p=m[v]).length;R<P;R++)c.holes.push(p[R].h)}return h}}),Object.assign(Za.prototype,{isFont:!0,generateShapes:function(t,e,i){void 0===e&&(e=100),void 0===i&&(i=4);for(var n=[],r=function(t,e,i,n){for(var r=String(t).split(""),a=e/n.resolution,o=(n.boundingBox.yMax-n.boundingBox.yMin+n.underlineThickness)*a,s=[],c=0,h=0,l=0;l<r.length;l++){var u=r[l];if("\n"===u)c=0,h-=o;else{var p=Ja(u,i,a,c,h,n);c+=p.offsetX,s.push(p.path)}}return s}(t,e,i,this.data),a=0,o=r.length;a<o;a++)Array.prototype.push.apply(n,r[a].toShapes());return n}}),Object.assign(function(t){this.manager=void 0!==t?t:Dr}.prototype,{load:function(t,e,i,n){var r=this,a=new zr(this.manager);a.setPath(this.path),a.load(t,(function(t){var i;try{i=JSON.parse(t)}catch(e){console.warn("THREE.FontLoader: typeface.js support is being deprecated. Use typeface.json instead."),i=JSON.parse(t.substring(65,t.length-2))}var n=r.parse(i);e&&e(n)}),i,n)},parse:function(t){return new Za(t)},setPath:function(t){return this.path=t,this}});var Qa,Ka,$a,to,eo,io,no,ro,ao,oo,so=function(){return void 0===ja&&(ja=new(window.AudioContext||window.webkitAudioContext)),ja};function co(t){this.manager=void 0!==t?t:Dr}function ho(t,e,i){bt.call(this),this.type="CubeCamera";var n=90,r=new Hi(n,1,t,e);r.up.set(0,-1,0),r.lookAt(new F(1,0,0)),this.add(r);var a=new Hi(n,1,t,e);a.up.set(0,-1,0),a.lookAt(new F(-1,0,0)),this.add(a);var o=new Hi(n,1,t,e);o.up.set(0,0,1),o.lookAt(new F(0,1,0)),this.add(o);var s=new Hi(n,1,t,e);s.up.set(0,0,-1),s.lookAt(new F(0,-1,0)),this.add(s);var c=new Hi(n,1,t,e);c.up.set(0,-1,0),c.lookAt(new F(0,0,1)),this.add(c);var h=new Hi(n,1,t,e);h.up.set(0,-1,0),h.lookAt(new F(0,0,-1)),this.add(h);var l={format:T,magFilter:v,minFilter:v};this.renderTarget=new K(i,i,l),this.renderTarget.texture.name="CubeCamera",this.update=function(t,e){null===this.parent&&this.updateMatrixWorld();var i=this.renderTarget,n=i.texture.generateMipmaps;i.texture.generateMipmaps=!1,i.activeCubeFace=0,t.render(e,r,i),i.activeCubeFace=1,t.render(e,a,i),i.activeCubeFace=2,t.render(e,o,i),i.activeCubeFace=3,t.render(e,s,i),i.activeCubeFace=4,t.render(e,c,i),i.texture.generateMipmaps=n,i.activeCubeFace=5,t.render(e,h,i),t.setRenderTarget(null)},this.clear=function(t,e,i,n){for(var r=this.renderTarget,a=0;a<6;a++)r.activeCubeFace=a,t.setRenderTarget(r),t.clear(e,i,n);t.setRenderTarget(null)}}function lo(){bt.call(this),this.type="AudioListener",this.context=so(),this.gain=this.context.createGain(),this.gain.connect(this.context.destination),this.filter=null}function uo(t){bt.call(this),this.type="Audio",this.context=t.context,this.gain=this.context.createGain(),this.gain.connect(t.getInput()),this.autoplay=!1,this.buffer=null,this.loop=!1,this.startTime=0,this.offset=0,this.playbackRate=1,this.isPlaying=!1,this.hasPlaybackControl=!0,this.sourceType="empty",this.filters=[]}function po(t){uo.call(this,t),this.panner=this.context.createPanner(),this.panner.connect(this.gain)}function fo(t,e){this.analyser=t.context.createAnalyser(),this.analyser.fftSize=void 0!==e?e:2048,this.data=new Uint8Array(this.analyser.frequencyBinCount),t.getOutput().connect(this.analyser)}function mo(t,e,i){this.binding=t,this.valueSize=i;var n,r=Float64Array;switch(e){case"quaternion":n=this._slerp;break;case"string":case"bool":r=Array,n=this._select;break;default:n=this._lerp}this.buffer=new r(4*i),this._mixBufferRegion=n,this.cumulativeWeight=0,this.useCount=0,this.referenceCount=0}Object.assign(co.prototype,{load:function(t,e,i,n){var r=new zr(this.manager);r.setResponseType("arraybuffer"),r.load(t,(function(t){so().decodeAudioData(t,(function(t){e(t)}))}),i,n)}}),Object.assign(function(){this.type="StereoCamera",this.aspect=1,this.eyeSep=.064,this.cameraL=new Hi,this.cameraL.layers.enable(1),this.cameraL.matrixAutoUpdate=!1,this.cameraR=new Hi,this.cameraR.layers.enable(2),this.cameraR.matrixAutoUpdate=!1}.prototype,{update:(ao=new z,oo=new z,function(t){if(Qa!==this||Ka!==t.focus||$a!==t.fov||to!==t.aspect*this.aspect||eo!==t.near||io!==t.far||no!==t.zoom||ro!==this.eyeSep){Qa=this,Ka=t.focus,$a=t.fov,to=t.aspect*this.aspect,eo=t.near,io=t.far,no=t.zoom;var e,i,n=t.projectionMatrix.clone(),r=(ro=this.eyeSep/2)*eo/Ka,a=eo*Math.tan(D.DEG2RAD*$a*.5)/no;oo.elements[12]=-ro,ao.elements[12]=ro,e=-a*to+r,i=a*to+r,n.elements[0]=2*eo/(i-e),n.elements[8]=(i+e)/(i-e),this.cameraL.projectionMatrix.copy(n),e=-a*to-r,i=a*to-r,n.elements[0]=2*eo/(i-e),n.elements[8]=(i+e)/(i-e),this.cameraR.projectionMatrix.copy(n)}this.cameraL.matrixWorld.copy(t.matrixWorld).multiply(oo),this.cameraR.matrixWorld.copy(t.matrixWorld).multiply(ao)})}),ho.prototype=Object.create(bt.prototype),ho.prototype.constructor=ho,lo.prototype=Object.assign(Object.create(bt.prototype),{constructor:lo,getInput:function(){return this.gain},removeFilter:function(){null!==this.filter&&(this.gain.disconnect(this.filter),this.filter.disconnect(this.context.destination),this.gain.connect(this.context.destination),this.filter=null)},getFilter:function(){return this.filter},setFilter:function(t){null!==this.filter?(this.gain.disconnect(this.filter),this.filter.disconnect(this.context.destination)):this.gain.disconnect(this.context.destination),this.filter=t,this.gain.connect(this.filter),this.filter.connect(this.context.destination)},getMasterVolume:function(){return this.gain.gain.value},setMasterVolume:function(t){this.gain.gain.setTargetAtTime(t,this.context.currentTime,.01)},updateMatrixWorld:function(){var t=new F,e=new B,i=new F,n=new F;return function(r){bt.prototype.updateMatrixWorld.call(this,r);var a=this.context.listener,o=this.up;this.matrixWorld.decompose(t,e,i),n.set(0,0,-1).applyQuaternion(e),a.positionX?(a.positionX.setValueAtTime(t.x,this.context.currentTime),a.positionY.setValueAtTime(t.y,this.context.currentTime),a.positionZ.setValueAtTime(t.z,this.context.currentTime),a.forwardX.setValueAtTime(n.x,this.context.currentTime),a.forwardY.setValueAtTime(n.y,this.context.currentTime),a.forwardZ.setValueAtTime(n.z,this.context.currentTime),a.upX.setValueAtTime(o.x,this.context.currentTime),a.upY.setValueAtTime(o.y,this.context.currentTime),a.upZ.setValueAtTime(o.z,this.context.currentTime)):(a.setPosition(t.x,t.y,t.z),a.setOrientation(n.x,n.y,n.z,o.x,o.y,o.z))}}()}),uo.prototype=Object.assign(Object.create(bt.prototype),{constructor:uo,getOutput:function(){return this.gain},setNodeSource:function(t){return this.hasPlaybackControl=!1,this.sourceType="audioNode",this.source=t,this.connect(),this},setBuffer:function(t){return this.buffer=t,this.sourceType="buffer",this.autoplay&&this.play(),this},play:function(){if(!0!==this.isPlaying){if(!1!==this.hasPlaybackControl){var t=this.context.createBufferSource();return t.buffer=this.buffer,t.loop=this.loop,t.onended=this.onEnded.bind(this),t.playbackRate.setValueAtTime(this.playbackRate,this.startTime),this.startTime=this.context.currentTime,t.start(this.startTime,this.offset),this.isPlaying=!0,this.source=t,this.connect()}console.warn("THREE.Audio: this Audio has no playback control.")}else console.warn("THREE.Audio: Audio is already playing.")},pause:function(){if(!1!==this.hasPlaybackControl)return!0===this.isPlaying&&(this.source.stop(),this.offset+=(this.context.currentTime-this.startTime)*this.playbackRate,this.isPlaying=!1),this;console.warn("THREE.Audio: this Audio has no playback control.")},stop:function(){if(!1!==this.hasPlaybackControl)return this.source.stop(),this.offset=0,this.isPlaying=!1,this;console.warn("THREE.Audio: this Audio has no playback control.")},connect:function(){if(this.filters.length>0){this.source.connect(this.filters[0]);for(var t=1,e=this.filters.length;t<e;t++)this.filters[t-1].connect(this.filters[t]);this.filters[this.filters.length-1].connect(this.getOutput())}else this.source.connect(this.getOutput());return this},disconnect:function(){if(this.filters.length>0){this.source.disconnect(this.filters[0]);for(var t=1,e=this.filters.length;t<e;t++)this.filters[t-1].disconnect(this.filters[t]);this.filters[this.filters.length-1].disconnect(this.getOutput())}else this.source.disconnect(this.getOutput());return this},getFilters:function(){return this.filters},setFilters:function(t){return t||(t=[]),!0===this.isPlaying?(this.disconnect(),this.filters=t,this.connect()):this.filters=t,this},getFilter:function(){return this.getFilters()[0]},setFilter:function(t){return this.setFilters(t?[t]:[])},setPlaybackRate:function(t){if(!1!==this.hasPlaybackControl)return this.playbackRate=t,!0===this.isPlaying&&this.source.playbackRate.setValueAtTime(this.playbackRate,this.context.currentTime),this;console.warn("THREE.Audio: this Audio has no playback control.")},getPlaybackRate:function(){return this.playbackRate},onEnded:function(){this.isPlaying=!1},getLoop:function(){return!1===this.hasPlaybackControl?(console.warn("THREE.Audio: this Audio has no playback control."),!1):this.loop},setLoop:function(t){if(!1!==this.hasPlaybackControl)return this.loop=t,!0===this.isPlaying&&(this.source.loop=this.loop),this;console.warn("THREE.Audio: this Audio has no playback control.")},getVolume:function(){return this.gain.gain.value},setVolume:function(t){return this.gain.gain.setTargetAtTime(t,this.context.currentTime,.01),this}}),po.prototype=Object.assign(Object.create(uo.prototype),{constructor:po,getOutput:function(){return this.panner},getRefDistance:function(){return this.panner.refDistance},setRefDistance:function(t){this.panner.refDistance=t},getRolloffFactor:function(){return this.panner.rolloffFactor},setRolloffFactor:function(t){this.panner.rolloffFactor=t},getDistanceModel:function(){return this.panner.distanceModel},setDistanceModel:function(t){this.panner.distanceModel=t},getMaxDistance:function(){return this.panner.maxDistance},setMaxDistance:function(t){this.panner.maxDistance=t},updateMatrixWorld:function(){var t=new F;return function(e){bt.prototype.updateMatrixWorld.call(this,e),t.setFromMatrixPosition(this.matrixWorld),this.panner.setPosition(t.x,t.y,t.z)}}()}),Object.assign(fo.prototype,{getFrequencyData:function(){return this.analyser.getByteFrequencyData(this.data),this.data},getAverageFrequency:function(){for(var t=0,e=this.getFrequencyData(),i=0;i<e.length;i++)t+=e[i];return t/e.length}}),Object.assign(mo.prototype,{accumulate:function(t,e){var i=this.buffer,n=this.valueSize,r=t*n+n,a=this.cumulativeWeight;if(0===a){for(var o=0;o!==n;++o)i[r+o]=i[o];a=e}else{var s=e/(a+=e);this._mixBufferRegion(i,r,0,s,n)}this.cumulativeWeight=a},apply:function(t){var e=this.valueSize,i=this.buffer,n=t*e+e,r=this.cumulativeWeight,a=this.binding;if(this.cumulativeWeight=0,r<1){var o=3*e;this._mixBufferRegion(i,n,o,1-r,e)}for(var s=e,c=e+e;s!==c;++s)if(i[s]!==i[s+e]){a.setValue(i,n);break}},saveOriginalState:function(){var t=this.binding,e=this.buffer,i=this.valueSize,n=3*i;t.getValue(e,n);for(var r=i,a=n;r!==a;++r)e[r]=e[n+r%i];this.cumulativeWeight=0},restoreOriginalState:function(){var t=3*this.valueSize;this.binding.setValue(this.buffer,t)},_select:function(t,e,i,n,r){if(n>=.5)for(var a=0;a!==r;++a)t[e+a]=t[i+a]},_slerp:function(t,e,i,n){B.slerpFlat(t,e,t,e,t,i,n)},_lerp:function(t,e,i,n,r){for(var a=1-n,o=0;o!==r;++o){var s=e+o;t[s]=t[s]*a+t[i+o]*n}}});var go,vo,yo,xo,bo,wo,_o,Mo,Eo,To,So,Ao,Lo,Ro="\\[\\]\\.:\\/";function Po(t,e,i){var n=i||Co.parseTrackName(e);this._targetGroup=t,this._bindings=t.subscribe_(e,n)}function Co(t,e,i){this.path=e,this.parsedPath=i||Co.parseTrackName(e),this.node=Co.findNode(t,this.parsedPath.nodeName)||t,this.rootNode=t}function Oo(t,e,i){this._mixer=t,this._clip=e,this._localRoot=i||null;for(var n=e.tracks,r=n.length,a=new Array(r),o={endingStart:O,endingEnd:O},s=0;s!==r;++s){var c=n[s].createInterpolant(null);a[s]=c,c.settings=o}this._interpolantSettings=o,this._interpolants=a,this._propertyBindings=new Array(r),this._cacheIndex=null,this._byClipCacheIndex=null,this._timeScaleInterpolant=null,this._weightInterpolant=null,this.loop=2201,this._loopCount=-1,this._startTime=null,this.time=0,this.timeScale=1,this._effectiveTimeScale=1,this.weight=1,this._effectiveWeight=1,this.repetitions=1/0,this.paused=!1,this.enabled=!0,this.clampWhenFinished=!1,this.zeroSlopeAtStart=!0,this.zeroSlopeAtEnd=!0}function ko(t){this._root=t,this._initMemoryManager(),this._accuIndex=0,this.time=0,this.timeScale=1}function Io(t){"string"==typeof t&&(console.warn("THREE.Uniform: Type parameter is no longer needed."),t=arguments[1]),this.value=t}function No(){Gt.call(this),this.type="InstancedBufferGeometry",this.maxInstancedCount=void 0}function Do(t,e,i,n){this.data=t,this.itemSize=e,this.offset=i,this.normalized=!0===n}function Uo(t,e){this.array=t,this.stride=e,this.count=void 0!==t?t.length/e:0,this.dynamic=!1,this.updateRange={offset:0,count:-1},this.version=0}function zo(t,e,i){Uo.call(this,t,e),this.meshPerAttribute=i||1}function Bo(t,e,i){Lt.call(this,t,e),this.meshPerAttribute=i||1}function Fo(t,e,i,n){this.ray=new ne(t,e),this.near=i||0,this.far=n||1/0,this.params={Mesh:{},Line:{},LOD:{},Points:{threshold:1},Sprite:{}},Object.defineProperties(this.params,{PointCloud:{get:function(){return console.warn("THREE.Raycaster: params.PointCloud has been renamed to params.Points."),this.Points}}})}function Go(t,e){return t.distance-e.distance}function Ho(t,e,i,n){if(!1!==t.visible&&(t.raycast(e,i),!0===n))for(var r=t.children,a=0,o=r.length;a<o;a++)Ho(r[a],e,i,!0)}function Vo(t,e){this.min=void 0!==t?t:new U(1/0,1/0),this.max=void 0!==e?e:new U(-1/0,-1/0)}function jo(t){bt.call(this),this.material=t,this.render=function(){}}function Wo(t,e,i,n){this.object=t,this.size=void 0!==e?e:1;var r=void 0!==i?i:16711680,a=void 0!==n?n:1,o=0,s=this.object.geometry;s&&s.isGeometry?o=3*s.faces.length:s&&s.isBufferGeometry&&(o=s.attributes.normal.count);var c=new Gt,h=new Dt(2*o*3,3);c.addAttribute("position",h),rn.call(this,c,new en({color:r,linewidth:a})),this.matrixAutoUpdate=!1,this.update()}function Xo(t,e){bt.call(this),this.light=t,this.light.updateMatrixWorld(),this.matrix=t.matrixWorld,this.matrixAutoUpdate=!1,this.color=e;for(var i=new Gt,n=[0,0,0,0,0,1,0,0,0,1,0,1,0,0,0,-1,0,1,0,0,0,0,1,1,0,0,0,0,-1,1],r=0,a=1;r<32;r++,a++){var o=r/32*Math.PI*2,s=a/32*Math.PI*2;n.push(Math.cos(o),Math.sin(o),1,Math.cos(s),Math.sin(s),1)}i.addAttribute("position",new Dt(n,3));var c=new en({fog:!1});this.cone=new rn(i,c),this.add(this.cone),this.update()}function qo(t){var e=[];t&&t.isBone&&e.push(t);for(var i=0;i<t.children.length;i++)e.push.apply(e,qo(t.children[i]));return e}function Yo(t){for(var e=qo(t),i=new Gt,n=[],r=[],a=new ct(0,0,1),o=new ct(0,1,0),s=0;s<e.length;s++){var c=e[s];c.parent&&c.parent.isBone&&(n.push(0,0,0),n.push(0,0,0),r.push(a.r,a.g,a.b),r.push(o.r,o.g,o.b))}i.addAttribute("position",new Dt(n,3)),i.addAttribute("color",new Dt(r,3));var h=new en({vertexColors:2,depthTest:!1,depthWrite:!1,transparent:!0});rn.call(this,i,h),this.root=t,this.bones=e,this.matrix=t.matrixWorld,this.matrixAutoUpdate=!1}function Zo(t,e,i){this.light=t,this.light.updateMatrixWorld(),this.color=i;var n=new cr(e,4,2),r=new ee({wireframe:!0,fog:!1});oe.call(this,n,r),this.matrix=this.light.matrixWorld,this.matrixAutoUpdate=!1,this.update()}function Jo(t,e){bt.call(this),this.light=t,this.light.updateMatrixWorld(),this.matrix=t.matrixWorld,this.matrixAutoUpdate=!1,this.color=e;var i=new en({fog:!1}),n=new Gt;n.addAttribute("position",new Lt(new Float32Array(15),3)),this.line=new nn(n,i),this.add(this.line),this.update()}function Qo(t,e,i){bt.call(this),this.light=t,this.light.updateMatrixWorld(),this.matrix=t.matrixWorld,this.matrixAutoUpdate=!1,this.color=i;var n=new bn(e);n.rotateY(.5*Math.PI),this.material=new ee({wireframe:!0,fog:!1}),void 0===this.color&&(this.material.vertexColors=2);var r=n.getAttribute("position"),a=new Float32Array(3*r.count);n.addAttribute("color",new Lt(a,3)),this.add(new oe(n,this.material)),this.update()}function Ko(t,e,i,n){t=t||10,e=e||10,i=new ct(void 0!==i?i:4473924),n=new ct(void 0!==n?n:8947848);for(var r=e/2,a=t/e,o=t/2,s=[],c=[],h=0,l=0,u=-o;h<=e;h++,u+=a){s.push(-o,0,u,o,0,u),s.push(u,0,-o,u,0,o);var p=h===r?i:n;p.toArray(c,l),l+=3,p.toArray(c,l),l+=3,p.toArray(c,l),l+=3,p.toArray(c,l),l+=3}var d=new Gt;d.addAttribute("position",new Dt(s,3)),d.addAttribute("color",new Dt(c,3));var f=new en({vertexColors:2});rn.call(this,d,f)}function $o(t,e,i,n,r,a){t=t||10,e=e||16,i=i||8,n=n||64,r=new ct(void 0!==r?r:4473924),a=new ct(void 0!==a?a:8947848);var o,s,c,h,l,u,p,d=[],f=[];for(h=0;h<=e;h++)c=h/e*(2*Math.PI),o=Math.sin(c)*t,s=Math.cos(c)*t,d.push(0,0,0),d.push(o,0,s),p=1&h?r:a,f.push(p.r,p.g,p.b),f.push(p.r,p.g,p.b);for(h=0;h<=i;h++)for(p=1&h?r:a,u=t-t/i*h,l=0;l<n;l++)c=l/n*(2*Math.PI),o=Math.sin(c)*u,s=Math.cos(c)*u,d.push(o,0,s),f.push(p.r,p.g,p.b),c=(l+1)/n*(2*Math.PI),o=Math.sin(c)*u,s=Math.cos(c)*u,d.push(o,0,s),f.push(p.r,p.g,p.b);var m=new Gt;m.addAttribute("position",new Dt(d,3)),m.addAttribute("color",new Dt(f,3));var g=new en({vertexColors:2});rn.call(this,m,g)}function ts(t,e,i,n){this.object=t,this.size=void 0!==e?e:1;var r=void 0!==i?i:16776960,a=void 0!==n?n:1,o=0,s=this.object.geometry;s&&s.isGeometry?o=s.faces.length:console.warn("THREE.FaceNormalsHelper: only THREE.Geometry is supported. Use THREE.VertexNormalsHelper, instead.");var c=new Gt,h=new Dt(2*o*3,3);c.addAttribute("position",h),rn.call(this,c,new en({color:r,linewidth:a})),this.matrixAutoUpdate=!1,this.update()}function es(t,e,i){bt.call(this),this.light=t,this.light.updateMatrixWorld(),this.matrix=t.matrixWorld,this.matrixAutoUpdate=!1,this.color=i,void 0===e&&(e=1);var n=new Gt;n.addAttribute("position",new Dt([-e,e,0,e,e,0,e,-e,0,-e,-e,0,-e,e,0],3));var r=new en({fog:!1});this.lightPlane=new nn(n,r),this.add(this.lightPlane),(n=new Gt).addAttribute("position",new Dt([0,0,0,0,0,1],3)),this.targetLine=new nn(n,r),this.add(this.targetLine),this.update()}function is(t){var e=new Gt,i=new en({color:16777215,vertexColors:1}),n=[],r=[],a={},o=new ct(16755200),s=new ct(16711680),c=new ct(43775),h=new ct(16777215),l=new ct(3355443);function u(t,e,i){p(t,i),p(e,i)}function p(t,e){n.push(0,0,0),r.push(e.r,e.g,e.b),void 0===a[t]&&(a[t]=[]),a[t].push(n.length/3-1)}u("n1","n2",o),u("n2","n4",o),u("n4","n3",o),u("n3","n1",o),u("f1","f2",o),u("f2","f4",o),u("f4","f3",o),u("f3","f1",o),u("n1","f1",o),u("n2","f2",o),u("n3","f3",o),u("n4","f4",o),u("p","n1",s),u("p","n2",s),u("p","n3",s),u("p","n4",s),u("u1","u2",c),u("u2","u3",c),u("u3","u1",c),u("c","t",h),u("p","c",l),u("cn1","cn2",l),u("cn3","cn4",l),u("cf1","cf2",l),u("cf3","cf4",l),e.addAttribute("position",new Dt(n,3)),e.addAttribute("color",new Dt(r,3)),rn.call(this,e,i),this.camera=t,this.camera.updateProjectionMatrix&&this.camera.updateProjectionMatrix(),this.matrix=t.matrixWorld,this.matrixAutoUpdate=!1,this.pointMap=a,this.update()}function ns(t,e){this.object=t,void 0===e&&(e=16776960);var i=new Uint16Array([0,1,1,2,2,3,3,0,4,5,5,6,6,7,7,4,0,4,1,5,2,6,3,7]),n=new Float32Array(24),r=new Gt;r.setIndex(new Lt(i,1)),r.addAttribute("position",new Lt(n,3)),rn.call(this,r,new en({color:e})),this.matrixAutoUpdate=!1,this.update()}function rs(t,e){this.type="Box3Helper",this.box=t;var i=void 0!==e?e:16776960,n=new Uint16Array([0,1,1,2,2,3,3,0,4,5,5,6,6,7,7,4,0,4,1,5,2,6,3,7]),r=new Gt;r.setIndex(new Lt(n,1)),r.addAttribute("position",new Dt([1,1,1,-1,1,1,-1,-1,1,1,-1,1,1,1,-1,-1,1,-1,-1,-1,-1,1,-1,-1],3)),rn.call(this,r,new en({color:i})),this.geometry.computeBoundingSphere()}function as(t,e,i){this.type="PlaneHelper",this.plane=t,this.size=void 0===e?1:e;var n=void 0!==i?i:16776960,r=new Gt;r.addAttribute("position",new Dt([1,-1,1,-1,1,1,-1,-1,1,1,1,1,-1,1,1,-1,-1,1,1,-1,1,1,1,1,0,0,1,0,0,0],3)),r.computeBoundingSphere(),nn.call(this,r,new en({color:n}));var a=new Gt;a.addAttribute("position",new Dt([1,1,1,-1,1,1,-1,-1,1,1,1,1,-1,-1,1,1,-1,1],3)),a.computeBoundingSphere(),this.add(new oe(a,new ee({color:n,opacity:.2,transparent:!0,depthWrite:!1})))}function os(t,e,i,n,r,a){bt.call(this),void 0===n&&(n=16776960),void 0===i&&(i=1),void 0===r&&(r=.2*i),void 0===a&&(a=.2*r),void 0===To&&((To=new Gt).addAttribute("position",new Dt([0,0,0,0,1,0],3)),(So=new yr(0,.5,1,5,1)).translate(0,-.5,0)),this.position.copy(e),this.line=new nn(To,new en({color:n})),this.line.matrixAutoUpdate=!1,this.add(this.line),this.cone=new oe(So,new ee({color:n})),this.cone.matrixAutoUpdate=!1,this.add(this.cone),this.setDirection(t),this.setLength(i,r,a)}function ss(t){var e=[0,0,0,t=t||1,0,0,0,0,0,0,t,0,0,0,0,0,0,t],i=new Gt;i.addAttribute("position",new Dt(e,3)),i.addAttribute("color",new Dt([1,0,0,1,.6,0,0,1,0,.6,1,0,0,0,1,0,.6,1],3));var n=new en({vertexColors:2});rn.call(this,i,n)}function cs(t){console.warn("THREE.Spline has been removed. Use THREE.CatmullRomCurve3 instead."),Zr.call(this,t),this.type="catmullrom"}Object.assign(Po.prototype,{getValue:function(t,e){this.bind();var i=this._targetGroup.nCachedObjects_,n=this._bindings[i];void 0!==n&&n.getValue(t,e)},setValue:function(t,e){for(var i=this._bindings,n=this._targetGroup.nCachedObjects_,r=i.length;n!==r;++n)i[n].setValue(t,e)},bind:function(){for(var t=this._bindings,e=this._targetGroup.nCachedObjects_,i=t.length;e!==i;++e)t[e].bind()},unbind:function(){for(var t=this._bindings,e=this._targetGroup.nCachedObjects_,i=t.length;e!==i;++e)t[e].unbind()}}),Object.assign(Co,{Composite:Po,create:function(t,e,i){return t&&t.isAnimationObjectGroup?new Co.Composite(t,e,i):new Co(t,e,i)},sanitizeNodeName:(Eo=new RegExp("[\\[\\]\\.:\\/]","g"),function(t){return t.replace(/\s/g,"_").replace(Eo,"")}),parseTrackName:(go="[^\\[\\]\\.:\\/]",vo="[^"+Ro.replace("\\.","")+"]",yo=/((?:WC+[\/:])*)/.source.replace("WC",go),xo=/(WCOD+)?/.source.replace("WCOD",vo),bo=/(?:\.(WC+)(?:\[(.+)\])?)?/.source.replace("WC",go),wo=/\.(WC+)(?:\[(.+)\])?/.source.replace("WC",go),_o=new RegExp("^"+yo+xo+bo+wo+"$"),Mo=["material","materials","bones"],function(t){var e=_o.exec(t);if(!e)throw new Error("PropertyBinding: Cannot parse trackName: "+t);var i={nodeName:e[2],objectName:e[3],objectIndex:e[4],propertyName:e[5],propertyIndex:e[6]},n=i.nodeName&&i.nodeName.lastIndexOf(".");if(void 0!==n&&-1!==n){var r=i.nodeName.substring(n+1);-1!==Mo.indexOf(r)&&(i.nodeName=i.nodeName.substring(0,n),i.objectName=r)}if(null===i.propertyName||0===i.propertyName.length)throw new Error("PropertyBinding: can not parse propertyName from trackName: "+t);return i}),findNode:function(t,e){if(!e||""===e||"root"===e||"."===e||-1===e||e===t.name||e===t.uuid)return t;if(t.skeleton){var i=t.skeleton.getBoneByName(e);if(void 0!==i)return i}if(t.children){var n=function(t){for(var i=0;i<t.length;i++){var r=t[i];if(r.name===e||r.uuid===e)return r;var a=n(r.children);if(a)return a}return null},r=n(t.children);if(r)return r}return null}}),Object.assign(Co.prototype,{_getValue_unavailable:function(){},_setValue_unavailable:function(){},BindingType:{Direct:0,EntireArray:1,ArrayElement:2,HasFromToArray:3},Versioning:{None:0,NeedsUpdate:1,MatrixWorldNeedsUpdate:2},GetterByBindingType:[function(t,e){t[e]=this.node[this.propertyName]},function(t,e){for(var i=this.resolvedProperty,n=0,r=i.length;n!==r;++n)t[e++]=i[n]},function(t,e){t[e]=this.resolvedProperty[this.propertyIndex]},function(t,e){this.resolvedProperty.toArray(t,e)}],SetterByBindingTypeAndVersioning:[[function(t,e){this.targetObject[this.propertyName]=t[e]},function(t,e){this.targetObject[this.propertyName]=t[e],this.targetObject.needsUpdate=!0},function(t,e){this.targetObject[this.propertyName]=t[e],this.targetObject.matrixWorldNeedsUpdate=!0}],[function(t,e){for(var i=this.resolvedProperty,n=0,r=i.length;n!==r;++n)i[n]=t[e++]},function(t,e){for(var i=this.resolvedProperty,n=0,r=i.length;n!==r;++n)i[n]=t[e++];this.targetObject.needsUpdate=!0},function(t,e){for(var i=this.resolvedProperty,n=0,r=i.length;n!==r;++n)i[n]=t[e++];this.targetObject.matrixWorldNeedsUpdate=!0}],[function(t,e){this.resolvedProperty[this.propertyIndex]=t[e]},function(t,e){this.resolvedProperty[this.propertyIndex]=t[e],this.targetObject.needsUpdate=!0},function(t,e){this.resolvedProperty[this.propertyIndex]=t[e],this.targetObject.matrixWorldNeedsUpdate=!0}],[function(t,e){this.resolvedProperty.fromArray(t,e)},function(t,e){this.resolvedProperty.fromArray(t,e),this.targetObject.needsUpdate=!0},function(t,e){this.resolvedProperty.fromArray(t,e),this.targetObject.matrixWorldNeedsUpdate=!0}]],getValue:function(t,e){this.bind(),this.getValue(t,e)},setValue:function(t,e){this.bind(),this.setValue(t,e)},bind:function(){var t=this.node,e=this.parsedPath,i=e.objectName,n=e.propertyName,r=e.propertyIndex;if(t||(t=Co.findNode(this.rootNode,e.nodeName)||this.rootNode,this.node=t),this.getValue=this._getValue_unavailable,this.setValue=this._setValue_unavailable,t){if(i){var a=e.objectIndex;switch(i){case"materials":if(!t.material)return void console.error("THREE.PropertyBinding: Can not bind to material as node does not have a material.",this);if(!t.material.materials)return void console.error("THREE.PropertyBinding: Can not bind to material.materials as node.material does not have a materials array.",this);t=t.material.materials;break;case"bones":if(!t.skeleton)return void console.error("THREE.PropertyBinding: Can not bind to bones as node does not have a skeleton.",this);t=t.skeleton.bones;for(var o=0;o<t.length;o++)if(t[o].name===a){a=o;break}break;default:if(void 0===t[i])return void console.error("THREE.PropertyBinding: Can not bind to objectName of node undefined.",this);t=t[i]}if(void 0!==a){if(void 0===t[a])return void console.error("THREE.PropertyBinding: Trying to bind to objectIndex of objectName, but is undefined.",this,t);t=t[a]}}var s=t[n];if(void 0!==s){var c=this.Versioning.None;void 0!==t.needsUpdate?(c=this.Versioning.NeedsUpdate,this.targetObject=t):void 0!==t.matrixWorldNeedsUpdate&&(c=this.Versioning.MatrixWorldNeedsUpdate,this.targetObject=t);var h=this.BindingType.Direct;if(void 0!==r){if("morphTargetInfluences"===n){if(!t.geometry)return void console.error("THREE.PropertyBinding: Can not bind to morphTargetInfluences because node does not have a geometry.",this);if(t.geometry.isBufferGeometry){if(!t.geometry.morphAttributes)return void console.error("THREE.PropertyBinding: Can not bind to morphTargetInfluences because node does not have a geometry.morphAttributes.",this);for(o=0;o<this.node.geometry.morphAttributes.position.length;o++)if(t.geometry.morphAttributes.position[o].name===r){r=o;break}}else{if(!t.geometry.morphTargets)return void console.error("THREE.PropertyBinding: Can not bind to morphTargetInfluences because node does not have a geometry.morphTargets.",this);for(o=0;o<this.node.geometry.morphTargets.length;o++)if(t.geometry.morphTargets[o].name===r){r=o;break}}}h=this.BindingType.ArrayElement,this.resolvedProperty=s,this.propertyIndex=r}else void 0!==s.fromArray&&void 0!==s.toArray?(h=this.BindingType.HasFromToArray,this.resolvedProperty=s):Array.isArray(s)?(h=this.BindingType.EntireArray,this.resolvedProperty=s):this.propertyName=n;this.getValue=this.GetterByBindingType[h],this.setValue=this.SetterByBindingTypeAndVersioning[h][c]}else{var l=e.nodeName;console.error("THREE.PropertyBinding: Trying to update property for track: "+l+"."+n+" but it wasn't found.",t)}}else console.error("THREE.PropertyBinding: Trying to update node for track: "+this.path+" but it wasn't found.")},unbind:function(){this.node=null,this.getValue=this._getValue_unbound,this.setValue=this._setValue_unbound}}),
 //!\ DECLARE ALIAS AFTER assign prototype !
-Object.assign(Co.prototype,{_getValue_unbound:Co.prototype.getValue,_setValue_unbound:Co.prototype.setValue}),Object.assign(function(){this.uuid=D.generateUUID(),this._objects=Array.prototype.slice.call(arguments),this.nCachedObjects_=0;var t={};this._indicesByUUID=t;for(var e=0,i=arguments.length;e!==i;++e)t[arguments[e].uuid]=e;this._paths=[],this._parsedPaths=[],this._bindings=[],this._bindingsIndicesByPath={};var n=this;this.stats={objects:{get total(){return n._objects.length},get inUse(){return this.total-n.nCachedObjects_}},get bindingsPerObject(){return n._bindings.length}}}.prototype,{isAnimationObjectGroup:!0,add:function(){for(var t=this._objects,e=t.length,i=this.nCachedObjects_,n=this._indicesByUUID,r=this._paths,a=this._parsedPaths,o=this._bindings,s=o.length,c=void 0,h=0,l=arguments.length;h!==l;++h){var u=arguments[h],p=u.uuid,d=n[p];if(void 0===d){d=e++,n[p]=d,t.push(u);for(var f=0,m=s;f!==m;++f)o[f].push(new Co(u,r[f],a[f]))}else if(d<i){c=t[d];var g=--i,v=t[g];n[v.uuid]=d,t[d]=v,n[p]=g,t[g]=u;for(f=0,m=s;f!==m;++f){var y=o[f],x=y[g],b=y[d];y[d]=x,void 0===b&&(b=new Co(u,r[f],a[f])),y[g]=b}}else t[d]!==c&&console.error("THREE.AnimationObjectGroup: Different objects with the same UUID detected. Clean the caches or recreate your infrastructure when reloading scenes.")}this.nCachedObjects_=i},remove:function(){for(var t=this._objects,e=this.nCachedObjects_,i=this._indicesByUUID,n=this._bindings,r=n.length,a=0,o=arguments.length;a!==o;++a){var s=arguments[a],c=s.uuid,h=i[c];if(void 0!==h&&h>=e){var l=e++,u=t[l];i[u.uuid]=h,t[h]=u,i[c]=l,t[l]=s;for(var p=0,d=r;p!==d;++p){var f=n[p],m=f[l],g=f[h];f[h]=m,f[l]=g}}}this.nCachedObjects_=e},uncache:function(){for(var t=this._objects,e=t.length,i=this.nCachedObjects_,n=this._indicesByUUID,r=this._bindings,a=r.length,o=0,s=arguments.length;o!==s;++o){var c=arguments[o],h=c.uuid,l=n[h];if(void 0!==l)if(delete n[h],l<i){var u=--i,p=t[u],d=t[y=--e];n[p.uuid]=l,t[l]=p,n[d.uuid]=u,t[u]=d,t.pop();for(var f=0,m=a;f!==m;++f){var g=(x=r[f])[u],v=x[y];x[l]=g,x[u]=v,x.pop()}}else{var y;n[(d=t[y=--e]).uuid]=l,t[l]=d,t.pop();for(f=0,m=a;f!==m;++f){var x;(x=r[f])[l]=x[y],x.pop()}}}this.nCachedObjects_=i},subscribe_:function(t,e){var i=this._bindingsIndicesByPath,n=i[t],r=this._bindings;if(void 0!==n)return r[n];var a=this._paths,o=this._parsedPaths,s=this._objects,c=s.length,h=this.nCachedObjects_,l=new Array(c);n=r.length,i[t]=n,a.push(t),o.push(e),r.push(l);for(var u=h,p=s.length;u!==p;++u){var d=s[u];l[u]=new Co(d,t,e)}return l},unsubscribe_:function(t){var e=this._bindingsIndicesByPath,i=e[t];if(void 0!==i){var n=this._paths,r=this._parsedPaths,a=this._bindings,o=a.length-1,s=a[o];e[t[o]]=i,a[i]=s,a.pop(),r[i]=r[o],r.pop(),n[i]=n[o],n.pop()}}}),Object.assign(Oo.prototype,{play:function(){return this._mixer._activateAction(this),this},stop:function(){return this._mixer._deactivateAction(this),this.reset()},reset:function(){return this.paused=!1,this.enabled=!0,this.time=0,this._loopCount=-1,this._startTime=null,this.stopFading().stopWarping()},isRunning:function(){return this.enabled&&!this.paused&&0!==this.timeScale&&null===this._startTime&&this._mixer._isActiveAction(this)},isScheduled:function(){return this._mixer._isActiveAction(this)},startAt:function(t){return this._startTime=t,this},setLoop:function(t,e){return this.loop=t,this.repetitions=e,this},setEffectiveWeight:function(t){return this.weight=t,this._effectiveWeight=this.enabled?t:0,this.stopFading()},getEffectiveWeight:function(){return this._effectiveWeight},fadeIn:function(t){return this._scheduleFading(t,0,1)},fadeOut:function(t){return this._scheduleFading(t,1,0)},crossFadeFrom:function(t,e,i){if(t.fadeOut(e),this.fadeIn(e),i){var n=this._clip.duration,r=t._clip.duration,a=r/n,o=n/r;t.warp(1,a,e),this.warp(o,1,e)}return this},crossFadeTo:function(t,e,i){return t.crossFadeFrom(this,e,i)},stopFading:function(){var t=this._weightInterpolant;return null!==t&&(this._weightInterpolant=null,this._mixer._takeBackControlInterpolant(t)),this},setEffectiveTimeScale:function(t){return this.timeScale=t,this._effectiveTimeScale=this.paused?0:t,this.stopWarping()},getEffectiveTimeScale:function(){return this._effectiveTimeScale},setDuration:function(t){return this.timeScale=this._clip.duration/t,this.stopWarping()},syncWith:function(t){return this.time=t.time,this.timeScale=t.timeScale,this.stopWarping()},halt:function(t){return this.warp(this._effectiveTimeScale,0,t)},warp:function(t,e,i){var n=this._mixer,r=n.time,a=this._timeScaleInterpolant,o=this.timeScale;null===a&&(a=n._lendControlInterpolant(),this._timeScaleInterpolant=a);var s=a.parameterPositions,c=a.sampleValues;return s[0]=r,s[1]=r+i,c[0]=t/o,c[1]=e/o,this},stopWarping:function(){var t=this._timeScaleInterpolant;return null!==t&&(this._timeScaleInterpolant=null,this._mixer._takeBackControlInterpolant(t)),this},getMixer:function(){return this._mixer},getClip:function(){return this._clip},getRoot:function(){return this._localRoot||this._mixer._root},_update:function(t,e,i,n){if(this.enabled){var r=this._startTime;if(null!==r){var a=(t-r)*i;if(a<0||0===i)return;this._startTime=null,e=i*a}e*=this._updateTimeScale(t);var o=this._updateTime(e),s=this._updateWeight(t);if(s>0)for(var c=this._interpolants,h=this._propertyBindings,l=0,u=c.length;l!==u;++l)c[l].evaluate(o),h[l].accumulate(n,s)}else this._updateWeight(t)},_updateWeight:function(t){var e=0;if(this.enabled){e=this.weight;var i=this._weightInterpolant;if(null!==i){var n=i.evaluate(t)[0];e*=n,t>i.parameterPositions[1]&&(this.stopFading(),0===n&&(this.enabled=!1))}}return this._effectiveWeight=e,e},_updateTimeScale:function(t){var e=0;if(!this.paused){e=this.timeScale;var i=this._timeScaleInterpolant;if(null!==i)e*=i.evaluate(t)[0],t>i.parameterPositions[1]&&(this.stopWarping(),0===e?this.paused=!0:this.timeScale=e)}return this._effectiveTimeScale=e,e},_updateTime:function(t){var e=this.time+t;if(0===t)return e;var i=this._clip.duration,n=this.loop,r=this._loopCount;if(2200===n){-1===r&&(this._loopCount=0,this._setEndings(!0,!0,!1));t:{if(e>=i)e=i;else{if(!(e<0))break t;e=0}this.clampWhenFinished?this.paused=!0:this.enabled=!1,this._mixer.dispatchEvent({type:"finished",action:this,direction:t<0?-1:1})}}else{var a=2202===n;if(-1===r&&(t>=0?(r=0,this._setEndings(!0,0===this.repetitions,a)):this._setEndings(0===this.repetitions,!0,a)),e>=i||e<0){var o=Math.floor(e/i);e-=i*o,r+=Math.abs(o);var s=this.repetitions-r;if(s<=0)this.clampWhenFinished?this.paused=!0:this.enabled=!1,e=t>0?i:0,this._mixer.dispatchEvent({type:"finished",action:this,direction:t>0?1:-1});else{if(1===s){var c=t<0;this._setEndings(c,!c,a)}else this._setEndings(!1,!1,a);this._loopCount=r,this._mixer.dispatchEvent({type:"loop",action:this,loopDelta:o})}}if(a&&1==(1&r))return this.time=e,i-e}return this.time=e,e},_setEndings:function(t,e,i){var n=this._interpolantSettings;i?(n.endingStart=k,n.endingEnd=k):(n.endingStart=t?this.zeroSlopeAtStart?k:O:I,n.endingEnd=e?this.zeroSlopeAtEnd?k:O:I)},_scheduleFading:function(t,e,i){var n=this._mixer,r=n.time,a=this._weightInterpolant;null===a&&(a=n._lendControlInterpolant(),this._weightInterpolant=a);var o=a.parameterPositions,s=a.sampleValues;return o[0]=r,s[0]=e,o[1]=r+t,s[1]=i,this}}),ko.prototype=Object.assign(Object.create(e.prototype),{constructor:ko,_bindAction:function(t,e){var i=t._localRoot||this._root,n=t._clip.tracks,r=n.length,a=t._propertyBindings,o=t._interpolants,s=i.uuid,c=this._bindingsByRootAndName,h=c[s];void 0===h&&(h={},c[s]=h);for(var l=0;l!==r;++l){var u=n[l],p=u.name,d=h[p];if(void 0!==d)a[l]=d;else{if(void 0!==(d=a[l])){null===d._cacheIndex&&(++d.referenceCount,this._addInactiveBinding(d,s,p));continue}var f=e&&e._propertyBindings[l].binding.parsedPath;++(d=new mo(Co.create(i,p,f),u.ValueTypeName,u.getValueSize())).referenceCount,this._addInactiveBinding(d,s,p),a[l]=d}o[l].resultBuffer=d.buffer}},_activateAction:function(t){if(!this._isActiveAction(t)){if(null===t._cacheIndex){var e=(t._localRoot||this._root).uuid,i=t._clip.uuid,n=this._actionsByClip[i];this._bindAction(t,n&&n.knownActions[0]),this._addInactiveAction(t,i,e)}for(var r=t._propertyBindings,a=0,o=r.length;a!==o;++a){var s=r[a];0==s.useCount++&&(this._lendBinding(s),s.saveOriginalState())}this._lendAction(t)}},_deactivateAction:function(t){if(this._isActiveAction(t)){for(var e=t._propertyBindings,i=0,n=e.length;i!==n;++i){var r=e[i];0==--r.useCount&&(r.restoreOriginalState(),this._takeBackBinding(r))}this._takeBackAction(t)}},_initMemoryManager:function(){this._actions=[],this._nActiveActions=0,this._actionsByClip={},this._bindings=[],this._nActiveBindings=0,this._bindingsByRootAndName={},this._controlInterpolants=[],this._nActiveControlInterpolants=0;var t=this;this.stats={actions:{get total(){return t._actions.length},get inUse(){return t._nActiveActions}},bindings:{get total(){return t._bindings.length},get inUse(){return t._nActiveBindings}},controlInterpolants:{get total(){return t._controlInterpolants.length},get inUse(){return t._nActiveControlInterpolants}}}},_isActiveAction:function(t){var e=t._cacheIndex;return null!==e&&e<this._nActiveActions},_addInactiveAction:function(t,e,i){var n=this._actions,r=this._actionsByClip,a=r[e];if(void 0===a)a={knownActions:[t],actionByRoot:{}},t._byClipCacheIndex=0,r[e]=a;else{var o=a.knownActions;t._byClipCacheIndex=o.length,o.push(t)}t._cacheIndex=n.length,n.push(t),a.actionByRoot[i]=t},_removeInactiveAction:function(t){var e=this._actions,i=e[e.length-1],n=t._cacheIndex;i._cacheIndex=n,e[n]=i,e.pop(),t._cacheIndex=null;var r=t._clip.uuid,a=this._actionsByClip,o=a[r],s=o.knownActions,c=s[s.length-1],h=t._byClipCacheIndex;c._byClipCacheIndex=h,s[h]=c,s.pop(),t._byClipCacheIndex=null,delete o.actionByRoot[(t._localRoot||this._root).uuid],0===s.length&&delete a[r],this._removeInactiveBindingsForAction(t)},_removeInactiveBindingsForAction:function(t){for(var e=t._propertyBindings,i=0,n=e.length;i!==n;++i){var r=e[i];0==--r.referenceCount&&this._removeInactiveBinding(r)}},_lendAction:function(t){var e=this._actions,i=t._cacheIndex,n=this._nActiveActions++,r=e[n];t._cacheIndex=n,e[n]=t,r._cacheIndex=i,e[i]=r},_takeBackAction:function(t){var e=this._actions,i=t._cacheIndex,n=--this._nActiveActions,r=e[n];t._cacheIndex=n,e[n]=t,r._cacheIndex=i,e[i]=r},_addInactiveBinding:function(t,e,i){var n=this._bindingsByRootAndName,r=n[e],a=this._bindings;void 0===r&&(r={},n[e]=r),r[i]=t,t._cacheIndex=a.length,a.push(t)},_removeInactiveBinding:function(t){var e=this._bindings,i=t.binding,n=i.rootNode.uuid,r=i.path,a=this._bindingsByRootAndName,o=a[n],s=e[e.length-1],c=t._cacheIndex;s._cacheIndex=c,e[c]=s,e.pop(),delete o[r];t:{for(var h in o)break t;delete a[n]}},_lendBinding:function(t){var e=this._bindings,i=t._cacheIndex,n=this._nActiveBindings++,r=e[n];t._cacheIndex=n,e[n]=t,r._cacheIndex=i,e[i]=r},_takeBackBinding:function(t){var e=this._bindings,i=t._cacheIndex,n=--this._nActiveBindings,r=e[n];t._cacheIndex=n,e[n]=t,r._cacheIndex=i,e[i]=r},_lendControlInterpolant:function(){var t=this._controlInterpolants,e=this._nActiveControlInterpolants++,i=t[e];return void 0===i&&((i=new La(new Float32Array(2),new Float32Array(2),1,this._controlInterpolantsResultBuffer)).__cacheIndex=e,t[e]=i),i},_takeBackControlInterpolant:function(t){var e=this._controlInterpolants,i=t.__cacheIndex,n=--this._nActiveControlInterpolants,r=e[n];t.__cacheIndex=n,e[n]=t,r.__cacheIndex=i,e[i]=r},_controlInterpolantsResultBuffer:new Float32Array(1),clipAction:function(t,e){var i=e||this._root,n=i.uuid,r="string"==typeof t?ka.findByName(i,t):t,a=null!==r?r.uuid:t,o=this._actionsByClip[a],s=null;if(void 0!==o){var c=o.actionByRoot[n];if(void 0!==c)return c;s=o.knownActions[0],null===r&&(r=s._clip)}if(null===r)return null;var h=new Oo(this,r,e);return this._bindAction(h,s),this._addInactiveAction(h,a,n),h},existingAction:function(t,e){var i=e||this._root,n=i.uuid,r="string"==typeof t?ka.findByName(i,t):t,a=r?r.uuid:t,o=this._actionsByClip[a];return void 0!==o&&o.actionByRoot[n]||null},stopAllAction:function(){var t=this._actions,e=this._nActiveActions,i=this._bindings,n=this._nActiveBindings;this._nActiveActions=0,this._nActiveBindings=0;for(var r=0;r!==e;++r)t[r].reset();for(r=0;r!==n;++r)i[r].useCount=0;return this},update:function(t){t*=this.timeScale;for(var e=this._actions,i=this._nActiveActions,n=this.time+=t,r=Math.sign(t),a=this._accuIndex^=1,o=0;o!==i;++o){e[o]._update(n,t,r,a)}var s=this._bindings,c=this._nActiveBindings;for(o=0;o!==c;++o)s[o].apply(a);return this},getRoot:function(){return this._root},uncacheClip:function(t){var e=this._actions,i=t.uuid,n=this._actionsByClip,r=n[i];if(void 0!==r){for(var a=r.knownActions,o=0,s=a.length;o!==s;++o){var c=a[o];this._deactivateAction(c);var h=c._cacheIndex,l=e[e.length-1];c._cacheIndex=null,c._byClipCacheIndex=null,l._cacheIndex=h,e[h]=l,e.pop(),this._removeInactiveBindingsForAction(c)}delete n[i]}},uncacheRoot:function(t){var e=t.uuid,i=this._actionsByClip;for(var n in i){var r=i[n].actionByRoot[e];void 0!==r&&(this._deactivateAction(r),this._removeInactiveAction(r))}var a=this._bindingsByRootAndName[e];if(void 0!==a)for(var o in a){var s=a[o];s.restoreOriginalState(),this._removeInactiveBinding(s)}},uncacheAction:function(t,e){var i=this.existingAction(t,e);null!==i&&(this._deactivateAction(i),this._removeInactiveAction(i))}}),Io.prototype.clone=function(){return new Io(void 0===this.value.clone?this.value:this.value.clone())},No.prototype=Object.assign(Object.create(Gt.prototype),{constructor:No,isInstancedBufferGeometry:!0,copy:function(t){return Gt.prototype.copy.call(this,t),this.maxInstancedCount=t.maxInstancedCount,this},clone:function(){return(new this.constructor).copy(this)}}),Object.defineProperties(Do.prototype,{count:{get:function(){return this.data.count}},array:{get:function(){return this.data.array}}}),Object.assign(Do.prototype,{isInterleavedBufferAttribute:!0,setX:function(t,e){return this.data.array[t*this.data.stride+this.offset]=e,this},setY:function(t,e){return this.data.array[t*this.data.stride+this.offset+1]=e,this},setZ:function(t,e){return this.data.array[t*this.data.stride+this.offset+2]=e,this},setW:function(t,e){return this.data.array[t*this.data.stride+this.offset+3]=e,this},getX:function(t){return this.data.array[t*this.data.stride+this.offset]},getY:function(t){return this.data.array[t*this.data.stride+this.offset+1]},getZ:function(t){return this.data.array[t*this.data.stride+this.offset+2]},getW:function(t){return this.data.array[t*this.data.stride+this.offset+3]},setXY:function(t,e,i){return t=t*this.data.stride+this.offset,this.data.array[t+0]=e,this.data.array[t+1]=i,this},setXYZ:function(t,e,i,n){return t=t*this.data.stride+this.offset,this.data.array[t+0]=e,this.data.array[t+1]=i,this.data.array[t+2]=n,this},setXYZW:function(t,e,i,n,r){return t=t*this.data.stride+this.offset,this.data.array[t+0]=e,this.data.array[t+1]=i,this.data.array[t+2]=n,this.data.array[t+3]=r,this}}),Object.defineProperty(Uo.prototype,"needsUpdate",{set:function(t){!0===t&&this.version++}}),Object.assign(Uo.prototype,{isInterleavedBuffer:!0,onUploadCallback:function(){},setArray:function(t){if(Array.isArray(t))throw new TypeError("THREE.BufferAttribute: array should be a Typed Array.");return this.count=void 0!==t?t.length/this.stride:0,this.array=t,this},setDynamic:function(t){return this.dynamic=t,this},copy:function(t){return this.array=new t.array.constructor(t.array),this.count=t.count,this.stride=t.stride,this.dynamic=t.dynamic,this},copyAt:function(t,e,i){t*=this.stride,i*=e.stride;for(var n=0,r=this.stride;n<r;n++)this.array[t+n]=e.array[i+n];return this},set:function(t,e){return void 0===e&&(e=0),this.array.set(t,e),this},clone:function(){return(new this.constructor).copy(this)},onUpload:function(t){return this.onUploadCallback=t,this}}),zo.prototype=Object.assign(Object.create(Uo.prototype),{constructor:zo,isInstancedInterleavedBuffer:!0,copy:function(t){return Uo.prototype.copy.call(this,t),this.meshPerAttribute=t.meshPerAttribute,this}}),Bo.prototype=Object.assign(Object.create(Lt.prototype),{constructor:Bo,isInstancedBufferAttribute:!0,copy:function(t){return Lt.prototype.copy.call(this,t),this.meshPerAttribute=t.meshPerAttribute,this}}),Object.assign(Fo.prototype,{linePrecision:1,set:function(t,e){this.ray.set(t,e)},setFromCamera:function(t,e){e&&e.isPerspectiveCamera?(this.ray.origin.setFromMatrixPosition(e.matrixWorld),this.ray.direction.set(t.x,t.y,.5).unproject(e).sub(this.ray.origin).normalize()):e&&e.isOrthographicCamera?(this.ray.origin.set(t.x,t.y,(e.near+e.far)/(e.near-e.far)).unproject(e),this.ray.direction.set(0,0,-1).transformDirection(e.matrixWorld)):console.error("THREE.Raycaster: Unsupported camera type.")},intersectObject:function(t,e,i){var n=i||[];return Ho(t,this,n,e),n.sort(Go),n},intersectObjects:function(t,e,i){var n=i||[];if(!1===Array.isArray(t))return console.warn("THREE.Raycaster.intersectObjects: objects is not an Array."),n;for(var r=0,a=t.length;r<a;r++)Ho(t[r],this,n,e);return n.sort(Go),n}}),Object.assign(function(t){this.autoStart=void 0===t||t,this.startTime=0,this.oldTime=0,this.elapsedTime=0,this.running=!1}.prototype,{start:function(){this.startTime=("undefined"==typeof performance?Date:performance).now(),this.oldTime=this.startTime,this.elapsedTime=0,this.running=!0},stop:function(){this.getElapsedTime(),this.running=!1,this.autoStart=!1},getElapsedTime:function(){return this.getDelta(),this.elapsedTime},getDelta:function(){var t=0;if(this.autoStart&&!this.running)return this.start(),0;if(this.running){var e=("undefined"==typeof performance?Date:performance).now();t=(e-this.oldTime)/1e3,this.oldTime=e,this.elapsedTime+=t}return t}}),Object.assign(function(t,e,i){return this.radius=void 0!==t?t:1,this.phi=void 0!==e?e:0,this.theta=void 0!==i?i:0,this}.prototype,{set:function(t,e,i){return this.radius=t,this.phi=e,this.theta=i,this},clone:function(){return(new this.constructor).copy(this)},copy:function(t){return this.radius=t.radius,this.phi=t.phi,this.theta=t.theta,this},makeSafe:function(){var t=1e-6;return this.phi=Math.max(t,Math.min(Math.PI-t,this.phi)),this},setFromVector3:function(t){return this.radius=t.length(),0===this.radius?(this.theta=0,this.phi=0):(this.theta=Math.atan2(t.x,t.z),this.phi=Math.acos(D.clamp(t.y/this.radius,-1,1))),this}}),Object.assign(function(t,e,i){return this.radius=void 0!==t?t:1,this.theta=void 0!==e?e:0,this.y=void 0!==i?i:0,this}.prototype,{set:function(t,e,i){return this.radius=t,this.theta=e,this.y=i,this},clone:function(){return(new this.constructor).copy(this)},copy:function(t){return this.radius=t.radius,this.theta=t.theta,this.y=t.y,this},setFromVector3:function(t){return this.radius=Math.sqrt(t.x*t.x+t.z*t.z),this.theta=Math.atan2(t.x,t.z),this.y=t.y,this}}),Object.assign(Vo.prototype,{set:function(t,e){return this.min.copy(t),this.max.copy(e),this},setFromPoints:function(t){this.makeEmpty();for(var e=0,i=t.length;e<i;e++)this.expandByPoint(t[e]);return this},setFromCenterAndSize:function(){var t=new U;return function(e,i){var n=t.copy(i).multiplyScalar(.5);return this.min.copy(e).sub(n),this.max.copy(e).add(n),this}}(),clone:function(){return(new this.constructor).copy(this)},copy:function(t){return this.min.copy(t.min),this.max.copy(t.max),this},makeEmpty:function(){return this.min.x=this.min.y=1/0,this.max.x=this.max.y=-1/0,this},isEmpty:function(){return this.max.x<this.min.x||this.max.y<this.min.y},getCenter:function(t){return void 0===t&&(console.warn("THREE.Box2: .getCenter() target is now required"),t=new U),this.isEmpty()?t.set(0,0):t.addVectors(this.min,this.max).multiplyScalar(.5)},getSize:function(t){return void 0===t&&(console.warn("THREE.Box2: .getSize() target is now required"),t=new U),this.isEmpty()?t.set(0,0):t.subVectors(this.max,this.min)},expandByPoint:function(t){return this.min.min(t),this.max.max(t),this},expandByVector:function(t){return this.min.sub(t),this.max.add(t),this},expandByScalar:function(t){return this.min.addScalar(-t),this.max.addScalar(t),this},containsPoint:function(t){return!(t.x<this.min.x||t.x>this.max.x||t.y<this.min.y||t.y>this.max.y)},containsBox:function(t){return this.min.x<=t.min.x&&t.max.x<=this.max.x&&this.min.y<=t.min.y&&t.max.y<=this.max.y},getParameter:function(t,e){return void 0===e&&(console.warn("THREE.Box2: .getParameter() target is now required"),e=new U),e.set((t.x-this.min.x)/(this.max.x-this.min.x),(t.y-this.min.y)/(this.max.y-this.min.y))},intersectsBox:function(t){return!(t.max.x<this.min.x||t.min.x>this.max.x||t.max.y<this.min.y||t.min.y>this.max.y)},clampPoint:function(t,e){return void 0===e&&(console.warn("THREE.Box2: .clampPoint() target is now required"),e=new U),e.copy(t).clamp(this.min,this.max)},distanceToPoint:function(){var t=new U;return function(e){return t.copy(e).clamp(this.min,this.max).sub(e).length()}}(),intersect:function(t){return this.min.max(t.min),this.max.min(t.max),this},union:function(t){return this.min.min(t.min),this.max.max(t.max),this},translate:function(t){return this.min.add(t),this.max.add(t),this},equals:function(t){return t.min.equals(this.min)&&t.max.equals(this.max)}}),jo.prototype=Object.create(bt.prototype),jo.prototype.constructor=jo,jo.prototype.isImmediateRenderObject=!0,Wo.prototype=Object.create(rn.prototype),Wo.prototype.constructor=Wo,Wo.prototype.update=function(){var t=new F,e=new F,i=new G;return function(){var n=["a","b","c"];this.object.updateMatrixWorld(!0),i.getNormalMatrix(this.object.matrixWorld);var r=this.object.matrixWorld,a=this.geometry.attributes.position,o=this.object.geometry;if(o&&o.isGeometry)for(var s=o.vertices,c=o.faces,h=0,l=0,u=c.length;l<u;l++)for(var p=c[l],d=0,f=p.vertexNormals.length;d<f;d++){var m=s[p[n[d]]],g=p.vertexNormals[d];t.copy(m).applyMatrix4(r),e.copy(g).applyMatrix3(i).normalize().multiplyScalar(this.size).add(t),a.setXYZ(h,t.x,t.y,t.z),h+=1,a.setXYZ(h,e.x,e.y,e.z),h+=1}else if(o&&o.isBufferGeometry){var v=o.attributes.position,y=o.attributes.normal;for(h=0,d=0,f=v.count;d<f;d++)t.set(v.getX(d),v.getY(d),v.getZ(d)).applyMatrix4(r),e.set(y.getX(d),y.getY(d),y.getZ(d)),e.applyMatrix3(i).normalize().multiplyScalar(this.size).add(t),a.setXYZ(h,t.x,t.y,t.z),h+=1,a.setXYZ(h,e.x,e.y,e.z),h+=1}a.needsUpdate=!0}}(),Xo.prototype=Object.create(bt.prototype),Xo.prototype.constructor=Xo,Xo.prototype.dispose=function(){this.cone.geometry.dispose(),this.cone.material.dispose()},Xo.prototype.update=function(){var t=new F,e=new F;return function(){this.light.updateMatrixWorld();var i=this.light.distance?this.light.distance:1e3,n=i*Math.tan(this.light.angle);this.cone.scale.set(n,n,i),t.setFromMatrixPosition(this.light.matrixWorld),e.setFromMatrixPosition(this.light.target.matrixWorld),this.cone.lookAt(e.sub(t)),void 0!==this.color?this.cone.material.color.set(this.color):this.cone.material.color.copy(this.light.color)}}(),Yo.prototype=Object.create(rn.prototype),Yo.prototype.constructor=Yo,Yo.prototype.updateMatrixWorld=function(){var t=new F,e=new z,i=new z;return function(n){var r=this.bones,a=this.geometry,o=a.getAttribute("position");i.getInverse(this.root.matrixWorld);for(var s=0,c=0;s<r.length;s++){var h=r[s];h.parent&&h.parent.isBone&&(e.multiplyMatrices(i,h.matrixWorld),t.setFromMatrixPosition(e),o.setXYZ(c,t.x,t.y,t.z),e.multiplyMatrices(i,h.parent.matrixWorld),t.setFromMatrixPosition(e),o.setXYZ(c+1,t.x,t.y,t.z),c+=2)}a.getAttribute("position").needsUpdate=!0,bt.prototype.updateMatrixWorld.call(this,n)}}(),Zo.prototype=Object.create(oe.prototype),Zo.prototype.constructor=Zo,Zo.prototype.dispose=function(){this.geometry.dispose(),this.material.dispose()},Zo.prototype.update=function(){void 0!==this.color?this.material.color.set(this.color):this.material.color.copy(this.light.color)},Jo.prototype=Object.create(bt.prototype),Jo.prototype.constructor=Jo,Jo.prototype.dispose=function(){this.children[0].geometry.dispose(),this.children[0].material.dispose()},Jo.prototype.update=function(){var t=.5*this.light.width,e=.5*this.light.height,i=this.line.geometry.attributes.position,n=i.array;n[0]=t,n[1]=-e,n[2]=0,n[3]=t,n[4]=e,n[5]=0,n[6]=-t,n[7]=e,n[8]=0,n[9]=-t,n[10]=-e,n[11]=0,n[12]=t,n[13]=-e,n[14]=0,i.needsUpdate=!0,void 0!==this.color?this.line.material.color.set(this.color):this.line.material.color.copy(this.light.color)},Qo.prototype=Object.create(bt.prototype),Qo.prototype.constructor=Qo,Qo.prototype.dispose=function(){this.children[0].geometry.dispose(),this.children[0].material.dispose()},Qo.prototype.update=function(){var t=new F,e=new ct,i=new ct;return function(){var n=this.children[0];if(void 0!==this.color)this.material.color.set(this.color);else{var r=n.geometry.getAttribute("color");e.copy(this.light.color),i.copy(this.light.groundColor);for(var a=0,o=r.count;a<o;a++){var s=a<o/2?e:i;r.setXYZ(a,s.r,s.g,s.b)}r.needsUpdate=!0}n.lookAt(t.setFromMatrixPosition(this.light.matrixWorld).negate())}}(),Ko.prototype=Object.create(rn.prototype),Ko.prototype.constructor=Ko,$o.prototype=Object.create(rn.prototype),$o.prototype.constructor=$o,ts.prototype=Object.create(rn.prototype),ts.prototype.constructor=ts,ts.prototype.update=function(){var t=new F,e=new F,i=new G;return function(){this.object.updateMatrixWorld(!0),i.getNormalMatrix(this.object.matrixWorld);for(var n=this.object.matrixWorld,r=this.geometry.attributes.position,a=this.object.geometry,o=a.vertices,s=a.faces,c=0,h=0,l=s.length;h<l;h++){var u=s[h],p=u.normal;t.copy(o[u.a]).add(o[u.b]).add(o[u.c]).divideScalar(3).applyMatrix4(n),e.copy(p).applyMatrix3(i).normalize().multiplyScalar(this.size).add(t),r.setXYZ(c,t.x,t.y,t.z),c+=1,r.setXYZ(c,e.x,e.y,e.z),c+=1}r.needsUpdate=!0}}(),es.prototype=Object.create(bt.prototype),es.prototype.constructor=es,es.prototype.dispose=function(){this.lightPlane.geometry.dispose(),this.lightPlane.material.dispose(),this.targetLine.geometry.dispose(),this.targetLine.material.dispose()},es.prototype.update=function(){var t=new F,e=new F,i=new F;return function(){t.setFromMatrixPosition(this.light.matrixWorld),e.setFromMatrixPosition(this.light.target.matrixWorld),i.subVectors(e,t),this.lightPlane.lookAt(i),void 0!==this.color?(this.lightPlane.material.color.set(this.color),this.targetLine.material.color.set(this.color)):(this.lightPlane.material.color.copy(this.light.color),this.targetLine.material.color.copy(this.light.color)),this.targetLine.lookAt(i),this.targetLine.scale.z=i.length()}}(),is.prototype=Object.create(rn.prototype),is.prototype.constructor=is,is.prototype.update=function(){var t,e,i=new F,n=new wt;function r(r,a,o,s){i.set(a,o,s).unproject(n);var c=e[r];if(void 0!==c)for(var h=t.getAttribute("position"),l=0,u=c.length;l<u;l++)h.setXYZ(c[l],i.x,i.y,i.z)}return function(){t=this.geometry,e=this.pointMap;n.projectionMatrix.copy(this.camera.projectionMatrix),r("c",0,0,-1),r("t",0,0,1),r("n1",-1,-1,-1),r("n2",1,-1,-1),r("n3",-1,1,-1),r("n4",1,1,-1),r("f1",-1,-1,1),r("f2",1,-1,1),r("f3",-1,1,1),r("f4",1,1,1),r("u1",.7,1.1,-1),r("u2",-.7,1.1,-1),r("u3",0,2,-1),r("cf1",-1,0,1),r("cf2",1,0,1),r("cf3",0,-1,1),r("cf4",0,1,1),r("cn1",-1,0,-1),r("cn2",1,0,-1),r("cn3",0,-1,-1),r("cn4",0,1,-1),t.getAttribute("position").needsUpdate=!0}}(),ns.prototype=Object.create(rn.prototype),ns.prototype.constructor=ns,ns.prototype.update=function(){var t=new tt;return function(e){if(void 0!==e&&console.warn("THREE.BoxHelper: .update() has no longer arguments."),void 0!==this.object&&t.setFromObject(this.object),!t.isEmpty()){var i=t.min,n=t.max,r=this.geometry.attributes.position,a=r.array;a[0]=n.x,a[1]=n.y,a[2]=n.z,a[3]=i.x,a[4]=n.y,a[5]=n.z,a[6]=i.x,a[7]=i.y,a[8]=n.z,a[9]=n.x,a[10]=i.y,a[11]=n.z,a[12]=n.x,a[13]=n.y,a[14]=i.z,a[15]=i.x,a[16]=n.y,a[17]=i.z,a[18]=i.x,a[19]=i.y,a[20]=i.z,a[21]=n.x,a[22]=i.y,a[23]=i.z,r.needsUpdate=!0,this.geometry.computeBoundingSphere()}}}(),ns.prototype.setFromObject=function(t){return this.object=t,this.update(),this},rs.prototype=Object.create(rn.prototype),rs.prototype.constructor=rs,rs.prototype.updateMatrixWorld=function(t){var e=this.box;e.isEmpty()||(e.getCenter(this.position),e.getSize(this.scale),this.scale.multiplyScalar(.5),bt.prototype.updateMatrixWorld.call(this,t))},as.prototype=Object.create(nn.prototype),as.prototype.constructor=as,as.prototype.updateMatrixWorld=function(t){var e=-this.plane.constant;Math.abs(e)<1e-8&&(e=1e-8),this.scale.set(.5*this.size,.5*this.size,e),this.lookAt(this.plane.normal),bt.prototype.updateMatrixWorld.call(this,t)},os.prototype=Object.create(bt.prototype),os.prototype.constructor=os,os.prototype.setDirection=(Lo=new F,function(t){t.y>.99999?this.quaternion.set(0,0,0,1):t.y<-.99999?this.quaternion.set(1,0,0,0):(Lo.set(t.z,0,-t.x).normalize(),Ao=Math.acos(t.y),this.quaternion.setFromAxisAngle(Lo,Ao))}),os.prototype.setLength=function(t,e,i){void 0===e&&(e=.2*t),void 0===i&&(i=.2*e),this.line.scale.set(1,Math.max(0,t-e),1),this.line.updateMatrix(),this.cone.scale.set(i,e,i),this.cone.position.y=t,this.cone.updateMatrix()},os.prototype.setColor=function(t){this.line.material.color.copy(t),this.cone.material.color.copy(t)},ss.prototype=Object.create(rn.prototype),ss.prototype.constructor=ss,Gr.create=function(t,e){return console.log("THREE.Curve.create() has been deprecated"),t.prototype=Object.create(Gr.prototype),t.prototype.constructor=t,t.prototype.getPoint=e,t},Object.assign(sa.prototype,{createPointsGeometry:function(t){console.warn("THREE.CurvePath: .createPointsGeometry() has been removed. Use new THREE.Geometry().setFromPoints( points ) instead.");var e=this.getPoints(t);return this.createGeometry(e)},createSpacedPointsGeometry:function(t){console.warn("THREE.CurvePath: .createSpacedPointsGeometry() has been removed. Use new THREE.Geometry().setFromPoints( points ) instead.");var e=this.getSpacedPoints(t);return this.createGeometry(e)},createGeometry:function(t){console.warn("THREE.CurvePath: .createGeometry() has been removed. Use new THREE.Geometry().setFromPoints( points ) instead.");for(var e=new At,i=0,n=t.length;i<n;i++){var r=t[i];e.vertices.push(new F(r.x,r.y,r.z||0))}return e}}),Object.assign(ca.prototype,{fromPoints:function(t){console.warn("THREE.Path: .fromPoints() has been renamed to .setFromPoints()."),this.setFromPoints(t)}}),cs.prototype=Object.create(Zr.prototype),Object.assign(cs.prototype,{initFromArray:function(){console.error("THREE.Spline: .initFromArray() has been removed.")},getControlPointsArray:function(){console.error("THREE.Spline: .getControlPointsArray() has been removed.")},reparametrizeByArcLength:function(){console.error("THREE.Spline: .reparametrizeByArcLength() has been removed.")}}),Ko.prototype.setColors=function(){console.error("THREE.GridHelper: setColors() has been deprecated, pass them in the constructor instead.")},Yo.prototype.update=function(){console.error("THREE.SkeletonHelper: update() no longer needs to be called.")},Object.assign(Ga.prototype,{extractUrlBase:function(t){return console.warn("THREE.Loader: .extractUrlBase() has been deprecated. Use THREE.LoaderUtils.extractUrlBase() instead."),Ha(t)}}),Object.assign(Vo.prototype,{center:function(t){return console.warn("THREE.Box2: .center() has been renamed to .getCenter()."),this.getCenter(t)},empty:function(){return console.warn("THREE.Box2: .empty() has been renamed to .isEmpty()."),this.isEmpty()},isIntersectionBox:function(t){return console.warn("THREE.Box2: .isIntersectionBox() has been renamed to .intersectsBox()."),this.intersectsBox(t)},size:function(t){return console.warn("THREE.Box2: .size() has been renamed to .getSize()."),this.getSize(t)}}),Object.assign(tt.prototype,{center:function(t){return console.warn("THREE.Box3: .center() has been renamed to .getCenter()."),this.getCenter(t)},empty:function(){return console.warn("THREE.Box3: .empty() has been renamed to .isEmpty()."),this.isEmpty()},isIntersectionBox:function(t){return console.warn("THREE.Box3: .isIntersectionBox() has been renamed to .intersectsBox()."),this.intersectsBox(t)},isIntersectionSphere:function(t){return console.warn("THREE.Box3: .isIntersectionSphere() has been renamed to .intersectsSphere()."),this.intersectsSphere(t)},size:function(t){return console.warn("THREE.Box3: .size() has been renamed to .getSize()."),this.getSize(t)}}),re.prototype.center=function(t){return console.warn("THREE.Line3: .center() has been renamed to .getCenter()."),this.getCenter(t)},Object.assign(D,{random16:function(){return console.warn("THREE.Math: .random16() has been deprecated. Use Math.random() instead."),Math.random()},nearestPowerOfTwo:function(t){return console.warn("THREE.Math: .nearestPowerOfTwo() has been renamed to .floorPowerOfTwo()."),D.floorPowerOfTwo(t)},nextPowerOfTwo:function(t){return console.warn("THREE.Math: .nextPowerOfTwo() has been renamed to .ceilPowerOfTwo()."),D.ceilPowerOfTwo(t)}}),Object.assign(G.prototype,{flattenToArrayOffset:function(t,e){return console.warn("THREE.Matrix3: .flattenToArrayOffset() has been deprecated. Use .toArray() instead."),this.toArray(t,e)},multiplyVector3:function(t){return console.warn("THREE.Matrix3: .multiplyVector3() has been removed. Use vector.applyMatrix3( matrix ) instead."),t.applyMatrix3(this)},multiplyVector3Array:function(){console.error("THREE.Matrix3: .multiplyVector3Array() has been removed.")},applyToBuffer:function(t){return console.warn("THREE.Matrix3: .applyToBuffer() has been removed. Use matrix.applyToBufferAttribute( attribute ) instead."),this.applyToBufferAttribute(t)},applyToVector3Array:function(){console.error("THREE.Matrix3: .applyToVector3Array() has been removed.")}}),Object.assign(z.prototype,{extractPosition:function(t){return console.warn("THREE.Matrix4: .extractPosition() has been renamed to .copyPosition()."),this.copyPosition(t)},flattenToArrayOffset:function(t,e){return console.warn("THREE.Matrix4: .flattenToArrayOffset() has been deprecated. Use .toArray() instead."),this.toArray(t,e)},getPosition:function(){var t;return function(){return void 0===t&&(t=new F),console.warn("THREE.Matrix4: .getPosition() has been removed. Use Vector3.setFromMatrixPosition( matrix ) instead."),t.setFromMatrixColumn(this,3)}}(),setRotationFromQuaternion:function(t){return console.warn("THREE.Matrix4: .setRotationFromQuaternion() has been renamed to .makeRotationFromQuaternion()."),this.makeRotationFromQuaternion(t)},multiplyToArray:function(){console.warn("THREE.Matrix4: .multiplyToArray() has been removed.")},multiplyVector3:function(t){return console.warn("THREE.Matrix4: .multiplyVector3() has been removed. Use vector.applyMatrix4( matrix ) instead."),t.applyMatrix4(this)},multiplyVector4:function(t){return console.warn("THREE.Matrix4: .multiplyVector4() has been removed. Use vector.applyMatrix4( matrix ) instead."),t.applyMatrix4(this)},multiplyVector3Array:function(){console.error("THREE.Matrix4: .multiplyVector3Array() has been removed.")},rotateAxis:function(t){console.warn("THREE.Matrix4: .rotateAxis() has been removed. Use Vector3.transformDirection( matrix ) instead."),t.transformDirection(this)},crossVector:function(t){return console.warn("THREE.Matrix4: .crossVector() has been removed. Use vector.applyMatrix4( matrix ) instead."),t.applyMatrix4(this)},translate:function(){console.error("THREE.Matrix4: .translate() has been removed.")},rotateX:function(){console.error("THREE.Matrix4: .rotateX() has been removed.")},rotateY:function(){console.error("THREE.Matrix4: .rotateY() has been removed.")},rotateZ:function(){console.error("THREE.Matrix4: .rotateZ() has been removed.")},rotateByAxis:function(){console.error("THREE.Matrix4: .rotateByAxis() has been removed.")},applyToBuffer:function(t){return console.warn("THREE.Matrix4: .applyToBuffer() has been removed. Use matrix.applyToBufferAttribute( attribute ) instead."),this.applyToBufferAttribute(t)},applyToVector3Array:function(){console.error("THREE.Matrix4: .applyToVector3Array() has been removed.")},makeFrustum:function(t,e,i,n,r,a){return console.warn("THREE.Matrix4: .makeFrustum() has been removed. Use .makePerspective( left, right, top, bottom, near, far ) instead."),this.makePerspective(t,e,n,i,r,a)}}),it.prototype.isIntersectionLine=function(t){return console.warn("THREE.Plane: .isIntersectionLine() has been renamed to .intersectsLine()."),this.intersectsLine(t)},B.prototype.multiplyVector3=function(t){return console.warn("THREE.Quaternion: .multiplyVector3() has been removed. Use is now vector.applyQuaternion( quaternion ) instead."),t.applyQuaternion(this)},Object.assign(ne.prototype,{isIntersectionBox:function(t){return console.warn("THREE.Ray: .isIntersectionBox() has been renamed to .intersectsBox()."),this.intersectsBox(t)},isIntersectionPlane:function(t){return console.warn("THREE.Ray: .isIntersectionPlane() has been renamed to .intersectsPlane()."),this.intersectsPlane(t)},isIntersectionSphere:function(t){return console.warn("THREE.Ray: .isIntersectionSphere() has been renamed to .intersectsSphere()."),this.intersectsSphere(t)}}),Object.assign(ae.prototype,{area:function(){return console.warn("THREE.Triangle: .area() has been renamed to .getArea()."),this.getArea()},barycoordFromPoint:function(t,e){return console.warn("THREE.Triangle: .barycoordFromPoint() has been renamed to .getBarycoord()."),this.getBarycoord(t,e)},midpoint:function(t){return console.warn("THREE.Triangle: .midpoint() has been renamed to .getMidpoint()."),this.getMidpoint(t)},normal:function(t){return console.warn("THREE.Triangle: .normal() has been renamed to .getNormal()."),this.getNormal(t)},plane:function(t){return console.warn("THREE.Triangle: .plane() has been renamed to .getPlane()."),this.getPlane(t)}}),Object.assign(ae,{barycoordFromPoint:function(t,e,i,n,r){return console.warn("THREE.Triangle: .barycoordFromPoint() has been renamed to .getBarycoord()."),ae.getBarycoord(t,e,i,n,r)},normal:function(t,e,i,n){return console.warn("THREE.Triangle: .normal() has been renamed to .getNormal()."),ae.getNormal(t,e,i,n)}}),Object.assign(ha.prototype,{extractAllPoints:function(t){return console.warn("THREE.Shape: .extractAllPoints() has been removed. Use .extractPoints() instead."),this.extractPoints(t)},extrude:function(t){return console.warn("THREE.Shape: .extrude() has been removed. Use ExtrudeGeometry() instead."),new ir(this,t)},makeGeometry:function(t){return console.warn("THREE.Shape: .makeGeometry() has been removed. Use ShapeGeometry() instead."),new dr(this,t)}}),Object.assign(U.prototype,{fromAttribute:function(t,e,i){return console.warn("THREE.Vector2: .fromAttribute() has been renamed to .fromBufferAttribute()."),this.fromBufferAttribute(t,e,i)},distanceToManhattan:function(t){return console.warn("THREE.Vector2: .distanceToManhattan() has been renamed to .manhattanDistanceTo()."),this.manhattanDistanceTo(t)},lengthManhattan:function(){return console.warn("THREE.Vector2: .lengthManhattan() has been renamed to .manhattanLength()."),this.manhattanLength()}}),Object.assign(F.prototype,{setEulerFromRotationMatrix:function(){console.error("THREE.Vector3: .setEulerFromRotationMatrix() has been removed. Use Euler.setFromRotationMatrix() instead.")},setEulerFromQuaternion:function(){console.error("THREE.Vector3: .setEulerFromQuaternion() has been removed. Use Euler.setFromQuaternion() instead.")},getPositionFromMatrix:function(t){return console.warn("THREE.Vector3: .getPositionFromMatrix() has been renamed to .setFromMatrixPosition()."),this.setFromMatrixPosition(t)},getScaleFromMatrix:function(t){return console.warn("THREE.Vector3: .getScaleFromMatrix() has been renamed to .setFromMatrixScale()."),this.setFromMatrixScale(t)},getColumnFromMatrix:function(t,e){return console.warn("THREE.Vector3: .getColumnFromMatrix() has been renamed to .setFromMatrixColumn()."),this.setFromMatrixColumn(e,t)},applyProjection:function(t){return console.warn("THREE.Vector3: .applyProjection() has been removed. Use .applyMatrix4( m ) instead."),this.applyMatrix4(t)},fromAttribute:function(t,e,i){return console.warn("THREE.Vector3: .fromAttribute() has been renamed to .fromBufferAttribute()."),this.fromBufferAttribute(t,e,i)},distanceToManhattan:function(t){return console.warn("THREE.Vector3: .distanceToManhattan() has been renamed to .manhattanDistanceTo()."),this.manhattanDistanceTo(t)},lengthManhattan:function(){return console.warn("THREE.Vector3: .lengthManhattan() has been renamed to .manhattanLength()."),this.manhattanLength()}}),Object.assign(J.prototype,{fromAttribute:function(t,e,i){return console.warn("THREE.Vector4: .fromAttribute() has been renamed to .fromBufferAttribute()."),this.fromBufferAttribute(t,e,i)},lengthManhattan:function(){return console.warn("THREE.Vector4: .lengthManhattan() has been renamed to .manhattanLength()."),this.manhattanLength()}}),Object.assign(At.prototype,{computeTangents:function(){console.error("THREE.Geometry: .computeTangents() has been removed.")},computeLineDistances:function(){console.error("THREE.Geometry: .computeLineDistances() has been removed. Use THREE.Line.computeLineDistances() instead.")}}),Object.assign(bt.prototype,{getChildByName:function(t){return console.warn("THREE.Object3D: .getChildByName() has been renamed to .getObjectByName()."),this.getObjectByName(t)},renderDepth:function(){console.warn("THREE.Object3D: .renderDepth has been removed. Use .renderOrder, instead.")},translate:function(t,e){return console.warn("THREE.Object3D: .translate() has been removed. Use .translateOnAxis( axis, distance ) instead."),this.translateOnAxis(e,t)},getWorldRotation:function(){console.error("THREE.Object3D: .getWorldRotation() has been removed. Use THREE.Object3D.getWorldQuaternion( target ) instead.")}}),Object.defineProperties(bt.prototype,{eulerOrder:{get:function(){return console.warn("THREE.Object3D: .eulerOrder is now .rotation.order."),this.rotation.order},set:function(t){console.warn("THREE.Object3D: .eulerOrder is now .rotation.order."),this.rotation.order=t}},useQuaternion:{get:function(){console.warn("THREE.Object3D: .useQuaternion has been removed. The library now uses quaternions by default.")},set:function(){console.warn("THREE.Object3D: .useQuaternion has been removed. The library now uses quaternions by default.")}}}),Object.defineProperties(Qi.prototype,{objects:{get:function(){return console.warn("THREE.LOD: .objects has been renamed to .levels."),this.levels}}}),Object.defineProperty(Ki.prototype,"useVertexTexture",{get:function(){console.warn("THREE.Skeleton: useVertexTexture has been removed.")},set:function(){console.warn("THREE.Skeleton: useVertexTexture has been removed.")}}),Object.defineProperty(Gr.prototype,"__arcLengthDivisions",{get:function(){return console.warn("THREE.Curve: .__arcLengthDivisions is now .arcLengthDivisions."),this.arcLengthDivisions},set:function(t){console.warn("THREE.Curve: .__arcLengthDivisions is now .arcLengthDivisions."),this.arcLengthDivisions=t}}),Hi.prototype.setLens=function(t,e){console.warn("THREE.PerspectiveCamera.setLens is deprecated. Use .setFocalLength and .filmGauge for a photographic setup."),void 0!==e&&(this.filmGauge=e),this.setFocalLength(t)},Object.defineProperties(la.prototype,{onlyShadow:{set:function(){console.warn("THREE.Light: .onlyShadow has been removed.")}},shadowCameraFov:{set:function(t){console.warn("THREE.Light: .shadowCameraFov is now .shadow.camera.fov."),this.shadow.camera.fov=t}},shadowCameraLeft:{set:function(t){console.warn("THREE.Light: .shadowCameraLeft is now .shadow.camera.left."),this.shadow.camera.left=t}},shadowCameraRight:{set:function(t){console.warn("THREE.Light: .shadowCameraRight is now .shadow.camera.right."),this.shadow.camera.right=t}},shadowCameraTop:{set:function(t){console.warn("THREE.Light: .shadowCameraTop is now .shadow.camera.top."),this.shadow.camera.top=t}},shadowCameraBottom:{set:function(t){console.warn("THREE.Light: .shadowCameraBottom is now .shadow.camera.bottom."),this.shadow.camera.bottom=t}},shadowCameraNear:{set:function(t){console.warn("THREE.Light: .shadowCameraNear is now .shadow.camera.near."),this.shadow.camera.near=t}},shadowCameraFar:{set:function(t){console.warn("THREE.Light: .shadowCameraFar is now .shadow.camera.far."),this.shadow.camera.far=t}},shadowCameraVisible:{set:function(){console.warn("THREE.Light: .shadowCameraVisible has been removed. Use new THREE.CameraHelper( light.shadow.camera ) instead.")}},shadowBias:{set:function(t){console.warn("THREE.Light: .shadowBias is now .shadow.bias."),this.shadow.bias=t}},shadowDarkness:{set:function(){console.warn("THREE.Light: .shadowDarkness has been removed.")}},shadowMapWidth:{set:function(t){console.warn("THREE.Light: .shadowMapWidth is now .shadow.mapSize.width."),this.shadow.mapSize.width=t}},shadowMapHeight:{set:function(t){console.warn("THREE.Light: .shadowMapHeight is now .shadow.mapSize.height."),this.shadow.mapSize.height=t}}}),Object.defineProperties(Lt.prototype,{length:{get:function(){return console.warn("THREE.BufferAttribute: .length has been deprecated. Use .count instead."),this.array.length}},copyIndicesArray:function(){console.error("THREE.BufferAttribute: .copyIndicesArray() has been removed.")}}),Object.assign(Gt.prototype,{addIndex:function(t){console.warn("THREE.BufferGeometry: .addIndex() has been renamed to .setIndex()."),this.setIndex(t)},addDrawCall:function(t,e,i){void 0!==i&&console.warn("THREE.BufferGeometry: .addDrawCall() no longer supports indexOffset."),console.warn("THREE.BufferGeometry: .addDrawCall() is now .addGroup()."),this.addGroup(t,e)},clearDrawCalls:function(){console.warn("THREE.BufferGeometry: .clearDrawCalls() is now .clearGroups()."),this.clearGroups()},computeTangents:function(){console.warn("THREE.BufferGeometry: .computeTangents() has been removed.")},computeOffsets:function(){console.warn("THREE.BufferGeometry: .computeOffsets() has been removed.")}}),Object.defineProperties(Gt.prototype,{drawcalls:{get:function(){return console.error("THREE.BufferGeometry: .drawcalls has been renamed to .groups."),this.groups}},offsets:{get:function(){return console.warn("THREE.BufferGeometry: .offsets has been renamed to .groups."),this.groups}}}),Object.assign(nr.prototype,{getArrays:function(){console.error("THREE.ExtrudeBufferGeometry: .getArrays() has been removed.")},addShapeList:function(){console.error("THREE.ExtrudeBufferGeometry: .addShapeList() has been removed.")},addShape:function(){console.error("THREE.ExtrudeBufferGeometry: .addShape() has been removed.")}}),Object.defineProperties(Io.prototype,{dynamic:{set:function(){console.warn("THREE.Uniform: .dynamic has been removed. Use object.onBeforeRender() instead.")}},onUpdate:{value:function(){return console.warn("THREE.Uniform: .onUpdate() has been removed. Use object.onBeforeRender() instead."),this}}}),Object.defineProperties(te.prototype,{wrapAround:{get:function(){console.warn("THREE.Material: .wrapAround has been removed.")},set:function(){console.warn("THREE.Material: .wrapAround has been removed.")}},wrapRGB:{get:function(){return console.warn("THREE.Material: .wrapRGB has been removed."),new ct}},shading:{get:function(){console.error("THREE."+this.type+": .shading has been removed. Use the boolean .flatShading instead.")},set:function(t){console.warn("THREE."+this.type+": .shading has been removed. Use the boolean .flatShading instead."),this.flatShading=1===t}}}),Object.defineProperties(Lr.prototype,{metal:{get:function(){return console.warn("THREE.MeshPhongMaterial: .metal has been removed. Use THREE.MeshStandardMaterial instead."),!1},set:function(){console.warn("THREE.MeshPhongMaterial: .metal has been removed. Use THREE.MeshStandardMaterial instead")}}}),Object.defineProperties(ie.prototype,{derivatives:{get:function(){return console.warn("THREE.ShaderMaterial: .derivatives has been moved to .extensions.derivatives."),this.extensions.derivatives},set:function(t){console.warn("THREE. ShaderMaterial: .derivatives has been moved to .extensions.derivatives."),this.extensions.derivatives=t}}}),Object.assign(Wi.prototype,{getCurrentRenderTarget:function(){return console.warn("THREE.WebGLRenderer: .getCurrentRenderTarget() is now .getRenderTarget()."),this.getRenderTarget()},getMaxAnisotropy:function(){return console.warn("THREE.WebGLRenderer: .getMaxAnisotropy() is now .capabilities.getMaxAnisotropy()."),this.capabilities.getMaxAnisotropy()},getPrecision:function(){return console.warn("THREE.WebGLRenderer: .getPrecision() is now .capabilities.precision."),this.capabilities.precision},resetGLState:function(){return console.warn("THREE.WebGLRenderer: .resetGLState() is now .state.reset()."),this.state.reset()},supportsFloatTextures:function(){return console.warn("THREE.WebGLRenderer: .supportsFloatTextures() is now .extensions.get( 'OES_texture_float' )."),this.extensions.get("OES_texture_float")},supportsHalfFloatTextures:function(){return console.warn("THREE.WebGLRenderer: .supportsHalfFloatTextures() is now .extensions.get( 'OES_texture_half_float' )."),this.extensions.get("OES_texture_half_float")},supportsStandardDerivatives:function(){return console.warn("THREE.WebGLRenderer: .supportsStandardDerivatives() is now .extensions.get( 'OES_standard_derivatives' )."),this.extensions.get("OES_standard_derivatives")},supportsCompressedTextureS3TC:function(){return console.warn("THREE.WebGLRenderer: .supportsCompressedTextureS3TC() is now .extensions.get( 'WEBGL_compressed_texture_s3tc' )."),this.extensions.get("WEBGL_compressed_texture_s3tc")},supportsCompressedTexturePVRTC:function(){return console.warn("THREE.WebGLRenderer: .supportsCompressedTexturePVRTC() is now .extensions.get( 'WEBGL_compressed_texture_pvrtc' )."),this.extensions.get("WEBGL_compressed_texture_pvrtc")},supportsBlendMinMax:function(){return console.warn("THREE.WebGLRenderer: .supportsBlendMinMax() is now .extensions.get( 'EXT_blend_minmax' )."),this.extensions.get("EXT_blend_minmax")},supportsVertexTextures:function(){return console.warn("THREE.WebGLRenderer: .supportsVertexTextures() is now .capabilities.vertexTextures."),this.capabilities.vertexTextures},supportsInstancedArrays:function(){return console.warn("THREE.WebGLRenderer: .supportsInstancedArrays() is now .extensions.get( 'ANGLE_instanced_arrays' )."),this.extensions.get("ANGLE_instanced_arrays")},enableScissorTest:function(t){console.warn("THREE.WebGLRenderer: .enableScissorTest() is now .setScissorTest()."),this.setScissorTest(t)},initMaterial:function(){console.warn("THREE.WebGLRenderer: .initMaterial() has been removed.")},addPrePlugin:function(){console.warn("THREE.WebGLRenderer: .addPrePlugin() has been removed.")},addPostPlugin:function(){console.warn("THREE.WebGLRenderer: .addPostPlugin() has been removed.")},updateShadowMap:function(){console.warn("THREE.WebGLRenderer: .updateShadowMap() has been removed.")},setFaceCulling:function(){console.warn("THREE.WebGLRenderer: .setFaceCulling() has been removed.")}}),Object.defineProperties(Wi.prototype,{shadowMapEnabled:{get:function(){return this.shadowMap.enabled},set:function(t){console.warn("THREE.WebGLRenderer: .shadowMapEnabled is now .shadowMap.enabled."),this.shadowMap.enabled=t}},shadowMapType:{get:function(){return this.shadowMap.type},set:function(t){console.warn("THREE.WebGLRenderer: .shadowMapType is now .shadowMap.type."),this.shadowMap.type=t}},shadowMapCullFace:{get:function(){console.warn("THREE.WebGLRenderer: .shadowMapCullFace has been removed. Set Material.shadowSide instead.")},set:function(){console.warn("THREE.WebGLRenderer: .shadowMapCullFace has been removed. Set Material.shadowSide instead.")}}}),Object.defineProperties(Di.prototype,{cullFace:{get:function(){console.warn("THREE.WebGLRenderer: .shadowMap.cullFace has been removed. Set Material.shadowSide instead.")},set:function(){console.warn("THREE.WebGLRenderer: .shadowMap.cullFace has been removed. Set Material.shadowSide instead.")}},renderReverseSided:{get:function(){console.warn("THREE.WebGLRenderer: .shadowMap.renderReverseSided has been removed. Set Material.shadowSide instead.")},set:function(){console.warn("THREE.WebGLRenderer: .shadowMap.renderReverseSided has been removed. Set Material.shadowSide instead.")}},renderSingleSided:{get:function(){console.warn("THREE.WebGLRenderer: .shadowMap.renderSingleSided has been removed. Set Material.shadowSide instead.")},set:function(){console.warn("THREE.WebGLRenderer: .shadowMap.renderSingleSided has been removed. Set Material.shadowSide instead.")}}}),Object.defineProperties(Q.prototype,{wrapS:{get:function(){return console.warn("THREE.WebGLRenderTarget: .wrapS is now .texture.wrapS."),this.texture.wrapS},set:function(t){console.warn("THREE.WebGLRenderTarget: .wrapS is now .texture.wrapS."),this.texture.wrapS=t}},wrapT:{get:function(){return console.warn("THREE.WebGLRenderTarget: .wrapT is now .texture.wrapT."),this.texture.wrapT},set:function(t){console.warn("THREE.WebGLRenderTarget: .wrapT is now .texture.wrapT."),this.texture.wrapT=t}},magFilter:{get:function(){return console.warn("THREE.WebGLRenderTarget: .magFilter is now .texture.magFilter."),this.texture.magFilter},set:function(t){console.warn("THREE.WebGLRenderTarget: .magFilter is now .texture.magFilter."),this.texture.magFilter=t}},minFilter:{get:function(){return console.warn("THREE.WebGLRenderTarget: .minFilter is now .texture.minFilter."),this.texture.minFilter},set:function(t){console.warn("THREE.WebGLRenderTarget: .minFilter is now .texture.minFilter."),this.texture.minFilter=t}},anisotropy:{get:function(){return console.warn("THREE.WebGLRenderTarget: .anisotropy is now .texture.anisotropy."),this.texture.anisotropy},set:function(t){console.warn("THREE.WebGLRenderTarget: .anisotropy is now .texture.anisotropy."),this.texture.anisotropy=t}},offset:{get:function(){return console.warn("THREE.WebGLRenderTarget: .offset is now .texture.offset."),this.texture.offset},set:function(t){console.warn("THREE.WebGLRenderTarget: .offset is now .texture.offset."),this.texture.offset=t}},repeat:{get:function(){return console.warn("THREE.WebGLRenderTarget: .repeat is now .texture.repeat."),this.texture.repeat},set:function(t){console.warn("THREE.WebGLRenderTarget: .repeat is now .texture.repeat."),this.texture.repeat=t}},format:{get:function(){return console.warn("THREE.WebGLRenderTarget: .format is now .texture.format."),this.texture.format},set:function(t){console.warn("THREE.WebGLRenderTarget: .format is now .texture.format."),this.texture.format=t}},type:{get:function(){return console.warn("THREE.WebGLRenderTarget: .type is now .texture.type."),this.texture.type},set:function(t){console.warn("THREE.WebGLRenderTarget: .type is now .texture.type."),this.texture.type=t}},generateMipmaps:{get:function(){return console.warn("THREE.WebGLRenderTarget: .generateMipmaps is now .texture.generateMipmaps."),this.texture.generateMipmaps},set:function(t){console.warn("THREE.WebGLRenderTarget: .generateMipmaps is now .texture.generateMipmaps."),this.texture.generateMipmaps=t}}}),Object.defineProperties(ji.prototype,{standing:{set:function(){console.warn("THREE.WebVRManager: .standing has been removed.")}}}),uo.prototype.load=function(t){console.warn("THREE.Audio: .load has been deprecated. Use THREE.AudioLoader instead.");var e=this;return(new co).load(t,(function(t){e.setBuffer(t)})),this},fo.prototype.getData=function(){return console.warn("THREE.AudioAnalyser: .getData() is now .getFrequencyData()."),this.getFrequencyData()},ho.prototype.updateCubeMap=function(t,e){return console.warn("THREE.CubeCamera: .updateCubeMap() is now .update()."),this.update(t,e)};class hs{constructor(t){this.elapsedTime=t||0,this.running=!1}getElapsedTime(){if(this.running){const t=performance.now()/1e3;this.elapsedTime+=t-this.oldTime,this.oldTime=t}return this.elapsedTime}start(){this.oldTime=performance.now()/1e3,this.running=!0}pause(){this.running=!1}}let ls=1;const us=new lo,ps=new co;var ds=null,fs=null,ms=65280,gs=16711935,vs=16711680,ys=65280,xs=255,bs=3145728,ws=1792,_s=window.Math,Ms=function(t){var e=(16711680&t)>>16,i=(65280&t)>>8,n=255&t;return((.299*e+.587*i+.114*n|0)<<16)+((-.169*e-.331*i+.5*n+128|0)<<8)+(.5*e-.419*i-.081*n+128|0)},Es=function(t,e){var i=Ms(t),n=Ms(e);return _s.abs((i&vs)-(n&vs))>bs||_s.abs((i&ys)-(n&ys))>ws||_s.abs((i&xs)-(n&xs))>6},Ts=function(t,e,i){e!==i?(fs[t]=(3*(e&ms)+(i&ms)>>2&ms)+(3*(e&gs)+(i&gs)>>2&gs),fs[t]|=4278190080&e):fs[t]=e},Ss=function(t,e,i,n){fs[t]=(((e&ms)<<1)+(i&ms)+(n&ms)>>2&ms)+(((e&gs)<<1)+(i&gs)+(n&gs)>>2&gs),fs[t]|=4278190080&e},As=function(t,e,i){e!==i?(fs[t]=(7*(e&ms)+(i&ms)>>3&ms)+(7*(e&gs)+(i&gs)>>3&gs),fs[t]|=4278190080&e):fs[t]=e},Ls=function(t,e,i,n){fs[t]=(((e&ms)<<1)+7*(i&ms)+7*(n&ms)>>4&ms)+(((e&gs)<<1)+7*(i&gs)+7*(n&gs)>>4&gs),fs[t]|=4278190080&e},Rs=function(t,e,i){e!==i?(fs[t]=((e&ms)+(i&ms)>>1&ms)+((e&gs)+(i&gs)>>1&gs),fs[t]|=4278190080&e):fs[t]=e},Ps=function(t,e,i,n){fs[t]=(5*(e&ms)+((i&ms)<<1)+(n&ms)>>3&ms)+(5*(e&gs)+((i&gs)<<1)+(n&gs)>>3&gs),fs[t]|=4278190080&e},Cs=function(t,e,i,n){fs[t]=(6*(e&ms)+(i&ms)+(n&ms)>>3&ms)+(6*(e&gs)+(i&gs)+(n&gs)>>3&gs),fs[t]|=4278190080&e},Os=function(t,e,i){e!==i?(fs[t]=(5*(e&ms)+3*(i&ms)>>3&ms)+(5*(e&gs)+3*(i&gs)>>3&gs),fs[t]|=4278190080&e):fs[t]=e},ks=function(t,e,i,n){fs[t]=(((e&ms)<<1)+3*(i&ms)+3*(n&ms)>>3&ms)+(((e&gs)<<1)+3*(i&gs)+3*(n&gs)>>3&gs),fs[t]|=4278190080&e},Is=function(t,e){var i=e.charAt(0).toUpperCase()+e.substr(1);return t[e]||t["ms"+i]||t["moz"+i]||t["webkit"+i]||t["o"+i]};function Ns(t,e){if(-1===[2,3,4].indexOf(e))return t;var i,n,r,a;t instanceof HTMLCanvasElement?(n=(i=t).getContext("2d"),r=i,a=n.getImageData(0,0,i.width,i.height).data):(a=function(t,e,i,n,r){var a=document.createElement("canvas"),o=a.getContext("2d"),s=Is(o,"backingStorePixelRatio")||1;o.getImageDataHD=Is(o,"getImageDataHD");var c=t.width/s,h=t.height/s;return a.width=Math.ceil(c),a.height=Math.ceil(h),o.drawImage(t,0,0,c,h),1===s?o.getImageData(e,i,n,r):o.getImageDataHD(e,i,n,r)}(t,0,0,t.width,t.height).data,r=document.createElement("canvas"));for(var o,s=t.width*t.height,c=ds=new Array(s),h=fs=new Array(s*e*e),l=0;l<s;l++)c[l]=(a[3+(o=l<<2)]<<24)+(a[o+2]<<16)+(a[o+1]<<8)+a[o];2===e?Ds(t.width,t.height):3===e?Us(t.width,t.height):4===e&&zs(t.width,t.height),r.width=t.width*e,r.height=t.height*e;for(var u,p,d=r.getContext("2d"),f=d.getImageData(0,0,r.width,r.height),m=f.data,g=h.length,v=0;v<g;v++)p=(4278190080&(u=h[v]))>>24,m[3+(o=v<<2)]=p<0?p+256:0,m[o+2]=(16711680&u)>>16,m[o+1]=(65280&u)>>8,m[o]=255&u;return ds=c=null,fs=h=null,d.putImageData(f,0,0),r}var Ds=function(t,e){var i,n,r,a,o,s,c,h=[],l=t<<1,u=0,p=0,d=Es,f=_s,m=Ms,g=Ts,v=Ss,y=Ps,x=Cs,b=ks,w=ds,_=fs,M=vs;for(n=0;n<e;n++){for(a=n>0?-t:0,o=n<e-1?t:0,i=0;i<t;i++){h[2]=w[p+a],h[5]=w[p],h[8]=w[p+o],i>0?(h[1]=w[p+a-1],h[4]=w[p-1],h[7]=w[p+o-1]):(h[1]=h[2],h[4]=h[5],h[7]=h[8]),i<t-1?(h[3]=w[p+a+1],h[6]=w[p+1],h[9]=w[p+o+1]):(h[3]=h[2],h[6]=h[5],h[9]=h[8]);var E=0,T=1;for(s=m(h[5]),r=1;r<10;r++)5!==r&&(h[r]!==h[5]&&(c=m(h[r]),(f.abs((s&M)-(c&M))>3145728||f.abs((65280&s)-(65280&c))>1792||f.abs((255&s)-(255&c))>6)&&(E|=T)),T<<=1);switch(E){case 0:case 1:case 4:case 32:case 128:case 5:case 132:case 160:case 33:case 129:case 36:case 133:case 164:case 161:case 37:case 165:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 2:case 34:case 130:case 162:v(u,h[5],h[1],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 16:case 17:case 48:case 49:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 64:case 65:case 68:case 69:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 8:case 12:case 136:case 140:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 3:case 35:case 131:case 163:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 6:case 38:case 134:case 166:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 20:case 21:case 52:case 53:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 144:case 145:case 176:case 177:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[8]);break;case 192:case 193:case 196:case 197:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[6]);break;case 96:case 97:case 100:case 101:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 40:case 44:case 168:case 172:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 9:case 13:case 137:case 141:g(u,h[5],h[2]),v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 18:case 50:v(u,h[5],h[1],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 80:case 81:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):v(u+l+1,h[5],h[6],h[8]);break;case 72:case 76:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 10:case 138:d(h[4],h[2])?g(u,h[5],h[4]):v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 66:v(u,h[5],h[1],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 24:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 7:case 39:case 135:g(u,h[5],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 148:case 149:case 180:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[8]);break;case 224:case 228:case 225:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 41:case 169:case 45:g(u,h[5],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 22:case 54:v(u,h[5],h[1],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 208:case 209:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 104:case 108:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 11:case 139:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 19:case 51:d(h[2],h[6])?(g(u,h[5],h[4]),g(u+1,h[5],h[3])):(y(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[6])),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 146:case 178:v(u,h[5],h[1],h[4]),d(h[2],h[6])?(g(u+1,h[5],h[3]),g(u+l+1,h[5],h[8])):(b(u+1,h[5],h[2],h[6]),y(u+l+1,h[5],h[6],h[8])),v(u+l,h[5],h[8],h[4]);break;case 84:case 85:v(u,h[5],h[4],h[2]),d(h[6],h[8])?(g(u+1,h[5],h[2]),g(u+l+1,h[5],h[9])):(y(u+1,h[5],h[6],h[2]),b(u+l+1,h[5],h[6],h[8])),v(u+l,h[5],h[7],h[4]);break;case 112:case 113:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),d(h[6],h[8])?(g(u+l,h[5],h[4]),g(u+l+1,h[5],h[9])):(y(u+l,h[5],h[8],h[4]),b(u+l+1,h[5],h[6],h[8]));break;case 200:case 204:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?(g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6])):(b(u+l,h[5],h[8],h[4]),y(u+l+1,h[5],h[8],h[6]));break;case 73:case 77:d(h[8],h[4])?(g(u,h[5],h[2]),g(u+l,h[5],h[7])):(y(u,h[5],h[4],h[2]),b(u+l,h[5],h[8],h[4])),v(u+1,h[5],h[2],h[6]),v(u+l+1,h[5],h[9],h[6]);break;case 42:case 170:d(h[4],h[2])?(g(u,h[5],h[4]),g(u+l,h[5],h[8])):(b(u,h[5],h[4],h[2]),y(u+l,h[5],h[4],h[8])),v(u+1,h[5],h[3],h[6]),v(u+l+1,h[5],h[6],h[8]);break;case 14:case 142:d(h[4],h[2])?(g(u,h[5],h[4]),g(u+1,h[5],h[6])):(b(u,h[5],h[4],h[2]),y(u+1,h[5],h[2],h[6])),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 67:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 70:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[7],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 28:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 152:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 194:v(u,h[5],h[1],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[6]);break;case 98:v(u,h[5],h[1],h[4]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 56:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 25:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 26:case 31:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 82:case 214:v(u,h[5],h[1],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 88:case 248:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 74:case 107:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 27:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 86:v(u,h[5],h[1],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[9]);break;case 216:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 106:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 30:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 210:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 120:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[9]);break;case 75:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[7]),v(u+l+1,h[5],h[9],h[6]);break;case 29:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 198:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[6]);break;case 184:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 99:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 57:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 71:g(u,h[5],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[7],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 156:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 226:v(u,h[5],h[1],h[4]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 60:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 195:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[6]);break;case 102:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[6]),g(u+l,h[5],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 153:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 58:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 83:g(u,h[5],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 92:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 202:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[6]);break;case 78:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),g(u+1,h[5],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 154:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 114:v(u,h[5],h[1],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 89:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 90:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 55:case 23:d(h[2],h[6])?(g(u,h[5],h[4]),_[u+1]=h[5]):(y(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[6])),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 182:case 150:v(u,h[5],h[1],h[4]),d(h[2],h[6])?(_[u+1]=h[5],g(u+l+1,h[5],h[8])):(b(u+1,h[5],h[2],h[6]),y(u+l+1,h[5],h[6],h[8])),v(u+l,h[5],h[8],h[4]);break;case 213:case 212:v(u,h[5],h[4],h[2]),d(h[6],h[8])?(g(u+1,h[5],h[2]),_[u+l+1]=h[5]):(y(u+1,h[5],h[6],h[2]),b(u+l+1,h[5],h[6],h[8])),v(u+l,h[5],h[7],h[4]);break;case 241:case 240:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),d(h[6],h[8])?(g(u+l,h[5],h[4]),_[u+l+1]=h[5]):(y(u+l,h[5],h[8],h[4]),b(u+l+1,h[5],h[6],h[8]));break;case 236:case 232:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?(_[u+l]=h[5],g(u+l+1,h[5],h[6])):(b(u+l,h[5],h[8],h[4]),y(u+l+1,h[5],h[8],h[6]));break;case 109:case 105:d(h[8],h[4])?(g(u,h[5],h[2]),_[u+l]=h[5]):(y(u,h[5],h[4],h[2]),b(u+l,h[5],h[8],h[4])),v(u+1,h[5],h[2],h[6]),v(u+l+1,h[5],h[9],h[6]);break;case 171:case 43:d(h[4],h[2])?(_[u]=h[5],g(u+l,h[5],h[8])):(b(u,h[5],h[4],h[2]),y(u+l,h[5],h[4],h[8])),v(u+1,h[5],h[3],h[6]),v(u+l+1,h[5],h[6],h[8]);break;case 143:case 15:d(h[4],h[2])?(_[u]=h[5],g(u+1,h[5],h[6])):(b(u,h[5],h[4],h[2]),y(u+1,h[5],h[2],h[6])),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 124:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[9]);break;case 203:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6]);break;case 62:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 211:g(u,h[5],h[4]),g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 118:v(u,h[5],h[1],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[9]);break;case 217:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 110:g(u,h[5],h[4]),g(u+1,h[5],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 155:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 188:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 185:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 61:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 157:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 103:g(u,h[5],h[4]),g(u+1,h[5],h[6]),g(u+l,h[5],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 227:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 230:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 199:g(u,h[5],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[6]);break;case 220:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 158:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 234:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[6]);break;case 242:v(u,h[5],h[1],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 59:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 121:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 87:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 79:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 122:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 94:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 218:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 91:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 229:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 167:g(u,h[5],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 173:g(u,h[5],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 181:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[8]);break;case 186:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 115:g(u,h[5],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 93:g(u,h[5],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 206:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),g(u+1,h[5],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[6]);break;case 205:case 201:g(u,h[5],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[6]);break;case 174:case 46:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),g(u+1,h[5],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 179:case 147:g(u,h[5],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[8]);break;case 117:case 116:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 189:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 231:g(u,h[5],h[4]),g(u+1,h[5],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 126:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[9]);break;case 219:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[3]),g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 125:d(h[8],h[4])?(g(u,h[5],h[2]),_[u+l]=h[5]):(y(u,h[5],h[4],h[2]),b(u+l,h[5],h[8],h[4])),g(u+1,h[5],h[2]),g(u+l+1,h[5],h[9]);break;case 221:g(u,h[5],h[2]),d(h[6],h[8])?(g(u+1,h[5],h[2]),_[u+l+1]=h[5]):(y(u+1,h[5],h[6],h[2]),b(u+l+1,h[5],h[6],h[8])),g(u+l,h[5],h[7]);break;case 207:d(h[4],h[2])?(_[u]=h[5],g(u+1,h[5],h[6])):(b(u,h[5],h[4],h[2]),y(u+1,h[5],h[2],h[6])),g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6]);break;case 238:g(u,h[5],h[4]),g(u+1,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],g(u+l+1,h[5],h[6])):(b(u+l,h[5],h[8],h[4]),y(u+l+1,h[5],h[8],h[6]));break;case 190:g(u,h[5],h[4]),d(h[2],h[6])?(_[u+1]=h[5],g(u+l+1,h[5],h[8])):(b(u+1,h[5],h[2],h[6]),y(u+l+1,h[5],h[6],h[8])),g(u+l,h[5],h[8]);break;case 187:d(h[4],h[2])?(_[u]=h[5],g(u+l,h[5],h[8])):(b(u,h[5],h[4],h[2]),y(u+l,h[5],h[4],h[8])),g(u+1,h[5],h[3]),g(u+l+1,h[5],h[8]);break;case 243:g(u,h[5],h[4]),g(u+1,h[5],h[3]),d(h[6],h[8])?(g(u+l,h[5],h[4]),_[u+l+1]=h[5]):(y(u+l,h[5],h[8],h[4]),b(u+l+1,h[5],h[6],h[8]));break;case 119:d(h[2],h[6])?(g(u,h[5],h[4]),_[u+1]=h[5]):(y(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[6])),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[9]);break;case 237:case 233:g(u,h[5],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6]);break;case 175:case 47:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),g(u+1,h[5],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 183:case 151:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[8]);break;case 245:case 244:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 250:g(u,h[5],h[4]),g(u+1,h[5],h[3]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 123:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[3]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[9]);break;case 95:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[7]),g(u+l+1,h[5],h[9]);break;case 222:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 252:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 249:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 235:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6]);break;case 111:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),g(u+1,h[5],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 63:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 159:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 215:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 246:v(u,h[5],h[1],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 254:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 253:g(u,h[5],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 251:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[3]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 239:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),g(u+1,h[5],h[6]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6]);break;case 127:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[9]);break;case 191:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 223:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 247:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),g(u+l,h[5],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 255:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9])}p++,u+=2}u+=l}},Us=function(t,e){var i,n,r,a,o,s,c,h=[],l=3*t,u=0,p=0,d=Es,f=_s,m=Ms,g=Ts,v=Ss,y=As,x=Ls,b=Rs,w=ds,_=fs,M=vs;for(n=0;n<e;n++){for(a=n>0?-t:0,o=n<e-1?t:0,i=0;i<t;i++){h[2]=w[p+a],h[5]=w[p],h[8]=w[p+o],i>0?(h[1]=w[p+a-1],h[4]=w[p-1],h[7]=w[p+o-1]):(h[1]=h[2],h[4]=h[5],h[7]=h[8]),i<t-1?(h[3]=w[p+a+1],h[6]=w[p+1],h[9]=w[p+o+1]):(h[3]=h[2],h[6]=h[5],h[9]=h[8]);var E=0,T=1;for(s=m(h[5]),r=1;r<10;r++)5!==r&&(h[r]!==h[5]&&(c=m(h[r]),(f.abs((s&M)-(c&M))>3145728||f.abs((65280&s)-(65280&c))>1792||f.abs((255&s)-(255&c))>6)&&(E|=T)),T<<=1);switch(E){case 0:case 1:case 4:case 32:case 128:case 5:case 132:case 160:case 33:case 129:case 36:case 133:case 164:case 161:case 37:case 165:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 2:case 34:case 130:case 162:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 16:case 17:case 48:case 49:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 64:case 65:case 68:case 69:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 8:case 12:case 136:case 140:g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 3:case 35:case 131:case 163:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 6:case 38:case 134:case 166:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 20:case 21:case 52:case 53:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 144:case 145:case 176:case 177:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 192:case 193:case 196:case 197:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 96:case 97:case 100:case 101:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 40:case 44:case 168:case 172:g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 9:case 13:case 137:case 141:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 18:case 50:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 80:case 81:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9])):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 72:case 76:g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 10:case 138:d(h[4],h[2])?(g(u,h[5],h[1]),_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 66:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 24:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 7:case 39:case 135:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 148:case 149:case 180:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 224:case 228:case 225:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 41:case 169:case 45:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 22:case 54:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 208:case 209:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 104:case 108:g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 11:case 139:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 19:case 51:d(h[2],h[6])?(g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+2]=h[5]):(v(u,h[5],h[4],h[2]),g(u+1,h[2],h[5]),b(u+2,h[2],h[6]),g(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 146:case 178:d(h[2],h[6])?(_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+2]=h[5],g(u+(l<<1)+2,h[5],h[8])):(g(u+1,h[5],h[2]),b(u+2,h[2],h[6]),g(u+l+2,h[6],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]);break;case 84:case 85:d(h[6],h[8])?(g(u+2,h[5],h[2]),_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9])):(v(u+2,h[5],h[2],h[6]),g(u+l+2,h[6],h[5]),g(u+(l<<1)+1,h[5],h[8]),b(u+(l<<1)+2,h[6],h[8])),v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]);break;case 112:case 113:d(h[6],h[8])?(_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9])):(g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),b(u+(l<<1)+2,h[6],h[8])),v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5];break;case 200:case 204:d(h[8],h[4])?(_[u+l]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6])):(g(u+l,h[5],h[4]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]);break;case 73:case 77:d(h[8],h[4])?(g(u,h[5],h[2]),_[u+l]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5]):(v(u,h[5],h[4],h[2]),g(u+l,h[4],h[5]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[5],h[8])),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1)+2,h[5],h[9]);break;case 42:case 170:d(h[4],h[2])?(g(u,h[5],h[1]),_[u+1]=h[5],_[u+l]=h[5],g(u+(l<<1),h[5],h[8])):(b(u,h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[4],h[5]),v(u+(l<<1),h[5],h[8],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 14:case 142:d(h[4],h[2])?(g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5]):(b(u,h[4],h[2]),g(u+1,h[2],h[5]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4])),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 67:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 70:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 28:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 152:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 194:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 98:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 56:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 25:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 26:case 31:d(h[4],h[2])?(_[u]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+l,h[5],h[4])),_[u+1]=h[5],d(h[2],h[6])?(_[u+2]=h[5],_[u+l+2]=h[5]):(x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 82:case 214:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 88:case 248:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4])),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 74:case 107:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2])),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 27:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 86:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 216:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 106:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 30:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 210:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 120:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 75:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 29:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 198:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 184:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 99:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 57:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 71:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 156:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 226:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 60:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 195:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 102:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 153:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 58:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 83:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 92:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 202:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 78:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 154:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 114:g(u,h[5],h[1]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 89:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 90:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 55:case 23:d(h[2],h[6])?(g(u,h[5],h[4]),_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(v(u,h[5],h[4],h[2]),g(u+1,h[2],h[5]),b(u+2,h[2],h[6]),g(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 182:case 150:d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5],g(u+(l<<1)+2,h[5],h[8])):(g(u+1,h[5],h[2]),b(u+2,h[2],h[6]),g(u+l+2,h[6],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]);break;case 213:case 212:d(h[6],h[8])?(g(u+2,h[5],h[2]),_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(v(u+2,h[5],h[2],h[6]),g(u+l+2,h[6],h[5]),g(u+(l<<1)+1,h[5],h[8]),b(u+(l<<1)+2,h[6],h[8])),v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]);break;case 241:case 240:d(h[6],h[8])?(_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),b(u+(l<<1)+2,h[6],h[8])),v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5];break;case 236:case 232:d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6])):(g(u+l,h[5],h[4]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]);break;case 109:case 105:d(h[8],h[4])?(g(u,h[5],h[2]),_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(v(u,h[5],h[4],h[2]),g(u+l,h[4],h[5]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[5],h[8])),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1)+2,h[5],h[9]);break;case 171:case 43:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5],g(u+(l<<1),h[5],h[8])):(b(u,h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[4],h[5]),v(u+(l<<1),h[5],h[8],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 143:case 15:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5]):(b(u,h[4],h[2]),g(u+1,h[2],h[5]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4])),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 124:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 203:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 62:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 211:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 118:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 217:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 110:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 155:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 188:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 185:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 61:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 157:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 103:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 227:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 230:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 199:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 220:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 158:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 234:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[6]);break;case 242:g(u,h[5],h[1]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[4]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 59:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 121:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 87:g(u,h[5],h[4]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 79:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 122:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 94:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 218:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 91:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 229:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 167:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 173:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 181:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 186:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 115:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 93:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 206:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 205:case 201:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 174:case 46:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 179:case 147:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 117:case 116:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 189:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 231:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 126:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 219:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 125:d(h[8],h[4])?(g(u,h[5],h[2]),_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(v(u,h[5],h[4],h[2]),g(u+l,h[4],h[5]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[5],h[8])),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 221:d(h[6],h[8])?(g(u+2,h[5],h[2]),_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(v(u+2,h[5],h[2],h[6]),g(u+l+2,h[6],h[5]),g(u+(l<<1)+1,h[5],h[8]),b(u+(l<<1)+2,h[6],h[8])),g(u,h[5],h[2]),g(u+1,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]);break;case 207:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5]):(b(u,h[4],h[2]),g(u+1,h[2],h[5]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4])),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 238:d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6])):(g(u+l,h[5],h[4]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]);break;case 190:d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5],g(u+(l<<1)+2,h[5],h[8])):(g(u+1,h[5],h[2]),b(u+2,h[2],h[6]),g(u+l+2,h[6],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]);break;case 187:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5],g(u+(l<<1),h[5],h[8])):(b(u,h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[4],h[5]),v(u+(l<<1),h[5],h[8],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 243:d(h[6],h[8])?(_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),b(u+(l<<1)+2,h[6],h[8])),g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5];break;case 119:d(h[2],h[6])?(g(u,h[5],h[4]),_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(v(u,h[5],h[4],h[2]),g(u+1,h[2],h[5]),b(u+2,h[2],h[6]),g(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 237:case 233:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 175:case 47:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 183:case 151:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 245:case 244:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 250:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4])),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 123:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2])),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 95:d(h[4],h[2])?(_[u]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+l,h[5],h[4])),_[u+1]=h[5],d(h[2],h[6])?(_[u+2]=h[5],_[u+l+2]=h[5]):(x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 222:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 252:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4])),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 249:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 235:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2])),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 111:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 63:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?(_[u+2]=h[5],_[u+l+2]=h[5]):(x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 159:d(h[4],h[2])?(_[u]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+l,h[5],h[4])),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 215:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 246:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 254:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6])),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4])),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]));break;case 253:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 251:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),v(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 239:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 127:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(v(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),d(h[2],h[6])?(_[u+2]=h[5],_[u+l+2]=h[5]):(x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 191:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 223:d(h[4],h[2])?(_[u]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+l,h[5],h[4])),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 247:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 255:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8])}p++,u+=3}u+=l<<1}},zs=function(t,e){var i,n,r,a,o,s,c,h=[],l=t<<2,u=0,p=0,d=Es,f=_s,m=Ms,g=Ts,v=Ss,y=As,x=Rs,b=Ps,w=Cs,_=Os,M=ds,E=fs,T=vs;for(n=0;n<e;n++){for(a=n>0?-t:0,o=n<e-1?t:0,i=0;i<t;i++){h[2]=M[p+a],h[5]=M[p],h[8]=M[p+o],i>0?(h[1]=M[p+a-1],h[4]=M[p-1],h[7]=M[p+o-1]):(h[1]=h[2],h[4]=h[5],h[7]=h[8]),i<t-1?(h[3]=M[p+a+1],h[6]=M[p+1],h[9]=M[p+o+1]):(h[3]=h[2],h[6]=h[5],h[9]=h[8]);var S=0,A=1;for(s=m(h[5]),r=1;r<10;r++)5!==r&&(h[r]!==h[5]&&(c=m(h[r]),(f.abs((s&T)-(c&T))>3145728||f.abs((65280&s)-(65280&c))>1792||f.abs((255&s)-(255&c))>6)&&(S|=A)),A<<=1);switch(S){case 0:case 1:case 4:case 32:case 128:case 5:case 132:case 160:case 33:case 129:case 36:case 133:case 164:case 161:case 37:case 165:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 2:case 34:case 130:case 162:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 16:case 17:case 48:case 49:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 64:case 65:case 68:case 69:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 8:case 12:case 136:case 140:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 3:case 35:case 131:case 163:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 6:case 38:case 134:case 166:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 20:case 21:case 52:case 53:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 144:case 145:case 176:case 177:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 192:case 193:case 196:case 197:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 96:case 97:case 100:case 101:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 40:case 44:case 168:case 172:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 9:case 13:case 137:case 141:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 18:case 50:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),E[u+l+2]=h[5],x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 80:case 81:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 72:case 76:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(x(u+(l<<1),h[4],h[5]),E[u+(l<<1)+1]=h[5],x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 10:case 138:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5]),E[u+l+1]=h[5]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 66:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 24:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 7:case 39:case 135:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 148:case 149:case 180:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 224:case 228:case 225:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 41:case 169:case 45:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 22:case 54:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 208:case 209:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 104:case 108:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 11:case 139:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 19:case 51:d(h[2],h[6])?(_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u,h[5],h[2]),g(u+1,h[2],h[5]),_(u+2,h[2],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),v(u+l+3,h[6],h[5],h[2])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 146:case 178:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l+3,h[5],h[8])):(v(u+2,h[2],h[5],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),_(u+l+3,h[6],h[2]),g(u+(l<<1)+3,h[6],h[5]),g(u+3*l+3,h[5],h[6])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]);break;case 84:case 85:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),d(h[6],h[8])?(_(u+3,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(g(u+3,h[5],h[6]),g(u+l+3,h[6],h[5]),w(u+(l<<1)+2,h[5],h[6],h[8]),_(u+(l<<1)+3,h[6],h[8]),v(u+3*l+2,h[8],h[5],h[6]),x(u+3*l+3,h[8],h[6])),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 112:case 113:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(w(u+(l<<1)+2,h[5],h[6],h[8]),v(u+(l<<1)+3,h[6],h[5],h[8]),g(u+3*l,h[5],h[8]),g(u+3*l+1,h[8],h[5]),_(u+3*l+2,h[8],h[6]),x(u+3*l+3,h[8],h[6]));break;case 200:case 204:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6])):(v(u+(l<<1),h[4],h[5],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),_(u+3*l+1,h[8],h[4]),g(u+3*l+2,h[8],h[5]),g(u+3*l+3,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]);break;case 73:case 77:d(h[8],h[4])?(_(u,h[5],h[2]),y(u+l,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u,h[5],h[4]),g(u+l,h[4],h[5]),_(u+(l<<1),h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),v(u+3*l+1,h[8],h[5],h[4])),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 42:case 170:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+(l<<1),h[5],h[8]),_(u+3*l,h[5],h[8])):(x(u,h[2],h[4]),v(u+1,h[2],h[5],h[4]),_(u+l,h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),g(u+(l<<1),h[4],h[5]),g(u+3*l,h[5],h[4])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 14:case 142:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(x(u,h[2],h[4]),_(u+1,h[2],h[4]),g(u+2,h[2],h[5]),g(u+3,h[5],h[2]),v(u+l,h[4],h[5],h[2]),w(u+l+1,h[5],h[4],h[2])),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 67:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 70:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 28:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 152:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 194:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 98:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 56:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 25:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 26:case 31:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l+1]=h[5],E[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 82:case 214:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 88:case 248:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6]));break;case 74:case 107:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 27:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 86:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 216:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 106:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 30:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 210:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 120:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 75:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 29:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 198:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 184:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 99:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 57:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 71:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 156:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 226:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 60:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 195:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 102:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 153:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 58:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 83:_(u,h[5],h[4]),y(u+1,h[5],h[4]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 92:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 202:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 78:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 154:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 114:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6])),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]);break;case 89:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 90:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 55:case 23:d(h[2],h[6])?(_(u,h[5],h[4]),y(u+1,h[5],h[4]),E[u+2]=h[5],E[u+3]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5]):(g(u,h[5],h[2]),g(u+1,h[2],h[5]),_(u+2,h[2],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),v(u+l+3,h[6],h[5],h[2])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 182:case 150:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],y(u+(l<<1)+3,h[5],h[8]),_(u+3*l+3,h[5],h[8])):(v(u+2,h[2],h[5],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),_(u+l+3,h[6],h[2]),g(u+(l<<1)+3,h[6],h[5]),g(u+3*l+3,h[5],h[6])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]);break;case 213:case 212:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),d(h[6],h[8])?(_(u+3,h[5],h[2]),y(u+l+3,h[5],h[2]),E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(g(u+3,h[5],h[6]),g(u+l+3,h[6],h[5]),w(u+(l<<1)+2,h[5],h[6],h[8]),_(u+(l<<1)+3,h[6],h[8]),v(u+3*l+2,h[8],h[5],h[6]),x(u+3*l+3,h[8],h[6])),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 241:case 240:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(w(u+(l<<1)+2,h[5],h[6],h[8]),v(u+(l<<1)+3,h[6],h[5],h[8]),g(u+3*l,h[5],h[8]),g(u+3*l+1,h[8],h[5]),_(u+3*l+2,h[8],h[6]),x(u+3*l+3,h[8],h[6]));break;case 236:case 232:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5],y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6])):(v(u+(l<<1),h[4],h[5],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),_(u+3*l+1,h[8],h[4]),g(u+3*l+2,h[8],h[5]),g(u+3*l+3,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]);break;case 109:case 105:d(h[8],h[4])?(_(u,h[5],h[2]),y(u+l,h[5],h[2]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(g(u,h[5],h[4]),g(u+l,h[4],h[5]),_(u+(l<<1),h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),v(u+3*l+1,h[8],h[5],h[4])),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 171:case 43:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5],E[u+l+1]=h[5],y(u+(l<<1),h[5],h[8]),_(u+3*l,h[5],h[8])):(x(u,h[2],h[4]),v(u+1,h[2],h[5],h[4]),_(u+l,h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),g(u+(l<<1),h[4],h[5]),g(u+3*l,h[5],h[4])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 143:case 15:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l]=h[5],E[u+l+1]=h[5]):(x(u,h[2],h[4]),_(u+1,h[2],h[4]),g(u+2,h[2],h[5]),g(u+3,h[5],h[2]),v(u+l,h[4],h[5],h[2]),w(u+l+1,h[5],h[4],h[2])),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 124:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 203:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 62:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 211:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 118:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 217:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 110:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 155:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 188:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 185:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 61:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 157:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 103:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 227:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 230:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 199:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 220:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6]));break;case 158:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 234:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 242:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]);break;case 59:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),E[u+l+1]=h[5],y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 121:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 87:_(u,h[5],h[4]),y(u+1,h[5],h[4]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),E[u+l+2]=h[5],b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 79:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l+1]=h[5],y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 122:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 94:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l+2]=h[5],d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 218:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6]));break;case 91:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),E[u+l+1]=h[5],d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 229:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 167:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 173:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 181:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 186:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 115:_(u,h[5],h[4]),y(u+1,h[5],h[4]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6])),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]);break;case 93:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 206:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 205:case 201:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 174:case 46:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 179:case 147:_(u,h[5],h[4]),y(u+1,h[5],h[4]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 117:case 116:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6])),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]);break;case 189:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 231:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 126:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 219:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 125:d(h[8],h[4])?(_(u,h[5],h[2]),y(u+l,h[5],h[2]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(g(u,h[5],h[4]),g(u+l,h[4],h[5]),_(u+(l<<1),h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),v(u+3*l+1,h[8],h[5],h[4])),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 221:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),d(h[6],h[8])?(_(u+3,h[5],h[2]),y(u+l+3,h[5],h[2]),E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(g(u+3,h[5],h[6]),g(u+l+3,h[6],h[5]),w(u+(l<<1)+2,h[5],h[6],h[8]),_(u+(l<<1)+3,h[6],h[8]),v(u+3*l+2,h[8],h[5],h[6]),x(u+3*l+3,h[8],h[6])),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 207:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l]=h[5],E[u+l+1]=h[5]):(x(u,h[2],h[4]),_(u+1,h[2],h[4]),g(u+2,h[2],h[5]),g(u+3,h[5],h[2]),v(u+l,h[4],h[5],h[2]),w(u+l+1,h[5],h[4],h[2])),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 238:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5],y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6])):(v(u+(l<<1),h[4],h[5],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),_(u+3*l+1,h[8],h[4]),g(u+3*l+2,h[8],h[5]),g(u+3*l+3,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]);break;case 190:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],y(u+(l<<1)+3,h[5],h[8]),_(u+3*l+3,h[5],h[8])):(v(u+2,h[2],h[5],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),_(u+l+3,h[6],h[2]),g(u+(l<<1)+3,h[6],h[5]),g(u+3*l+3,h[5],h[6])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]);break;case 187:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5],E[u+l+1]=h[5],y(u+(l<<1),h[5],h[8]),_(u+3*l,h[5],h[8])):(x(u,h[2],h[4]),v(u+1,h[2],h[5],h[4]),_(u+l,h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),g(u+(l<<1),h[4],h[5]),g(u+3*l,h[5],h[4])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 243:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(w(u+(l<<1)+2,h[5],h[6],h[8]),v(u+(l<<1)+3,h[6],h[5],h[8]),g(u+3*l,h[5],h[8]),g(u+3*l+1,h[8],h[5]),_(u+3*l+2,h[8],h[6]),x(u+3*l+3,h[8],h[6]));break;case 119:d(h[2],h[6])?(_(u,h[5],h[4]),y(u+1,h[5],h[4]),E[u+2]=h[5],E[u+3]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5]):(g(u,h[5],h[2]),g(u+1,h[2],h[5]),_(u+2,h[2],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),v(u+l+3,h[6],h[5],h[2])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 237:case 233:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5],y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 175:case 47:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l]=h[5],E[u+l+1]=h[5],y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 183:case 151:_(u,h[5],h[4]),y(u+1,h[5],h[4]),E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),E[u+l+2]=h[5],E[u+l+3]=h[5],b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 245:case 244:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 250:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6]));break;case 123:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 95:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l+1]=h[5],E[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 222:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 252:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 249:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5];break;case 235:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5],y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 111:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l]=h[5],E[u+l+1]=h[5],y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 63:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l]=h[5],E[u+l+1]=h[5],E[u+l+2]=h[5],y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 159:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),E[u+l+1]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 215:_(u,h[5],h[4]),y(u+1,h[5],h[4]),E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),E[u+l+2]=h[5],E[u+l+3]=h[5],b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 246:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 254:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 253:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5],E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 251:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5];break;case 239:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l]=h[5],E[u+l+1]=h[5],y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5],y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 127:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l]=h[5],E[u+l+1]=h[5],E[u+l+2]=h[5],d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 191:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),E[u+l]=h[5],E[u+l+1]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 223:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),E[u+l+1]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 247:_(u,h[5],h[4]),y(u+1,h[5],h[4]),E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),E[u+l+2]=h[5],E[u+l+3]=h[5],_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 255:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),E[u+l]=h[5],E[u+l+1]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5],E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6])}p++,u+=4}u+=3*l}};function Bs(t,e,i){const n={offset:t.offset.clone(),repeat:t.repeat.clone()};e&&ls>1&&(e*=ls),n.frameWidth=e||t.image.height,n.frames=i||Math.floor(t.image.width/n.frameWidth),n.repeat.y=Math.max(1,n.frameWidth/t.image.height),n.frameUvWidth=n.frameWidth/t.image.width,n.isSpriteSheet=!0,n.offsetRepeatUniform=null,n.setFrame=function(e){if(e<0||e>=this.frames)throw console.log(t.image.width),console.log(n.frameWidth),new RangeError(`Invalid frame number ${e} of ${this.frames}`);this.offset.x=e*this.frameUvWidth,this.repeat.x=this.frameUvWidth,this.offsetRepeatUniform&&this.offsetRepeatUniform.set(this.offset.x,this.offset.y,this.repeat.x,this.repeat.y)};const r=new Proxy(t,{get:function(t,e){return n.hasOwnProperty(e)?n[e]:t[e]},set:function(t,e,i){return n.hasOwnProperty(e)?n[e]=i:t[e]=i,!0}});return r.setFrame(0),r}const Fs=new class extends Fr{constructor(){super(...arguments),this.cache=new Map,this.queued=new Map,this.stats=new Map}load(t,e,...i){return this.stats.has(t)||this.stats.set(t,{hits:0,loaded:0}),this.stats.get(t).loaded++,super.load(t,(function(t){if(ls>1){const e=Ns(t.image,ls);t.image=e,t.needsUpdate=!0}e&&e(t)}),...i)}get(t,e,i,n){if(this.cache.has(t)){this.stats.get(t).hits++;const i=this.cache.get(t);return e&&e(i),i}if(this.queued.has(t)){this.stats.get(t).hits++;const r=this.queued.get(t);return r.onLoad.push(e),r.onProgress.push(i),r.onError.push(n),r.texture}{const r=this.cache,a=this.queued,o={onLoad:[e],onProgress:[i],onError:[n]},s=this.load(t,(...e)=>{const i=e[0];r.set(t,i),a.delete(t),o.onLoad.forEach(t=>t&&t(...e))},(...t)=>{o.onProgress.forEach(e=>e&&e(...t))},(...e)=>{a.delete(t),o.onError.forEach(t=>t&&t(...e))});return o.texture=s,a.set(t,o),s.magFilter=f,s}}};class Gs extends bt{constructor(t){super(),Object.keys(t).forEach(e=>{this[e]instanceof Object&&this[e].copy?this[e].copy(t[e]):this[e]=t[e]}),this.persistedProps=["type","position"],this.up.set(0,0,1)}includes(t){for(let e=t;e;e=e.parent)if(e===this)return!0;return!1}getState(){const t={};return this.persistedProps.forEach(e=>{if(!(e in this))throw console.log(this),new Error("entity does not have a `"+e+"` property");t[e]=this[e]}),t}}class Hs extends Gs{constructor(t,e,i){super(t),this.size=e,this.speed=i||0,this.moveDirection=new F,this.velocity=new F,this.turnDirection=0,this.raycaster=new Fo}update(t,e){const i=t-this.lastTime;if(this.lastTime=t,i&&(this.turnDirection&&(this.rotateY(this.turnDirection*i),this.updateVelocity()),this.velocity.lengthSq()&&!this.frozen)){let n=0,r=!1;const a=this.velocity.clone().multiplyScalar(i);do{n++,r=!1;const i=a.length(),o=a.clone().normalize(),s=this.size+i;this.raycaster.set(this.position,o),this.raycaster.far=s;let c=this.raycaster.intersectObject(e,!0);for(let e of c){if(this.includes(e.object)||Vs(e.object))continue;let n=!0;if(this.onCollision&&(n=this.onCollision(e,t)),n){const t=new F;if(e.face)t.copy(e.face.normal);else{const i=new F;e.object.getWorldPosition(i).sub(e.point),t.crossVectors(o,i).cross(i).normalize()}const n=Math.min(i,s-e.distance);n&&(a.addScaledVector(t,n),r=!0)}}}while(r&&n<4);this.position.add(a)}}updateVelocity(){return this.velocity.copy(this.moveDirection).normalize().multiplyScalar(this.speed),this.localToWorld(this.velocity).sub(this.position),this.velocity.multiplyScalar(100).roundToZero().divideScalar(100)}}function Vs(t){for(let e=t;e;e=e.parent)if(e.isEthereal||e.shouldRemove)return!0;return!1}let js=null;ps.load("sounds/adlib/wall_hit.wav",t=>js=t);class Ws extends Hs{constructor(t){super(t,0,30),this.persistedProps.push("direction","isBig"),void 0===this.isBig&&(this.isBig=!0),this.scale.divideScalar(3),this.lookAt(this.position.clone().add(this.direction)),this.updateMatrixWorld(),this.moveDirection.z=1,this.updateVelocity(),this.isEthereal=!0,js&&(this.hitSound=new po(us),this.hitSound.setBuffer(js),this.add(this.hitSound)),this.isBig&&(this.light=new ma(16737792,.5,.5),this.light.distance*=2,this.add(this.light)),Fs.get("sprites/fireball.png",t=>{this.spriteSheet=Bs(t),this.sprite=new Ji(new Zi({map:this.spriteSheet})),this.isBig||(this.sprite.material.rotation=Math.floor(4*Math.random())*Math.PI/2),this.add(this.sprite)})}dispose(){this.sprite.material.dispose(),this.spriteSheet.dispose()}onCollision(t,e){if(!this.removeAtTime){let i=!1;for(let n=t.object;n;n=n.parent)if(n.onDamage){const t=this.isBig?3:1;n.onDamage(e,t),i=!0;break}!i&&this.hitSound&&this.hitSound.play()}return this.removeAtTime=e+.075,this.isBig=!1,this.removeAtTime+=.075,this.moveDirection.z=0,this.updateVelocity(),this.translateZ(-.1),!0}update(t,e){super.update(t,e);let i=Math.floor(10*t)%2;this.isBig&&(i+=2),this.spriteSheet&&this.spriteSheet.setFrame(i),t>=this.removeAtTime&&(this.shouldRemove=!0)}}class Xs extends Gs{constructor(t){super(t),this.persistedProps.push("value"),this.fps=8,this.light=new ma(17117,1,1.5),this.add(this.light),Fs.get("sprites/portal.png",t=>{this.spritesheet=new Bs(t),this.sprite=new Ji(new Zi({fog:!0,map:this.spritesheet})),this.add(this.sprite)})}dispose(){this.sprite.material.dispose(),this.spritesheet.dispose()}update(t){if(this.spritesheet){const e=Math.floor(t*this.fps)%this.spritesheet.frames;this.spritesheet.setFrame(e)}this.light.intensity=.5+.2*Math.abs(Math.sin(.5*t))+.02*Math.abs(Math.sin(this.fps*t))}}class qs extends Xs{constructor(t){super(t),this.destination=(new F).copy(t.value)}}class Ys extends Xs{}var Zs=Object.freeze({__proto__:null,Entity:Gs,Actor:Hs,Fireball:Ws,Portal:Xs,JumpGate:qs,WarpGate:Ys});class Js extends Hs{constructor(t,e,i,n,r,a,o){super(e,n,r),this.persistedProps.push("anim","animStartTime","health","lastAttackTime"),void 0===this.anim&&(this.anim="move",this.animStartTime=-Math.random()),void 0===this.health&&(this.health=i),void 0===this.lastAttackTime&&(this.lastAttackTime=0),this.lastThinkTime=Math.random(),this.isEthereal=this.health<=0,this.sprite=new Ji(new Zi({fog:!0})),this.attackInterval=a,this.animations=o.animations;const s=Object.values(this.animations).map(t=>t.start+t.length).reduce(Math.max,0);Fs.get(t,t=>{this.texture=new Bs(t,o.frameWidth,s),this.sprite.material.map=this.texture,this.sprite.material.needsUpdate=!0,this.add(this.sprite)}),this.raycaster=new Fo}attack(t,e){this.startAnimation("attack",e),this.lastAttackTime=e}onDamage(t,e){"death"!=this.anim&&(this.health-=e,this.velocity.set(0,0,0),this.health>0?this.startAnimation("pain",t):(this.isEthereal=!0,this.startAnimation("death",t),this.moveDestination=null))}dispose(){this.sprite.material.dispose,this.texture.dispose()}startAnimation(t,e){t in this.animations&&(this.anim=t,this.animStartTime=e)}hunt(t,e){"death"!=this.anim&&(this.target=t,this.maze=e)}sightTarget(){const t=this.target.position.clone().sub(this.position),e=t.length();this.raycaster.set(this.position,t.normalize()),this.raycaster.far=e;let i=this.raycaster.intersectObject(this.maze,!0);return i=i.filter(t=>!(t.object instanceof Ji)),i.length&&this.targetLocked?(this.targetPosition=this.targetPosition.clone(),this.targetLocked=!1):i.length||this.targetLocked||(this.targetPosition=this.target.position,this.targetLocked=!0),this.targetLocked}onCollision(t,e){const i=t.object;if(!this.sidestep&&i instanceof Ji){const t=this.worldToLocal(i.position.clone()).cross(this.up),n=this.worldToLocal(this.target.position.clone());this.sidestep=Math.sign(n.dot(t)),this.sidestepStartTime=e}return!0}update(t,e){if(void 0===this.animStartTime&&this.startAnimation(this.anim,t),t>this.sidestepStartTime+1&&(this.sidestep=0),this.target&&t>this.lastThinkTime+.25&&(this.think(t),this.lastThinkTime=t),this.moveDestination&&"move"==this.anim){this.velocity.copy(this.moveDestination).sub(this.position),this.sidestep&&this.velocity.cross(this.up.clone().multiplyScalar(this.sidestep)),this.velocity.clampLength(0,this.speed);let t=this.size;this.target&&(t+=this.target.size),this.velocity.length()<t&&(this.moveDestination=null,this.velocity.set(0,0,0))}else this.velocity.set(0,0,0);if(super.update(t,e),this.texture){const e=t-this.animStartTime,i=this.animations[this.anim];let n=Math.floor(e*i.speed);if(n>=i.length)if("death"==this.anim)this.removeDead&&(this.shouldRemove=!0),n=i.length-1;else{if("move"!=this.anim)return this.startAnimation("move",t),this.update(t);this.animStartTime=t,n%=i.length}this.texture.setFrame(i.start+n)}}}class Qs extends Js{think(t){if(this.sightTarget()){const e=t-this.lastAttackTime,i=this.size+this.target.size;this.position.distanceTo(this.targetPosition)<i&&"move"==this.anim&&e>=this.attackInterval?this.attack(this.targetPosition,t):this.moveDestination=this.targetPosition,this.sidestep=0}else this.moveDestination=this.targetPosition}}class Ks extends Js{constructor(...t){super(...t),ps.load("sounds/adlib/shoot.wav",t=>{this.shootSound=new po(us).setBuffer(t),this.add(this.shootSound)})}attack(t,e){super.attack(t,e);const i=t.clone().sub(this.position).normalize(),n=new Ws({type:"Fireball",position:this.position.clone().addScaledVector(i,2/3),direction:i,isBig:!1});this.parent.add(n),this.shootSound&&this.shootSound.play()}think(t){if(this.sightTarget()){const e=t-this.lastAttackTime;"move"==this.anim&&e>=this.attackInterval&&this.attack(this.targetPosition,t),this.sidestep=0}else this.moveDestination=this.targetPosition}}var $s=Object.freeze({__proto__:null,Enemy:Js,MeleeEnemy:Qs,RangedEnemy:Ks,Orc:class extends Qs{constructor(t){super("sprites/orc.png",t,3,.5,2,1,{frameWidth:51,animations:{move:{start:0,length:4,speed:4},attack:{start:4,length:2,speed:2},pain:{start:6,length:1,speed:4},death:{start:6,length:4,speed:6}}})}},Troll:class extends Qs{constructor(t){super("sprites/troll.png",t,10,.75,5,1,{frameWidth:64,animations:{move:{start:0,length:4,speed:6},attack:{start:4,length:3,speed:5},pain:{start:7,length:1,speed:4},death:{start:7,length:4,speed:6}}})}},Bat:class extends Qs{constructor(t){super("sprites/bat.png",t,1,.5,5,0,{frameWidth:40,animations:{move:{start:0,length:4,speed:16},death:{start:4,length:2,speed:8}}}),this.sprite.scale.x=.625;this.sprite.scale.multiplyScalar(.8),this.sprite.translateZ(-.1),this.removeDead=!0}},Mage:class extends Ks{constructor(t){super("sprites/mage.png",t,5,.5,1.5,2,{frameWidth:56,animations:{move:{start:0,length:2,speed:3},attack:{start:2,length:1,speed:3},pain:{start:3,length:1,speed:2},death:{start:3,length:3,speed:7}}}),this.sprite.scale.x=.875}},Demon:class extends Qs{constructor(t){super("sprites/demon.png",t,50,.75,1.5,1,{frameWidth:64,animations:{move:{start:0,length:4,speed:3.5},attack:{start:4,length:3,speed:4},pain:{start:7,length:1,speed:4},death:{start:7,length:4,speed:6}}})}},Nemesis:class extends Ks{constructor(t){super("sprites/nemesis.png",t,100,.5,5,2,{frameWidth:64,animations:{move:{start:0,length:2,speed:10},attack:{start:2,length:1,speed:2},pain:{start:3,length:1,speed:4},death:{start:3,length:7,speed:8}}})}}});class tc extends ie{constructor(t){super({vertexShader:"\nvarying vec3 vNormal;\nvarying vec3 vModelPosition;\n\n#include <common>\n#include <fog_pars_vertex>\n#include <uv_pars_vertex>\n\nvoid main() {\n\t#include <begin_vertex>\n\t#include <project_vertex>\n\n\t#include <beginnormal_vertex>\n\t#include <defaultnormal_vertex>\n\n\tvNormal = normalize(transformedNormal);\n\tvModelPosition = transformed;\n\n\t#include <fog_vertex>\n\t#include <uv_vertex>\n}",fragmentShader:"\nuniform bool clampColor;\nuniform vec3 diffuse;\nuniform float interweaveMin;\nuniform float interweaveSteps;\nuniform float opacity;\nuniform int pixelate;\n\nuniform mat4 modelViewMatrix;\n\nvarying vec3 vNormal;\nvarying vec3 vModelPosition;\n\nvec3 colorPalette[16];\n\nvoid initColorPalette() {\n\tcolorPalette[0] = vec3(0, 0, 0);\n\tcolorPalette[1] = vec3(0, 0, 2.0/3.0);\n\tcolorPalette[2] = vec3(0, 2.0/3.0, 0);\n\tcolorPalette[3] = vec3(0, 2.0/3.0, 2.0/3.0);\n\tcolorPalette[4] = vec3(2.0/3.0, 0, 0);\n\tcolorPalette[5] = vec3(2.0/3.0, 0, 2.0/3.0);\n\tcolorPalette[6] = vec3(2.0/3.0, 1.0/3.0, 0);\n\tcolorPalette[7] = vec3(2.0/3.0, 2.0/3.0, 2.0/3.0);\n\tcolorPalette[8] = vec3(1.0/3.0, 1.0/3.0, 1.0/3.0);\n\tcolorPalette[9] = vec3(1.0/3.0, 1.0/3.0, 1);\n\tcolorPalette[10] = vec3(1.0/3.0, 1, 1.0/3.0);\n\tcolorPalette[11] = vec3(1.0/3.0, 1, 1);\n\tcolorPalette[12] = vec3(1, 1.0/3.0, 1.0/3.0);\n\tcolorPalette[13] = vec3(1, 1.0/3.0, 1);\n\tcolorPalette[14] = vec3(1, 1, 1.0/3.0);\n\tcolorPalette[15] = vec3(1, 1, 1);\n}\n\nvec3 clampToPalette(const in vec3 color) {\n\tfloat smallestDistance = 100000.0;\n\tvec3 closestColor = color;\n\tfor (int i = 0; i < 16; i++) {\n\t\tfloat distance = distance(color, colorPalette[i]);\n\t\tif (distance < smallestDistance) {\n\t\t\tsmallestDistance = distance;\n\t\t\tclosestColor = colorPalette[i];\n\t\t}\n\t}\n\treturn closestColor;\n}\n\n#include <common>\n#include <bsdfs>\n#include <fog_pars_fragment>\n#include <lights_pars_begin>\n#include <lights_pars_maps>\n#include <map_pars_fragment>\n#include <uv_pars_fragment>\n\nvoid RE_Direct_Pixelated(const in IncidentLight directLight, const in GeometricContext geometry, const in vec3 material, inout ReflectedLight reflectedLight) {\n\n\tfloat dotNL = saturate(dot(geometry.normal, directLight.direction));\n\tvec3 irradiance = dotNL * directLight.color;\n\n\t#ifndef PHYSICALLY_CORRECT_LIGHTS\n\t\tirradiance *= PI; // punctual light\n\t#endif\n\n\treflectedLight.directDiffuse += mix(irradiance * material, irradiance, 0.5);\n}\n\nvoid RE_IndirectDiffuse_Pixelated(const in vec3 irradiance, const in GeometricContext geometry, const in vec3 material, inout ReflectedLight reflectedLight) {\n\treflectedLight.indirectDiffuse += irradiance * material;  // FIXME: this is a color right now\n}\n\n#define RE_Direct          RE_Direct_Pixelated\n#define RE_IndirectDiffuse RE_IndirectDiffuse_Pixelated\n\nvoid main() {\n\tinitColorPalette();\n\n\tvec4 diffuseColor = vec4(diffuse, opacity);\n\n\t#include <map_fragment>\n\n\t#include <normal_fragment_begin>\n\t#include <normal_fragment_maps>\n\n\tReflectedLight reflectedLight = ReflectedLight(vec3(0.0), vec3(0.0), vec3(0.0), vec3(0.0));\n\n\tvec3 material = diffuseColor.rgb;\n\n\tvec3 modelPosition = vModelPosition;\n\tfloat interweave = 1.0;\n\tif (pixelate > 0) {\n\t\tmodelPosition = floor(modelPosition * float(pixelate));\n\t\tfloat interweaveStep = (1.0 - interweaveMin) / interweaveSteps;\n\t\tinterweave = interweaveMin + interweaveStep * mod(dot(modelPosition, vec3(1)), interweaveSteps);\n\t\tmodelPosition /= float(pixelate);\n\t}\n\tvec3 vViewPosition = -(modelViewMatrix * vec4(modelPosition, 1.0)).xyz;\n\n\t#include <lights_fragment_begin>\n\t#include <lights_fragment_maps>\n\t#include <lights_fragment_end>\n\n\tvec3 outgoingLight = reflectedLight.indirectDiffuse;\n\toutgoingLight += reflectedLight.directDiffuse * interweave;\n\tgl_FragColor = vec4(outgoingLight, diffuseColor.a);\n\n\tif (clampColor) {\n\t\tgl_FragColor.rgb = clampToPalette(gl_FragColor.rgb);\n\t}\n\n\t#include <fog_fragment>\n}",uniforms:ot.merge([lt.common,lt.fog,lt.lights,{clampColor:{value:!0},interweaveMin:{value:2/3},interweaveSteps:{value:3},pixelate:{value:64}}]),fog:!0,lights:!0}),this.setValues(t)}get clampColor(){return this.uniforms.clampColor.value}set clampColor(t){this.uniforms.clampColor.value=t}get color(){return this.uniforms.diffuse.value}set color(t){t.isColor?this.uniforms.diffuse.value=t:this.uniforms.diffuse.value=new ct(t)}get interweaveMin(){return this.uniforms.interweaveMin.value}set interweaveMin(t){this.uniforms.interweaveMin.value=t}get interweaveSteps(){return this.uniforms.interweaveSteps.value}set interweaveSteps(t){this.uniforms.interweaveSteps.value=t}get map(){return this.uniforms.map.value}set map(t){this.uniforms.map.value=t}get pixelate(){return this.uniforms.pixelate.value}set pixelate(t){this.uniforms.pixelate.value=t}}class ec extends jt{constructor(t){super(1,1),this.translate(t.x,t.y,-.5)}}class ic extends jt{constructor(t,e){super(1,1),this.rotateX(Math.PI/2),this.translate(0,-.5,0);this.rotateZ(["south","east","north","west"].indexOf(t)*Math.PI/2),e&&this.translate(e.x,e.y,0)}}function nc(t){return Object.keys(t).map(e=>{const i=(new Gt).fromGeometry(t[e]),n=Fs.get("walls/"+e+".png");n.anisotropy=8;const r=new tc({map:n}),a=new oe(i,r);return a.dispose=function(){i.dispose(),r.dispose(),n.dispose()},a})}function rc(t,e,i,n){i=i||{};const r={east:"light",west:"light",north:"dark",south:"dark"};return e.forEach(e=>{const a=t+"_"+r[e];a in i||(i[a]=new At),i[a].merge(new ic(e,n))}),i}class ac extends Gs{constructor(t,e){super(t),this.type=this.type||"Door",this.persistedProps.push("color"),this.removeFunc=e;const i=new Vt(1,1,1);i.rotateX(Math.PI/2);const n=new tc;Fs.get("walls/"+this.color+"_door.png",t=>{t.anisotropy=8;const e=new Bs(t);n.map=e,n.needsUpdate=!0}),this.mesh=new oe(i,n),this.add(this.mesh),this.frequency=9+.5*Math.random(),this.adjacent=[],ps.load("sounds/adlib/use_key.wav",t=>{this.unlockSound=new po(us),this.unlockSound.setBuffer(t),this.add(this.unlockSound)})}dispose(){this.mesh.geometry.dispose(),this.mesh.material.dispose(),this.mesh.material.map.dispose()}getState(){return null}update(t){if(this.mesh.material.map){Math.floor(this.frequency*t)}}unlock(t){return!this.shouldRemove&&(this.shouldRemove=!0,this.removeFunc(),this.adjacent.forEach(t=>t.unlock(!0)),!0)}}class oc extends Gs{constructor(t,e){super(t),this.type="ExplodingWall",this.persistedProps.push("ignition","faces","wall"),this.removeFunc=e,this.walls=nc(rc(this.wall,this.faces)),this.add(...this.walls);const i=new Vt(1,1,1).rotateX(Math.PI/2),n=new ee({transparent:!0});this.box=new oe(i,n),Fs.get("walls/exploding.png",t=>{this.box.material.map=new Bs(t,64,3),this.box.material.needsUpdate=!0}),this.adjacent=[],this.burnDuration=.25,this.spreadDuration=this.burnDuration/2}dispose(){this.box.geometry.dispose(),this.box.material.dispose(),this.box.material.map.dispose(),this.walls.forEach(t=>t.dispose())}ignite(t){t>=this.ignition||(this.ignition=t,this.removeFunc&&this.removeFunc(),this.adjacent.forEach(e=>e.ignite(t+this.spreadDuration)))}getState(){return this.ignition?super.getState():null}onDamage(t){this.ignite(t)}update(t){const e=t-this.ignition;if(e>=this.burnDuration)this.shouldRemove=!0;else if(e>0){this.exploding||(this.exploding=!0,this.children.forEach(t=>t.shouldRemove=!0),this.add(this.box));const t=this.box.material.map;if(t){const i=Math.floor(e*t.frames/this.burnDuration);t.setFrame(i)}}}}class sc extends Gs{constructor(t,...e){super(t),this.itemFrames=e;const i=this.soundName||this.type.toLowerCase();ps.load("sounds/adlib/pickup_"+i+".wav",t=>{this.pickupSound=new po(us),this.pickupSound.setBuffer(t),this.add(this.pickupSound)}),Fs.get("sprites/items.png",t=>{this.texture=t,this.spritesheet=new Bs(t,40,11),this.spritesheet.setFrame(this.itemFrames[0]),this.sprite=new Ji(new Zi({fog:!0,map:this.spritesheet})),this.sprite.scale.multiplyScalar(.6),this.sprite.translateZ(-.2),this.add(this.sprite)})}dispose(){this.sprite.material.dispose(),this.texture.dispose()}pickup(){return this.pickupSound&&this.pickupSound.play(),this.shouldRemove=!0,this}update(t){if(this.itemFrames.length>1&&this.spritesheet){const e=Math.floor(8*t)%this.itemFrames.length,i=this.itemFrames[e];this.spritesheet.setFrame(i)}}}function cc(t,e){return class extends sc{constructor(i){super(Object.assign(i,e),...t)}}}const hc=cc([0,1]),lc=cc([2,3]),uc=cc([4]),pc=cc([5],{soundName:"key"}),dc=cc([6],{soundName:"key"}),fc=cc([7],{soundName:"key"}),mc=cc([8],{soundName:"key"}),gc=cc([9]),vc=cc([10]);var yc=Object.freeze({__proto__:null,Item:sc,Bolt:hc,Nuke:lc,Potion:uc,RedKey:pc,YellowKey:dc,GreenKey:fc,BlueKey:mc,Scroll:gc,Treasure:vc,Grelminar:class extends Gs{constructor(t){super(t),Fs.get("sprites/grelminar.png",t=>{this.sprite=new Ji(new Zi({fog:!0,map:t})),this.add(this.sprite)})}pickup(){return console.log("Game over, man! Game over!"),this}}});function xc(t,e,i,n,r){[t[[i-1,n]],t[[i+1,n]],t[[i,n-1]],t[[i,n+1]]].filter(t=>void 0!==t).filter(r||(t=>!0)).forEach(t=>{e.adjacent.push(t),t.adjacent.push(e)}),t[[i,n]]=e}class bc{constructor(t){Object.assign(this,t),"string"==typeof this.layout[0]&&(this.layout=this.layout.map(t=>t.split("")))}getTile(t){const e=this.height-1-Math.round(t.y),i=Math.round(t.x);if(e<0||e>=this.height||i<0||i>=this.width)return null;const n=this.layout[e][i];return" "==n?{type:"floor"}:this.legend[n]}adjacentTiles(t){const e=t.x,i=t.y;return{east:this.getTile(new U(e+1,i)),west:this.getTile(new U(e-1,i)),north:this.getTile(new U(e,i+1)),south:this.getTile(new U(e,i-1))}}adjacentBorders(t){const e=this.getTile(t),i=this.adjacentTiles(t);return Object.keys(i).filter(t=>{const n=i[t];return n&&n.type!=e.type})}getPlayerStart(){return this.entities.filter(t=>"Player"==t.type).shift()||this.playerStart}toScene(){const t=new Yi;return t.add(new ya),this.fog&&(t.fog=new qi(this.fog.color,this.fog.near,this.fog.far)),function(t,e){const i={},n={},r=new At,a={};for(let o=0;o<t.width;o++)for(let s=0;s<t.height;s++){const c=new U(o,s),h=t.getTile(c),l=t.adjacentBorders(c),u=()=>t.layout[t.height-1-s][o]=" ";if("wall"==h.type)rc(h.value,l,a,c);else if("exploding_wall"==h.type){const t=new oc({position:c,wall:h.value,faces:l},u);xc(n,t,o,s),e.add(t)}else if("door"==h.type){const t=new ac({color:h.value,position:c},u);xc(i,t,o,s,e=>e.color==t.color),e.add(t)}"wall"!=h.type&&r.merge(new ec(c))}const o=new tc({color:5592405,clampColor:!1,pixelate:0});e.add(new oe((new Gt).fromGeometry(r),o)),e.add(...nc(a))}(this,t),function(t,e){const i=Object.assign({},$s,yc,Zs,{ExplodingWall:oc});t.forEach(t=>{const n=new F(t.position[0],t.position[1],0),r=i[t.type];r?e.add(new r(t)):console.warn("class not found for",t.type,"at",n)})}(this.entities,t),t}}class wc extends Hs{constructor(t,e){super(t,2/3,5),this.persistedProps.push("direction","inventory"),t.direction&&(this.direction=t.direction),this.inventory||(this.inventory={}),this.game=e,this.audioListener=us,this.add(this.audioListener),this.camera=new Hi(45,0,.01,256),this.camera.rotation.set(0,Math.PI,0),this.add(this.camera),this.light=new ma(15031040,0,0),this.add(this.light),this.score=0,this.footstepIdx=0,this.footsteps=[];for(let t=0;t<2;t++)ps.load("sounds/adlib/footstep"+t+".wav",t=>{const e=new uo(us);e.setBuffer(t),e.setVolume(1/3),this.footsteps.push(e)});Fs.get("sprites/hand.png",t=>{const e=Bs(t,88,2);e.repeat.y=88/72,this.hand=new Ji(new Zi({map:e})),this.hand.setFrame=t=>{e.setFrame(t),this.light.intensity=t},this.hand.scale.divideScalar(20),this.hand.outPosition=new F(-.0032,-.0165,.1),this.hand.inPosition=new F(-.0032,-.033,.05),this.hand.position.copy(this.hand.inPosition),this.add(this.hand);["shoot","big_shoot"].forEach(t=>{const e="sounds/adlib/"+t+".wav";ps.load(e,e=>{const i=new po(us).setBuffer(e);this[t.replace("_","")+"Sound"]=i,this.hand.add(i)})})})}get direction(){return this.getWorldDirection(new F)}set direction(t){const e=(new F).copy(t),i=this.position.clone().add(e);this.lookAt(i)}onCollision(t,e){for(let e=t.object;e;e=e.parent){if(e.pickup)return this.pickupItem(e),!1;if(e instanceof ac)return!this.unlockDoor(e);if(e instanceof qs){const t=this.velocity.clone().normalize().multiplyScalar(2/3);return this.warpToPosition=e.destination.clone().add(t),!1}e instanceof Ys&&this.game.changeMap(e.value)}return!0}pickupItem(t){if(t instanceof vc)this.score+=t.value;else{const e=t.type.toLowerCase();void 0===this.inventory[e]&&(this.inventory[e]=0),this.inventory[e]+=1}t.pickup()}unlockDoor(t){const e=t.color+"key";return!!this.inventory[e]&&(t.unlock()&&(this.inventory[e]-=1),!0)}update(t,e){if(super.update(t,e),this.hand&&this.updateHand(t),this.velocity.lengthSq()&&!this.frozen){t-this.lastStep>7/3/this.speed&&(this.footsteps[this.footstepIdx].play(),this.footstepIdx=(this.footstepIdx+1)%this.footsteps.length,this.lastStep=t)}else this.lastStep=t}updateHand(t){const e=this.hand.position.clone().sub(this.hand.inPosition).length()/this.hand.outPosition.clone().sub(this.hand.inPosition).length();if(this.chargeStarted){const i=t-this.chargeStarted;this.hand.position.lerpVectors(this.hand.inPosition,this.hand.outPosition,Math.min(1,Math.max(e,2*i)));const n=Math.ceil(8*i)%2;this.hand.setFrame(n),this.light.distance=2.5*Math.min(1,i)}else if(this.lastFire){const i=t-this.lastFire;this.hand.position.lerpVectors(this.hand.outPosition,this.hand.inPosition,Math.min(1,Math.max(1-e,i-1)))}}binds(){return{ArrowUp:this.moveForward.bind(this),ArrowLeft:this.turnLeft.bind(this),ArrowDown:this.moveBackward.bind(this),ArrowRight:this.turnRight.bind(this),KeyW:this.moveForward.bind(this),KeyA:this.moveLeft.bind(this),KeyS:this.moveBackward.bind(this),KeyD:this.moveRight.bind(this),ShiftLeft:this.sprint.bind(this),Space:this.shoot.bind(this)}}updateMovement(){let t=0,e=0,i=0;this.movingForward&&(t+=1),this.movingBackward&&(t-=1),this.movingLeft&&(e+=1),this.movingRight&&(e-=1),this.turningLeft&&(i+=1),this.turningRight&&(i-=1),this.moveDirection.z=t,this.moveDirection.x=e,this.turnDirection=i,this.updateVelocity()}stopMovement(){this.movingForward=!1,this.movingBackward=!1,this.movingLeft=!1,this.movingRight=!1,this.turningLeft=!1,this.turningRight=!1,this.updateMovement()}moveForward(t){this.movingForward=t,this.updateMovement()}moveBackward(t){this.movingBackward=t,this.updateMovement()}moveLeft(t){this.movingLeft=t,this.updateMovement()}moveRight(t){this.movingRight=t,this.updateMovement()}sprint(t){this.speed*=t>0?2:.5,this.updateVelocity()}turnLeft(t){this.turningLeft=t,this.updateMovement()}turnRight(t){this.turningRight=t,this.updateMovement()}shoot(t){if(t&&!this.chargeStarted)this.chargeStarted=this.lastTime,this.hand.setFrame(1),this.light.distance=0;else if(this.chargeStarted){const t=this.lastTime-this.chargeStarted,e=this.getWorldDirection(new F),i=new Ws({type:"Fireball",position:this.position.clone().addScaledVector(e,2/3),direction:e,isBig:t>1});this.parent.add(i);const n=i.isBig?this.bigshootSound:this.shootSound;n.isPlaying&&n.stop(),n.play(),this.chargeStarted=0,this.lastFire=this.lastTime,this.hand.setFrame(0);const r=this.hand.position.clone().sub(this.hand.inPosition).length()/this.hand.outPosition.clone().sub(this.hand.inPosition).length();this.hand.position.lerpVectors(this.hand.inPosition,this.hand.outPosition,Math.min(1,r+.25))}}}class _c extends ie{constructor(t,e){super({vertexShader:"\n#define USE_MAP = 1;\n#include <uv_pars_vertex>\n\nvoid main() {\n\t#include <begin_vertex>\n\t#include <project_vertex>\n\t#include <uv_vertex>\n}",fragmentShader:"\nuniform float aspectRatio;\nuniform float pixelate;\nuniform sampler2D tex1;\nuniform sampler2D tex2;\nuniform float weight;\n\n#define USE_MAP = 1;\n#include <uv_pars_fragment>\n\nvec4 mod289(vec4 x)\n{\n  return x - floor(x * (1.0 / 289.0)) * 289.0;\n}\n\nvec4 permute(vec4 x)\n{\n  return mod289(((x*34.0)+1.0)*x);\n}\n\nvec4 taylorInvSqrt(vec4 r)\n{\n  return 1.79284291400159 - 0.85373472095314 * r;\n}\n\nvec2 fade(vec2 t) {\n  return t*t*t*(t*(t*6.0-15.0)+10.0);\n}\n\n// Classic Perlin noise\nfloat cnoise(vec2 P)\n{\n  vec4 Pi = floor(P.xyxy) + vec4(0.0, 0.0, 1.0, 1.0);\n  vec4 Pf = fract(P.xyxy) - vec4(0.0, 0.0, 1.0, 1.0);\n  Pi = mod289(Pi); // To avoid truncation effects in permutation\n  vec4 ix = Pi.xzxz;\n  vec4 iy = Pi.yyww;\n  vec4 fx = Pf.xzxz;\n  vec4 fy = Pf.yyww;\n\n  vec4 i = permute(permute(ix) + iy);\n\n  vec4 gx = fract(i * (1.0 / 41.0)) * 2.0 - 1.0 ;\n  vec4 gy = abs(gx) - 0.5 ;\n  vec4 tx = floor(gx + 0.5);\n  gx = gx - tx;\n\n  vec2 g00 = vec2(gx.x,gy.x);\n  vec2 g10 = vec2(gx.y,gy.y);\n  vec2 g01 = vec2(gx.z,gy.z);\n  vec2 g11 = vec2(gx.w,gy.w);\n\n  vec4 norm = taylorInvSqrt(vec4(dot(g00, g00), dot(g01, g01), dot(g10, g10), dot(g11, g11)));\n  g00 *= norm.x;  \n  g01 *= norm.y;  \n  g10 *= norm.z;  \n  g11 *= norm.w;  \n\n  float n00 = dot(g00, vec2(fx.x, fy.x));\n  float n10 = dot(g10, vec2(fx.y, fy.y));\n  float n01 = dot(g01, vec2(fx.z, fy.z));\n  float n11 = dot(g11, vec2(fx.w, fy.w));\n\n  vec2 fade_xy = fade(Pf.xy);\n  vec2 n_x = mix(vec2(n00, n01), vec2(n10, n11), fade_xy.x);\n  float n_xy = mix(n_x.x, n_x.y, fade_xy.y);\n  return 2.3 * n_xy;\n}\n\n// Classic Perlin noise, periodic variant\nfloat pnoise(vec2 P, vec2 rep)\n{\n  vec4 Pi = floor(P.xyxy) + vec4(0.0, 0.0, 1.0, 1.0);\n  vec4 Pf = fract(P.xyxy) - vec4(0.0, 0.0, 1.0, 1.0);\n  Pi = mod(Pi, rep.xyxy); // To create noise with explicit period\n  Pi = mod289(Pi);        // To avoid truncation effects in permutation\n  vec4 ix = Pi.xzxz;\n  vec4 iy = Pi.yyww;\n  vec4 fx = Pf.xzxz;\n  vec4 fy = Pf.yyww;\n\n  vec4 i = permute(permute(ix) + iy);\n\n  vec4 gx = fract(i * (1.0 / 41.0)) * 2.0 - 1.0 ;\n  vec4 gy = abs(gx) - 0.5 ;\n  vec4 tx = floor(gx + 0.5);\n  gx = gx - tx;\n\n  vec2 g00 = vec2(gx.x,gy.x);\n  vec2 g10 = vec2(gx.y,gy.y);\n  vec2 g01 = vec2(gx.z,gy.z);\n  vec2 g11 = vec2(gx.w,gy.w);\n\n  vec4 norm = taylorInvSqrt(vec4(dot(g00, g00), dot(g01, g01), dot(g10, g10), dot(g11, g11)));\n  g00 *= norm.x;  \n  g01 *= norm.y;  \n  g10 *= norm.z;  \n  g11 *= norm.w;  \n\n  float n00 = dot(g00, vec2(fx.x, fy.x));\n  float n10 = dot(g10, vec2(fx.y, fy.y));\n  float n01 = dot(g01, vec2(fx.z, fy.z));\n  float n11 = dot(g11, vec2(fx.w, fy.w));\n\n  vec2 fade_xy = fade(Pf.xy);\n  vec2 n_x = mix(vec2(n00, n01), vec2(n10, n11), fade_xy.x);\n  float n_xy = mix(n_x.x, n_x.y, fade_xy.y);\n  return 2.3 * n_xy;\n}\n\nvoid main() {\n\tvec4 color1 = texture2D(tex1, vUv);\n\tvec4 color2 = texture2D(tex2, vUv);\n\tvec2 pixel = vUv * vec2(pixelate * aspectRatio, pixelate);\n\tpixel = floor(pixel) + vec2(0.5);\n\tfloat noise = cnoise(pixel);\n\tnoise = step(1.0-2.0*weight, noise);\n\tgl_FragColor = mix(color1, color2, noise);\n}",uniforms:ot.merge([lt.common,{aspectRatio:{value:1},pixelate:{value:128},tex1:{value:t},tex2:{value:e},weight:{value:0}}])})}}class Mc{constructor(t,e,i,n){this.fbo1=i,this.fbo2=n,this.camera=new _t(-t/2,t/2,e/2,-e/2,-10,10),this.material=new _c(i.texture,n.texture),this.plane=new oe(new Wt(1,1),this.material),this.scene=new Yi,this.scene.add(this.camera,this.plane)}setMix(t){this.material.uniforms.weight.value=t}setSize(t,e){this.material.uniforms.aspectRatio.value=t/e,this.camera.left=-t/2,this.camera.right=t/2,this.camera.top=e/2,this.camera.bottom=-e/2,this.camera.updateProjectionMatrix(),this.plane.scale.set(t,e,1)}}F.prototype.copy=function(t){return"x"in t&&(this.x=t.x),"y"in t&&(this.y=t.y),"z"in t&&(this.z=t.z),this};class Ec{constructor(t){this.actor=t,this.touches={},this.touchOrigins={},document.addEventListener("touchstart",this.onTouchStart.bind(this)),document.addEventListener("touchmove",this.onTouchMove.bind(this)),document.addEventListener("touchend",this.onTouchEnd.bind(this))}partition(t){const e=[],i=[];for(let n of t)n.screenX<window.screen.width/2?e.push(n):i.push(n);return{left:e,right:i}}moveForward(){this.movingForward=!0,this.actor.moveForward(.5)}onTouchStart(t){this.touches=this.partition(t.touches),this.actor.shoot&&this.actor.shoot(this.touches.right.length),this.touches.left.length&&!this.movingForward&&(this.timeoutId=window.setTimeout(this.moveForward.bind(this),500));for(let e of t.changedTouches)this.touchOrigins[e.identifier]={x:e.screenX,y:e.screenY}}onTouchMove(t){if(this.touches=this.partition(t.touches),this.touches.left.length<1)return;let e=0;for(let t of this.touches.left){const i=this.touchOrigins[t.identifier].x-t.screenX;Math.abs(i)>Math.abs(e)&&(e=i)}let i=e/25;i=Math.min(i,1.5),Math.abs(i)<.5?i=0:this.movingForward||(window.clearTimeout(this.timeoutId),this.timeoutId=window.setTimeout(this.moveForward.bind(this),500)),this.actor.turnDirection=i}onTouchEnd(t){this.touches=this.partition(t.touches),this.actor.shoot&&this.actor.shoot(this.touches.right.length),this.touches.left.length<1&&(window.clearTimeout(this.timeoutId),this.timeoutId=void 0,this.movingForward&&(this.actor.moveForward(-.5),this.movingForward=!1),this.actor.turnDirection=0);for(let e of t.changedTouches)delete this.touchOrigins[e.identifier]}}return t.Game=class{constructor(t,e,i,n,r){this.name=t,this.container=e,this.hud=i,this.hudInventory=i.children.inventory,this.hudLocation=i.children.location,ls=r;const a=JSON.parse(localStorage.getItem(this.name)||"{}");this.fromSave="mapName"in a&&a.mapName==n,this.clock=new hs(a.gameTime),this.mapName=n||a.mapName,this.player=new wc(a.player||{type:"Player"},this),this.renderer=new Wi({antialias:!0}),this.renderer.physicallyCorrectLights=!0,e.appendChild(this.renderer.domElement),this.fbo1=new Q(window.innerWidth,window.innerHeight,{minFilter:v,magFilter:v,format:T,stencilBuffer:!1}),this.fbo2=new Q(window.innerWidth,window.innerHeight,{minFilter:v,magFilter:v,format:T,stencilBuffer:!1}),this.transition=new Mc(window.innerWidth,window.innerHeight,this.fbo1,this.fbo2)}onKey(t){const e=this.player.binds();return e.Enter=this.save.bind(this),i=>{const n=e[i.code];n&&!i.repeat&&n(t)}}onMouseButton(t){return e=>{this.player.shoot(t)}}onMouseMove(t){this.player.rotateY(-t.movementX/2e3),this.player.updateVelocity()}play(){this.clock.start(),this.isActive=!0,this.render()}pause(){this.clock.pause(),this.isActive=!1}render(){const t=this.clock.getElapsedTime(),e=[];this.scene.traverse(i=>{i.update&&i.update(t,this.scene),i.shouldRemove&&e.push(i)}),e.forEach(t=>t.parent.remove(t)),this.player.warpToPosition&&(this.transitionStart=t,this.renderer.render(this.scene,this.player.camera,this.fbo1,!0),this.player.position.copy(this.player.warpToPosition),this.player.warpToPosition=null);const i=t-this.transitionStart,n=2/3;i<n?(this.renderer.render(this.scene,this.player.camera,this.fbo2,!0),this.player.frozen=!0,this.transition.setMix(i/n),this.transition.material.uniforms.tex1.value=this.fbo1.texture,this.transition.material.uniforms.tex2.value=this.fbo2.texture,this.renderer.render(this.transition.scene,this.transition.camera)):(this.player.frozen=!1,this.renderer.render(this.scene,this.player.camera));const r=this.map.getTile(this.player.position);r&&"floor"==r.type?this.hudLocation.innerText=r.value||"":this.hudLocation.innerText="",this.isActive&&requestAnimationFrame(this.render.bind(this))}resizeView(t,e){[this.renderer,this.fbo1,this.fbo2,this.transition].forEach(i=>i.setSize(t,e));[this.player.camera].forEach(i=>{i.aspect=t/e,i.updateProjectionMatrix()})}changeMap(t){if(this.loading)return;this.renderer.render(this.scene,this.player.camera,this.fbo1,!0),this.player.frozen=!0,this.loading=!0;const e=this;this.loadMap(t).then(i=>{e.save(),e.scene.traverse(t=>t.dispose&&t.dispose()),e.transitionStart=e.clock.getElapsedTime(),e.mapName=t,e.map=i,e.scene=i.toScene(),e.scene.add(e.player),e.player.position.copy(i.playerStart.position),e.player.direction=i.playerStart.direction,e.huntPlayer(),e.loading=!1})}huntPlayer(){this.scene.traverse(t=>{"hunt"in t&&t.hunt(this.player,this.scene)})}loadMap(t){const e=localStorage.getItem(t);if(e){const t=new bc(JSON.parse(e));return Promise.resolve(t)}return fetch("maps/"+t+".map.json").then(t=>t.json()).then(t=>new bc(t))}getGlobalState(){return{date:new Date,gameTime:this.clock.getElapsedTime(),mapName:this.mapName,player:this.player.getState()}}getMapState(){const t=[];this.scene.traverse(e=>{if(e instanceof wc)return;const i=e.getState&&e.getState();i&&t.push(i)});const e=Object.assign({},this.map);return e.layout=e.layout.map(t=>t.join("")),e.entities=t,e}save(){localStorage.setItem(this.name,JSON.stringify(this.getGlobalState())),localStorage.setItem(this.mapName,JSON.stringify(this.getMapState()))}setup(){const t=this,e=[["keydown",this.onKey(!0)],["keyup",this.onKey(!1)],["mousedown",this.onMouseButton(!0)],["mouseup",this.onMouseButton(!1)],["mousemove",this.onMouseMove.bind(this)]];this.player.inventory=new Proxy(this.player.inventory,{set:function(e,i,n,r){return e[i]=n,t.drawInventory(e),!0}}),this.drawInventory(this.player.inventory),this.touchControls=new Ec(this.player),document.addEventListener("pointerlockchange",t=>{document.pointerLockElement===this.renderer.domElement?(e.forEach(([t,e])=>document.addEventListener(t,e)),this.container.classList.add("playing")):(e.forEach(([t,e])=>document.removeEventListener(t,e)),this.container.classList.remove("playing"),this.player.stopMovement())}),this.loadMap(this.mapName).then(e=>{t.map=e,t.scene=e.toScene(),t.scene.add(t.player),t.fromSave||(t.player.position.copy(e.playerStart.position),t.player.direction=e.playerStart.direction),t.huntPlayer(),t.play()})}drawInventory(t){this.hudInventory.innerText=Object.entries(t).filter(([t,e])=>e).map(([t,e])=>`${t}: ${e}`).join("\n")}},t}({});
+Object.assign(Co.prototype,{_getValue_unbound:Co.prototype.getValue,_setValue_unbound:Co.prototype.setValue}),Object.assign(function(){this.uuid=D.generateUUID(),this._objects=Array.prototype.slice.call(arguments),this.nCachedObjects_=0;var t={};this._indicesByUUID=t;for(var e=0,i=arguments.length;e!==i;++e)t[arguments[e].uuid]=e;this._paths=[],this._parsedPaths=[],this._bindings=[],this._bindingsIndicesByPath={};var n=this;this.stats={objects:{get total(){return n._objects.length},get inUse(){return this.total-n.nCachedObjects_}},get bindingsPerObject(){return n._bindings.length}}}.prototype,{isAnimationObjectGroup:!0,add:function(){for(var t=this._objects,e=t.length,i=this.nCachedObjects_,n=this._indicesByUUID,r=this._paths,a=this._parsedPaths,o=this._bindings,s=o.length,c=void 0,h=0,l=arguments.length;h!==l;++h){var u=arguments[h],p=u.uuid,d=n[p];if(void 0===d){d=e++,n[p]=d,t.push(u);for(var f=0,m=s;f!==m;++f)o[f].push(new Co(u,r[f],a[f]))}else if(d<i){c=t[d];var g=--i,v=t[g];n[v.uuid]=d,t[d]=v,n[p]=g,t[g]=u;for(f=0,m=s;f!==m;++f){var y=o[f],x=y[g],b=y[d];y[d]=x,void 0===b&&(b=new Co(u,r[f],a[f])),y[g]=b}}else t[d]!==c&&console.error("THREE.AnimationObjectGroup: Different objects with the same UUID detected. Clean the caches or recreate your infrastructure when reloading scenes.")}this.nCachedObjects_=i},remove:function(){for(var t=this._objects,e=this.nCachedObjects_,i=this._indicesByUUID,n=this._bindings,r=n.length,a=0,o=arguments.length;a!==o;++a){var s=arguments[a],c=s.uuid,h=i[c];if(void 0!==h&&h>=e){var l=e++,u=t[l];i[u.uuid]=h,t[h]=u,i[c]=l,t[l]=s;for(var p=0,d=r;p!==d;++p){var f=n[p],m=f[l],g=f[h];f[h]=m,f[l]=g}}}this.nCachedObjects_=e},uncache:function(){for(var t=this._objects,e=t.length,i=this.nCachedObjects_,n=this._indicesByUUID,r=this._bindings,a=r.length,o=0,s=arguments.length;o!==s;++o){var c=arguments[o],h=c.uuid,l=n[h];if(void 0!==l)if(delete n[h],l<i){var u=--i,p=t[u],d=t[y=--e];n[p.uuid]=l,t[l]=p,n[d.uuid]=u,t[u]=d,t.pop();for(var f=0,m=a;f!==m;++f){var g=(x=r[f])[u],v=x[y];x[l]=g,x[u]=v,x.pop()}}else{var y;n[(d=t[y=--e]).uuid]=l,t[l]=d,t.pop();for(f=0,m=a;f!==m;++f){var x;(x=r[f])[l]=x[y],x.pop()}}}this.nCachedObjects_=i},subscribe_:function(t,e){var i=this._bindingsIndicesByPath,n=i[t],r=this._bindings;if(void 0!==n)return r[n];var a=this._paths,o=this._parsedPaths,s=this._objects,c=s.length,h=this.nCachedObjects_,l=new Array(c);n=r.length,i[t]=n,a.push(t),o.push(e),r.push(l);for(var u=h,p=s.length;u!==p;++u){var d=s[u];l[u]=new Co(d,t,e)}return l},unsubscribe_:function(t){var e=this._bindingsIndicesByPath,i=e[t];if(void 0!==i){var n=this._paths,r=this._parsedPaths,a=this._bindings,o=a.length-1,s=a[o];e[t[o]]=i,a[i]=s,a.pop(),r[i]=r[o],r.pop(),n[i]=n[o],n.pop()}}}),Object.assign(Oo.prototype,{play:function(){return this._mixer._activateAction(this),this},stop:function(){return this._mixer._deactivateAction(this),this.reset()},reset:function(){return this.paused=!1,this.enabled=!0,this.time=0,this._loopCount=-1,this._startTime=null,this.stopFading().stopWarping()},isRunning:function(){return this.enabled&&!this.paused&&0!==this.timeScale&&null===this._startTime&&this._mixer._isActiveAction(this)},isScheduled:function(){return this._mixer._isActiveAction(this)},startAt:function(t){return this._startTime=t,this},setLoop:function(t,e){return this.loop=t,this.repetitions=e,this},setEffectiveWeight:function(t){return this.weight=t,this._effectiveWeight=this.enabled?t:0,this.stopFading()},getEffectiveWeight:function(){return this._effectiveWeight},fadeIn:function(t){return this._scheduleFading(t,0,1)},fadeOut:function(t){return this._scheduleFading(t,1,0)},crossFadeFrom:function(t,e,i){if(t.fadeOut(e),this.fadeIn(e),i){var n=this._clip.duration,r=t._clip.duration,a=r/n,o=n/r;t.warp(1,a,e),this.warp(o,1,e)}return this},crossFadeTo:function(t,e,i){return t.crossFadeFrom(this,e,i)},stopFading:function(){var t=this._weightInterpolant;return null!==t&&(this._weightInterpolant=null,this._mixer._takeBackControlInterpolant(t)),this},setEffectiveTimeScale:function(t){return this.timeScale=t,this._effectiveTimeScale=this.paused?0:t,this.stopWarping()},getEffectiveTimeScale:function(){return this._effectiveTimeScale},setDuration:function(t){return this.timeScale=this._clip.duration/t,this.stopWarping()},syncWith:function(t){return this.time=t.time,this.timeScale=t.timeScale,this.stopWarping()},halt:function(t){return this.warp(this._effectiveTimeScale,0,t)},warp:function(t,e,i){var n=this._mixer,r=n.time,a=this._timeScaleInterpolant,o=this.timeScale;null===a&&(a=n._lendControlInterpolant(),this._timeScaleInterpolant=a);var s=a.parameterPositions,c=a.sampleValues;return s[0]=r,s[1]=r+i,c[0]=t/o,c[1]=e/o,this},stopWarping:function(){var t=this._timeScaleInterpolant;return null!==t&&(this._timeScaleInterpolant=null,this._mixer._takeBackControlInterpolant(t)),this},getMixer:function(){return this._mixer},getClip:function(){return this._clip},getRoot:function(){return this._localRoot||this._mixer._root},_update:function(t,e,i,n){if(this.enabled){var r=this._startTime;if(null!==r){var a=(t-r)*i;if(a<0||0===i)return;this._startTime=null,e=i*a}e*=this._updateTimeScale(t);var o=this._updateTime(e),s=this._updateWeight(t);if(s>0)for(var c=this._interpolants,h=this._propertyBindings,l=0,u=c.length;l!==u;++l)c[l].evaluate(o),h[l].accumulate(n,s)}else this._updateWeight(t)},_updateWeight:function(t){var e=0;if(this.enabled){e=this.weight;var i=this._weightInterpolant;if(null!==i){var n=i.evaluate(t)[0];e*=n,t>i.parameterPositions[1]&&(this.stopFading(),0===n&&(this.enabled=!1))}}return this._effectiveWeight=e,e},_updateTimeScale:function(t){var e=0;if(!this.paused){e=this.timeScale;var i=this._timeScaleInterpolant;if(null!==i)e*=i.evaluate(t)[0],t>i.parameterPositions[1]&&(this.stopWarping(),0===e?this.paused=!0:this.timeScale=e)}return this._effectiveTimeScale=e,e},_updateTime:function(t){var e=this.time+t;if(0===t)return e;var i=this._clip.duration,n=this.loop,r=this._loopCount;if(2200===n){-1===r&&(this._loopCount=0,this._setEndings(!0,!0,!1));t:{if(e>=i)e=i;else{if(!(e<0))break t;e=0}this.clampWhenFinished?this.paused=!0:this.enabled=!1,this._mixer.dispatchEvent({type:"finished",action:this,direction:t<0?-1:1})}}else{var a=2202===n;if(-1===r&&(t>=0?(r=0,this._setEndings(!0,0===this.repetitions,a)):this._setEndings(0===this.repetitions,!0,a)),e>=i||e<0){var o=Math.floor(e/i);e-=i*o,r+=Math.abs(o);var s=this.repetitions-r;if(s<=0)this.clampWhenFinished?this.paused=!0:this.enabled=!1,e=t>0?i:0,this._mixer.dispatchEvent({type:"finished",action:this,direction:t>0?1:-1});else{if(1===s){var c=t<0;this._setEndings(c,!c,a)}else this._setEndings(!1,!1,a);this._loopCount=r,this._mixer.dispatchEvent({type:"loop",action:this,loopDelta:o})}}if(a&&1==(1&r))return this.time=e,i-e}return this.time=e,e},_setEndings:function(t,e,i){var n=this._interpolantSettings;i?(n.endingStart=k,n.endingEnd=k):(n.endingStart=t?this.zeroSlopeAtStart?k:O:I,n.endingEnd=e?this.zeroSlopeAtEnd?k:O:I)},_scheduleFading:function(t,e,i){var n=this._mixer,r=n.time,a=this._weightInterpolant;null===a&&(a=n._lendControlInterpolant(),this._weightInterpolant=a);var o=a.parameterPositions,s=a.sampleValues;return o[0]=r,s[0]=e,o[1]=r+t,s[1]=i,this}}),ko.prototype=Object.assign(Object.create(e.prototype),{constructor:ko,_bindAction:function(t,e){var i=t._localRoot||this._root,n=t._clip.tracks,r=n.length,a=t._propertyBindings,o=t._interpolants,s=i.uuid,c=this._bindingsByRootAndName,h=c[s];void 0===h&&(h={},c[s]=h);for(var l=0;l!==r;++l){var u=n[l],p=u.name,d=h[p];if(void 0!==d)a[l]=d;else{if(void 0!==(d=a[l])){null===d._cacheIndex&&(++d.referenceCount,this._addInactiveBinding(d,s,p));continue}var f=e&&e._propertyBindings[l].binding.parsedPath;++(d=new mo(Co.create(i,p,f),u.ValueTypeName,u.getValueSize())).referenceCount,this._addInactiveBinding(d,s,p),a[l]=d}o[l].resultBuffer=d.buffer}},_activateAction:function(t){if(!this._isActiveAction(t)){if(null===t._cacheIndex){var e=(t._localRoot||this._root).uuid,i=t._clip.uuid,n=this._actionsByClip[i];this._bindAction(t,n&&n.knownActions[0]),this._addInactiveAction(t,i,e)}for(var r=t._propertyBindings,a=0,o=r.length;a!==o;++a){var s=r[a];0==s.useCount++&&(this._lendBinding(s),s.saveOriginalState())}this._lendAction(t)}},_deactivateAction:function(t){if(this._isActiveAction(t)){for(var e=t._propertyBindings,i=0,n=e.length;i!==n;++i){var r=e[i];0==--r.useCount&&(r.restoreOriginalState(),this._takeBackBinding(r))}this._takeBackAction(t)}},_initMemoryManager:function(){this._actions=[],this._nActiveActions=0,this._actionsByClip={},this._bindings=[],this._nActiveBindings=0,this._bindingsByRootAndName={},this._controlInterpolants=[],this._nActiveControlInterpolants=0;var t=this;this.stats={actions:{get total(){return t._actions.length},get inUse(){return t._nActiveActions}},bindings:{get total(){return t._bindings.length},get inUse(){return t._nActiveBindings}},controlInterpolants:{get total(){return t._controlInterpolants.length},get inUse(){return t._nActiveControlInterpolants}}}},_isActiveAction:function(t){var e=t._cacheIndex;return null!==e&&e<this._nActiveActions},_addInactiveAction:function(t,e,i){var n=this._actions,r=this._actionsByClip,a=r[e];if(void 0===a)a={knownActions:[t],actionByRoot:{}},t._byClipCacheIndex=0,r[e]=a;else{var o=a.knownActions;t._byClipCacheIndex=o.length,o.push(t)}t._cacheIndex=n.length,n.push(t),a.actionByRoot[i]=t},_removeInactiveAction:function(t){var e=this._actions,i=e[e.length-1],n=t._cacheIndex;i._cacheIndex=n,e[n]=i,e.pop(),t._cacheIndex=null;var r=t._clip.uuid,a=this._actionsByClip,o=a[r],s=o.knownActions,c=s[s.length-1],h=t._byClipCacheIndex;c._byClipCacheIndex=h,s[h]=c,s.pop(),t._byClipCacheIndex=null,delete o.actionByRoot[(t._localRoot||this._root).uuid],0===s.length&&delete a[r],this._removeInactiveBindingsForAction(t)},_removeInactiveBindingsForAction:function(t){for(var e=t._propertyBindings,i=0,n=e.length;i!==n;++i){var r=e[i];0==--r.referenceCount&&this._removeInactiveBinding(r)}},_lendAction:function(t){var e=this._actions,i=t._cacheIndex,n=this._nActiveActions++,r=e[n];t._cacheIndex=n,e[n]=t,r._cacheIndex=i,e[i]=r},_takeBackAction:function(t){var e=this._actions,i=t._cacheIndex,n=--this._nActiveActions,r=e[n];t._cacheIndex=n,e[n]=t,r._cacheIndex=i,e[i]=r},_addInactiveBinding:function(t,e,i){var n=this._bindingsByRootAndName,r=n[e],a=this._bindings;void 0===r&&(r={},n[e]=r),r[i]=t,t._cacheIndex=a.length,a.push(t)},_removeInactiveBinding:function(t){var e=this._bindings,i=t.binding,n=i.rootNode.uuid,r=i.path,a=this._bindingsByRootAndName,o=a[n],s=e[e.length-1],c=t._cacheIndex;s._cacheIndex=c,e[c]=s,e.pop(),delete o[r];t:{for(var h in o)break t;delete a[n]}},_lendBinding:function(t){var e=this._bindings,i=t._cacheIndex,n=this._nActiveBindings++,r=e[n];t._cacheIndex=n,e[n]=t,r._cacheIndex=i,e[i]=r},_takeBackBinding:function(t){var e=this._bindings,i=t._cacheIndex,n=--this._nActiveBindings,r=e[n];t._cacheIndex=n,e[n]=t,r._cacheIndex=i,e[i]=r},_lendControlInterpolant:function(){var t=this._controlInterpolants,e=this._nActiveControlInterpolants++,i=t[e];return void 0===i&&((i=new La(new Float32Array(2),new Float32Array(2),1,this._controlInterpolantsResultBuffer)).__cacheIndex=e,t[e]=i),i},_takeBackControlInterpolant:function(t){var e=this._controlInterpolants,i=t.__cacheIndex,n=--this._nActiveControlInterpolants,r=e[n];t.__cacheIndex=n,e[n]=t,r.__cacheIndex=i,e[i]=r},_controlInterpolantsResultBuffer:new Float32Array(1),clipAction:function(t,e){var i=e||this._root,n=i.uuid,r="string"==typeof t?ka.findByName(i,t):t,a=null!==r?r.uuid:t,o=this._actionsByClip[a],s=null;if(void 0!==o){var c=o.actionByRoot[n];if(void 0!==c)return c;s=o.knownActions[0],null===r&&(r=s._clip)}if(null===r)return null;var h=new Oo(this,r,e);return this._bindAction(h,s),this._addInactiveAction(h,a,n),h},existingAction:function(t,e){var i=e||this._root,n=i.uuid,r="string"==typeof t?ka.findByName(i,t):t,a=r?r.uuid:t,o=this._actionsByClip[a];return void 0!==o&&o.actionByRoot[n]||null},stopAllAction:function(){var t=this._actions,e=this._nActiveActions,i=this._bindings,n=this._nActiveBindings;this._nActiveActions=0,this._nActiveBindings=0;for(var r=0;r!==e;++r)t[r].reset();for(r=0;r!==n;++r)i[r].useCount=0;return this},update:function(t){t*=this.timeScale;for(var e=this._actions,i=this._nActiveActions,n=this.time+=t,r=Math.sign(t),a=this._accuIndex^=1,o=0;o!==i;++o){e[o]._update(n,t,r,a)}var s=this._bindings,c=this._nActiveBindings;for(o=0;o!==c;++o)s[o].apply(a);return this},getRoot:function(){return this._root},uncacheClip:function(t){var e=this._actions,i=t.uuid,n=this._actionsByClip,r=n[i];if(void 0!==r){for(var a=r.knownActions,o=0,s=a.length;o!==s;++o){var c=a[o];this._deactivateAction(c);var h=c._cacheIndex,l=e[e.length-1];c._cacheIndex=null,c._byClipCacheIndex=null,l._cacheIndex=h,e[h]=l,e.pop(),this._removeInactiveBindingsForAction(c)}delete n[i]}},uncacheRoot:function(t){var e=t.uuid,i=this._actionsByClip;for(var n in i){var r=i[n].actionByRoot[e];void 0!==r&&(this._deactivateAction(r),this._removeInactiveAction(r))}var a=this._bindingsByRootAndName[e];if(void 0!==a)for(var o in a){var s=a[o];s.restoreOriginalState(),this._removeInactiveBinding(s)}},uncacheAction:function(t,e){var i=this.existingAction(t,e);null!==i&&(this._deactivateAction(i),this._removeInactiveAction(i))}}),Io.prototype.clone=function(){return new Io(void 0===this.value.clone?this.value:this.value.clone())},No.prototype=Object.assign(Object.create(Gt.prototype),{constructor:No,isInstancedBufferGeometry:!0,copy:function(t){return Gt.prototype.copy.call(this,t),this.maxInstancedCount=t.maxInstancedCount,this},clone:function(){return(new this.constructor).copy(this)}}),Object.defineProperties(Do.prototype,{count:{get:function(){return this.data.count}},array:{get:function(){return this.data.array}}}),Object.assign(Do.prototype,{isInterleavedBufferAttribute:!0,setX:function(t,e){return this.data.array[t*this.data.stride+this.offset]=e,this},setY:function(t,e){return this.data.array[t*this.data.stride+this.offset+1]=e,this},setZ:function(t,e){return this.data.array[t*this.data.stride+this.offset+2]=e,this},setW:function(t,e){return this.data.array[t*this.data.stride+this.offset+3]=e,this},getX:function(t){return this.data.array[t*this.data.stride+this.offset]},getY:function(t){return this.data.array[t*this.data.stride+this.offset+1]},getZ:function(t){return this.data.array[t*this.data.stride+this.offset+2]},getW:function(t){return this.data.array[t*this.data.stride+this.offset+3]},setXY:function(t,e,i){return t=t*this.data.stride+this.offset,this.data.array[t+0]=e,this.data.array[t+1]=i,this},setXYZ:function(t,e,i,n){return t=t*this.data.stride+this.offset,this.data.array[t+0]=e,this.data.array[t+1]=i,this.data.array[t+2]=n,this},setXYZW:function(t,e,i,n,r){return t=t*this.data.stride+this.offset,this.data.array[t+0]=e,this.data.array[t+1]=i,this.data.array[t+2]=n,this.data.array[t+3]=r,this}}),Object.defineProperty(Uo.prototype,"needsUpdate",{set:function(t){!0===t&&this.version++}}),Object.assign(Uo.prototype,{isInterleavedBuffer:!0,onUploadCallback:function(){},setArray:function(t){if(Array.isArray(t))throw new TypeError("THREE.BufferAttribute: array should be a Typed Array.");return this.count=void 0!==t?t.length/this.stride:0,this.array=t,this},setDynamic:function(t){return this.dynamic=t,this},copy:function(t){return this.array=new t.array.constructor(t.array),this.count=t.count,this.stride=t.stride,this.dynamic=t.dynamic,this},copyAt:function(t,e,i){t*=this.stride,i*=e.stride;for(var n=0,r=this.stride;n<r;n++)this.array[t+n]=e.array[i+n];return this},set:function(t,e){return void 0===e&&(e=0),this.array.set(t,e),this},clone:function(){return(new this.constructor).copy(this)},onUpload:function(t){return this.onUploadCallback=t,this}}),zo.prototype=Object.assign(Object.create(Uo.prototype),{constructor:zo,isInstancedInterleavedBuffer:!0,copy:function(t){return Uo.prototype.copy.call(this,t),this.meshPerAttribute=t.meshPerAttribute,this}}),Bo.prototype=Object.assign(Object.create(Lt.prototype),{constructor:Bo,isInstancedBufferAttribute:!0,copy:function(t){return Lt.prototype.copy.call(this,t),this.meshPerAttribute=t.meshPerAttribute,this}}),Object.assign(Fo.prototype,{linePrecision:1,set:function(t,e){this.ray.set(t,e)},setFromCamera:function(t,e){e&&e.isPerspectiveCamera?(this.ray.origin.setFromMatrixPosition(e.matrixWorld),this.ray.direction.set(t.x,t.y,.5).unproject(e).sub(this.ray.origin).normalize()):e&&e.isOrthographicCamera?(this.ray.origin.set(t.x,t.y,(e.near+e.far)/(e.near-e.far)).unproject(e),this.ray.direction.set(0,0,-1).transformDirection(e.matrixWorld)):console.error("THREE.Raycaster: Unsupported camera type.")},intersectObject:function(t,e,i){var n=i||[];return Ho(t,this,n,e),n.sort(Go),n},intersectObjects:function(t,e,i){var n=i||[];if(!1===Array.isArray(t))return console.warn("THREE.Raycaster.intersectObjects: objects is not an Array."),n;for(var r=0,a=t.length;r<a;r++)Ho(t[r],this,n,e);return n.sort(Go),n}}),Object.assign(function(t){this.autoStart=void 0===t||t,this.startTime=0,this.oldTime=0,this.elapsedTime=0,this.running=!1}.prototype,{start:function(){this.startTime=("undefined"==typeof performance?Date:performance).now(),this.oldTime=this.startTime,this.elapsedTime=0,this.running=!0},stop:function(){this.getElapsedTime(),this.running=!1,this.autoStart=!1},getElapsedTime:function(){return this.getDelta(),this.elapsedTime},getDelta:function(){var t=0;if(this.autoStart&&!this.running)return this.start(),0;if(this.running){var e=("undefined"==typeof performance?Date:performance).now();t=(e-this.oldTime)/1e3,this.oldTime=e,this.elapsedTime+=t}return t}}),Object.assign(function(t,e,i){return this.radius=void 0!==t?t:1,this.phi=void 0!==e?e:0,this.theta=void 0!==i?i:0,this}.prototype,{set:function(t,e,i){return this.radius=t,this.phi=e,this.theta=i,this},clone:function(){return(new this.constructor).copy(this)},copy:function(t){return this.radius=t.radius,this.phi=t.phi,this.theta=t.theta,this},makeSafe:function(){var t=1e-6;return this.phi=Math.max(t,Math.min(Math.PI-t,this.phi)),this},setFromVector3:function(t){return this.radius=t.length(),0===this.radius?(this.theta=0,this.phi=0):(this.theta=Math.atan2(t.x,t.z),this.phi=Math.acos(D.clamp(t.y/this.radius,-1,1))),this}}),Object.assign(function(t,e,i){return this.radius=void 0!==t?t:1,this.theta=void 0!==e?e:0,this.y=void 0!==i?i:0,this}.prototype,{set:function(t,e,i){return this.radius=t,this.theta=e,this.y=i,this},clone:function(){return(new this.constructor).copy(this)},copy:function(t){return this.radius=t.radius,this.theta=t.theta,this.y=t.y,this},setFromVector3:function(t){return this.radius=Math.sqrt(t.x*t.x+t.z*t.z),this.theta=Math.atan2(t.x,t.z),this.y=t.y,this}}),Object.assign(Vo.prototype,{set:function(t,e){return this.min.copy(t),this.max.copy(e),this},setFromPoints:function(t){this.makeEmpty();for(var e=0,i=t.length;e<i;e++)this.expandByPoint(t[e]);return this},setFromCenterAndSize:function(){var t=new U;return function(e,i){var n=t.copy(i).multiplyScalar(.5);return this.min.copy(e).sub(n),this.max.copy(e).add(n),this}}(),clone:function(){return(new this.constructor).copy(this)},copy:function(t){return this.min.copy(t.min),this.max.copy(t.max),this},makeEmpty:function(){return this.min.x=this.min.y=1/0,this.max.x=this.max.y=-1/0,this},isEmpty:function(){return this.max.x<this.min.x||this.max.y<this.min.y},getCenter:function(t){return void 0===t&&(console.warn("THREE.Box2: .getCenter() target is now required"),t=new U),this.isEmpty()?t.set(0,0):t.addVectors(this.min,this.max).multiplyScalar(.5)},getSize:function(t){return void 0===t&&(console.warn("THREE.Box2: .getSize() target is now required"),t=new U),this.isEmpty()?t.set(0,0):t.subVectors(this.max,this.min)},expandByPoint:function(t){return this.min.min(t),this.max.max(t),this},expandByVector:function(t){return this.min.sub(t),this.max.add(t),this},expandByScalar:function(t){return this.min.addScalar(-t),this.max.addScalar(t),this},containsPoint:function(t){return!(t.x<this.min.x||t.x>this.max.x||t.y<this.min.y||t.y>this.max.y)},containsBox:function(t){return this.min.x<=t.min.x&&t.max.x<=this.max.x&&this.min.y<=t.min.y&&t.max.y<=this.max.y},getParameter:function(t,e){return void 0===e&&(console.warn("THREE.Box2: .getParameter() target is now required"),e=new U),e.set((t.x-this.min.x)/(this.max.x-this.min.x),(t.y-this.min.y)/(this.max.y-this.min.y))},intersectsBox:function(t){return!(t.max.x<this.min.x||t.min.x>this.max.x||t.max.y<this.min.y||t.min.y>this.max.y)},clampPoint:function(t,e){return void 0===e&&(console.warn("THREE.Box2: .clampPoint() target is now required"),e=new U),e.copy(t).clamp(this.min,this.max)},distanceToPoint:function(){var t=new U;return function(e){return t.copy(e).clamp(this.min,this.max).sub(e).length()}}(),intersect:function(t){return this.min.max(t.min),this.max.min(t.max),this},union:function(t){return this.min.min(t.min),this.max.max(t.max),this},translate:function(t){return this.min.add(t),this.max.add(t),this},equals:function(t){return t.min.equals(this.min)&&t.max.equals(this.max)}}),jo.prototype=Object.create(bt.prototype),jo.prototype.constructor=jo,jo.prototype.isImmediateRenderObject=!0,Wo.prototype=Object.create(rn.prototype),Wo.prototype.constructor=Wo,Wo.prototype.update=function(){var t=new F,e=new F,i=new G;return function(){var n=["a","b","c"];this.object.updateMatrixWorld(!0),i.getNormalMatrix(this.object.matrixWorld);var r=this.object.matrixWorld,a=this.geometry.attributes.position,o=this.object.geometry;if(o&&o.isGeometry)for(var s=o.vertices,c=o.faces,h=0,l=0,u=c.length;l<u;l++)for(var p=c[l],d=0,f=p.vertexNormals.length;d<f;d++){var m=s[p[n[d]]],g=p.vertexNormals[d];t.copy(m).applyMatrix4(r),e.copy(g).applyMatrix3(i).normalize().multiplyScalar(this.size).add(t),a.setXYZ(h,t.x,t.y,t.z),h+=1,a.setXYZ(h,e.x,e.y,e.z),h+=1}else if(o&&o.isBufferGeometry){var v=o.attributes.position,y=o.attributes.normal;for(h=0,d=0,f=v.count;d<f;d++)t.set(v.getX(d),v.getY(d),v.getZ(d)).applyMatrix4(r),e.set(y.getX(d),y.getY(d),y.getZ(d)),e.applyMatrix3(i).normalize().multiplyScalar(this.size).add(t),a.setXYZ(h,t.x,t.y,t.z),h+=1,a.setXYZ(h,e.x,e.y,e.z),h+=1}a.needsUpdate=!0}}(),Xo.prototype=Object.create(bt.prototype),Xo.prototype.constructor=Xo,Xo.prototype.dispose=function(){this.cone.geometry.dispose(),this.cone.material.dispose()},Xo.prototype.update=function(){var t=new F,e=new F;return function(){this.light.updateMatrixWorld();var i=this.light.distance?this.light.distance:1e3,n=i*Math.tan(this.light.angle);this.cone.scale.set(n,n,i),t.setFromMatrixPosition(this.light.matrixWorld),e.setFromMatrixPosition(this.light.target.matrixWorld),this.cone.lookAt(e.sub(t)),void 0!==this.color?this.cone.material.color.set(this.color):this.cone.material.color.copy(this.light.color)}}(),Yo.prototype=Object.create(rn.prototype),Yo.prototype.constructor=Yo,Yo.prototype.updateMatrixWorld=function(){var t=new F,e=new z,i=new z;return function(n){var r=this.bones,a=this.geometry,o=a.getAttribute("position");i.getInverse(this.root.matrixWorld);for(var s=0,c=0;s<r.length;s++){var h=r[s];h.parent&&h.parent.isBone&&(e.multiplyMatrices(i,h.matrixWorld),t.setFromMatrixPosition(e),o.setXYZ(c,t.x,t.y,t.z),e.multiplyMatrices(i,h.parent.matrixWorld),t.setFromMatrixPosition(e),o.setXYZ(c+1,t.x,t.y,t.z),c+=2)}a.getAttribute("position").needsUpdate=!0,bt.prototype.updateMatrixWorld.call(this,n)}}(),Zo.prototype=Object.create(oe.prototype),Zo.prototype.constructor=Zo,Zo.prototype.dispose=function(){this.geometry.dispose(),this.material.dispose()},Zo.prototype.update=function(){void 0!==this.color?this.material.color.set(this.color):this.material.color.copy(this.light.color)},Jo.prototype=Object.create(bt.prototype),Jo.prototype.constructor=Jo,Jo.prototype.dispose=function(){this.children[0].geometry.dispose(),this.children[0].material.dispose()},Jo.prototype.update=function(){var t=.5*this.light.width,e=.5*this.light.height,i=this.line.geometry.attributes.position,n=i.array;n[0]=t,n[1]=-e,n[2]=0,n[3]=t,n[4]=e,n[5]=0,n[6]=-t,n[7]=e,n[8]=0,n[9]=-t,n[10]=-e,n[11]=0,n[12]=t,n[13]=-e,n[14]=0,i.needsUpdate=!0,void 0!==this.color?this.line.material.color.set(this.color):this.line.material.color.copy(this.light.color)},Qo.prototype=Object.create(bt.prototype),Qo.prototype.constructor=Qo,Qo.prototype.dispose=function(){this.children[0].geometry.dispose(),this.children[0].material.dispose()},Qo.prototype.update=function(){var t=new F,e=new ct,i=new ct;return function(){var n=this.children[0];if(void 0!==this.color)this.material.color.set(this.color);else{var r=n.geometry.getAttribute("color");e.copy(this.light.color),i.copy(this.light.groundColor);for(var a=0,o=r.count;a<o;a++){var s=a<o/2?e:i;r.setXYZ(a,s.r,s.g,s.b)}r.needsUpdate=!0}n.lookAt(t.setFromMatrixPosition(this.light.matrixWorld).negate())}}(),Ko.prototype=Object.create(rn.prototype),Ko.prototype.constructor=Ko,$o.prototype=Object.create(rn.prototype),$o.prototype.constructor=$o,ts.prototype=Object.create(rn.prototype),ts.prototype.constructor=ts,ts.prototype.update=function(){var t=new F,e=new F,i=new G;return function(){this.object.updateMatrixWorld(!0),i.getNormalMatrix(this.object.matrixWorld);for(var n=this.object.matrixWorld,r=this.geometry.attributes.position,a=this.object.geometry,o=a.vertices,s=a.faces,c=0,h=0,l=s.length;h<l;h++){var u=s[h],p=u.normal;t.copy(o[u.a]).add(o[u.b]).add(o[u.c]).divideScalar(3).applyMatrix4(n),e.copy(p).applyMatrix3(i).normalize().multiplyScalar(this.size).add(t),r.setXYZ(c,t.x,t.y,t.z),c+=1,r.setXYZ(c,e.x,e.y,e.z),c+=1}r.needsUpdate=!0}}(),es.prototype=Object.create(bt.prototype),es.prototype.constructor=es,es.prototype.dispose=function(){this.lightPlane.geometry.dispose(),this.lightPlane.material.dispose(),this.targetLine.geometry.dispose(),this.targetLine.material.dispose()},es.prototype.update=function(){var t=new F,e=new F,i=new F;return function(){t.setFromMatrixPosition(this.light.matrixWorld),e.setFromMatrixPosition(this.light.target.matrixWorld),i.subVectors(e,t),this.lightPlane.lookAt(i),void 0!==this.color?(this.lightPlane.material.color.set(this.color),this.targetLine.material.color.set(this.color)):(this.lightPlane.material.color.copy(this.light.color),this.targetLine.material.color.copy(this.light.color)),this.targetLine.lookAt(i),this.targetLine.scale.z=i.length()}}(),is.prototype=Object.create(rn.prototype),is.prototype.constructor=is,is.prototype.update=function(){var t,e,i=new F,n=new wt;function r(r,a,o,s){i.set(a,o,s).unproject(n);var c=e[r];if(void 0!==c)for(var h=t.getAttribute("position"),l=0,u=c.length;l<u;l++)h.setXYZ(c[l],i.x,i.y,i.z)}return function(){t=this.geometry,e=this.pointMap;n.projectionMatrix.copy(this.camera.projectionMatrix),r("c",0,0,-1),r("t",0,0,1),r("n1",-1,-1,-1),r("n2",1,-1,-1),r("n3",-1,1,-1),r("n4",1,1,-1),r("f1",-1,-1,1),r("f2",1,-1,1),r("f3",-1,1,1),r("f4",1,1,1),r("u1",.7,1.1,-1),r("u2",-.7,1.1,-1),r("u3",0,2,-1),r("cf1",-1,0,1),r("cf2",1,0,1),r("cf3",0,-1,1),r("cf4",0,1,1),r("cn1",-1,0,-1),r("cn2",1,0,-1),r("cn3",0,-1,-1),r("cn4",0,1,-1),t.getAttribute("position").needsUpdate=!0}}(),ns.prototype=Object.create(rn.prototype),ns.prototype.constructor=ns,ns.prototype.update=function(){var t=new tt;return function(e){if(void 0!==e&&console.warn("THREE.BoxHelper: .update() has no longer arguments."),void 0!==this.object&&t.setFromObject(this.object),!t.isEmpty()){var i=t.min,n=t.max,r=this.geometry.attributes.position,a=r.array;a[0]=n.x,a[1]=n.y,a[2]=n.z,a[3]=i.x,a[4]=n.y,a[5]=n.z,a[6]=i.x,a[7]=i.y,a[8]=n.z,a[9]=n.x,a[10]=i.y,a[11]=n.z,a[12]=n.x,a[13]=n.y,a[14]=i.z,a[15]=i.x,a[16]=n.y,a[17]=i.z,a[18]=i.x,a[19]=i.y,a[20]=i.z,a[21]=n.x,a[22]=i.y,a[23]=i.z,r.needsUpdate=!0,this.geometry.computeBoundingSphere()}}}(),ns.prototype.setFromObject=function(t){return this.object=t,this.update(),this},rs.prototype=Object.create(rn.prototype),rs.prototype.constructor=rs,rs.prototype.updateMatrixWorld=function(t){var e=this.box;e.isEmpty()||(e.getCenter(this.position),e.getSize(this.scale),this.scale.multiplyScalar(.5),bt.prototype.updateMatrixWorld.call(this,t))},as.prototype=Object.create(nn.prototype),as.prototype.constructor=as,as.prototype.updateMatrixWorld=function(t){var e=-this.plane.constant;Math.abs(e)<1e-8&&(e=1e-8),this.scale.set(.5*this.size,.5*this.size,e),this.lookAt(this.plane.normal),bt.prototype.updateMatrixWorld.call(this,t)},os.prototype=Object.create(bt.prototype),os.prototype.constructor=os,os.prototype.setDirection=(Lo=new F,function(t){t.y>.99999?this.quaternion.set(0,0,0,1):t.y<-.99999?this.quaternion.set(1,0,0,0):(Lo.set(t.z,0,-t.x).normalize(),Ao=Math.acos(t.y),this.quaternion.setFromAxisAngle(Lo,Ao))}),os.prototype.setLength=function(t,e,i){void 0===e&&(e=.2*t),void 0===i&&(i=.2*e),this.line.scale.set(1,Math.max(0,t-e),1),this.line.updateMatrix(),this.cone.scale.set(i,e,i),this.cone.position.y=t,this.cone.updateMatrix()},os.prototype.setColor=function(t){this.line.material.color.copy(t),this.cone.material.color.copy(t)},ss.prototype=Object.create(rn.prototype),ss.prototype.constructor=ss,Gr.create=function(t,e){return console.log("THREE.Curve.create() has been deprecated"),t.prototype=Object.create(Gr.prototype),t.prototype.constructor=t,t.prototype.getPoint=e,t},Object.assign(sa.prototype,{createPointsGeometry:function(t){console.warn("THREE.CurvePath: .createPointsGeometry() has been removed. Use new THREE.Geometry().setFromPoints( points ) instead.");var e=this.getPoints(t);return this.createGeometry(e)},createSpacedPointsGeometry:function(t){console.warn("THREE.CurvePath: .createSpacedPointsGeometry() has been removed. Use new THREE.Geometry().setFromPoints( points ) instead.");var e=this.getSpacedPoints(t);return this.createGeometry(e)},createGeometry:function(t){console.warn("THREE.CurvePath: .createGeometry() has been removed. Use new THREE.Geometry().setFromPoints( points ) instead.");for(var e=new At,i=0,n=t.length;i<n;i++){var r=t[i];e.vertices.push(new F(r.x,r.y,r.z||0))}return e}}),Object.assign(ca.prototype,{fromPoints:function(t){console.warn("THREE.Path: .fromPoints() has been renamed to .setFromPoints()."),this.setFromPoints(t)}}),cs.prototype=Object.create(Zr.prototype),Object.assign(cs.prototype,{initFromArray:function(){console.error("THREE.Spline: .initFromArray() has been removed.")},getControlPointsArray:function(){console.error("THREE.Spline: .getControlPointsArray() has been removed.")},reparametrizeByArcLength:function(){console.error("THREE.Spline: .reparametrizeByArcLength() has been removed.")}}),Ko.prototype.setColors=function(){console.error("THREE.GridHelper: setColors() has been deprecated, pass them in the constructor instead.")},Yo.prototype.update=function(){console.error("THREE.SkeletonHelper: update() no longer needs to be called.")},Object.assign(Ga.prototype,{extractUrlBase:function(t){return console.warn("THREE.Loader: .extractUrlBase() has been deprecated. Use THREE.LoaderUtils.extractUrlBase() instead."),Ha(t)}}),Object.assign(Vo.prototype,{center:function(t){return console.warn("THREE.Box2: .center() has been renamed to .getCenter()."),this.getCenter(t)},empty:function(){return console.warn("THREE.Box2: .empty() has been renamed to .isEmpty()."),this.isEmpty()},isIntersectionBox:function(t){return console.warn("THREE.Box2: .isIntersectionBox() has been renamed to .intersectsBox()."),this.intersectsBox(t)},size:function(t){return console.warn("THREE.Box2: .size() has been renamed to .getSize()."),this.getSize(t)}}),Object.assign(tt.prototype,{center:function(t){return console.warn("THREE.Box3: .center() has been renamed to .getCenter()."),this.getCenter(t)},empty:function(){return console.warn("THREE.Box3: .empty() has been renamed to .isEmpty()."),this.isEmpty()},isIntersectionBox:function(t){return console.warn("THREE.Box3: .isIntersectionBox() has been renamed to .intersectsBox()."),this.intersectsBox(t)},isIntersectionSphere:function(t){return console.warn("THREE.Box3: .isIntersectionSphere() has been renamed to .intersectsSphere()."),this.intersectsSphere(t)},size:function(t){return console.warn("THREE.Box3: .size() has been renamed to .getSize()."),this.getSize(t)}}),re.prototype.center=function(t){return console.warn("THREE.Line3: .center() has been renamed to .getCenter()."),this.getCenter(t)},Object.assign(D,{random16:function(){return console.warn("THREE.Math: .random16() has been deprecated. Use Math.random() instead."),Math.random()},nearestPowerOfTwo:function(t){return console.warn("THREE.Math: .nearestPowerOfTwo() has been renamed to .floorPowerOfTwo()."),D.floorPowerOfTwo(t)},nextPowerOfTwo:function(t){return console.warn("THREE.Math: .nextPowerOfTwo() has been renamed to .ceilPowerOfTwo()."),D.ceilPowerOfTwo(t)}}),Object.assign(G.prototype,{flattenToArrayOffset:function(t,e){return console.warn("THREE.Matrix3: .flattenToArrayOffset() has been deprecated. Use .toArray() instead."),this.toArray(t,e)},multiplyVector3:function(t){return console.warn("THREE.Matrix3: .multiplyVector3() has been removed. Use vector.applyMatrix3( matrix ) instead."),t.applyMatrix3(this)},multiplyVector3Array:function(){console.error("THREE.Matrix3: .multiplyVector3Array() has been removed.")},applyToBuffer:function(t){return console.warn("THREE.Matrix3: .applyToBuffer() has been removed. Use matrix.applyToBufferAttribute( attribute ) instead."),this.applyToBufferAttribute(t)},applyToVector3Array:function(){console.error("THREE.Matrix3: .applyToVector3Array() has been removed.")}}),Object.assign(z.prototype,{extractPosition:function(t){return console.warn("THREE.Matrix4: .extractPosition() has been renamed to .copyPosition()."),this.copyPosition(t)},flattenToArrayOffset:function(t,e){return console.warn("THREE.Matrix4: .flattenToArrayOffset() has been deprecated. Use .toArray() instead."),this.toArray(t,e)},getPosition:function(){var t;return function(){return void 0===t&&(t=new F),console.warn("THREE.Matrix4: .getPosition() has been removed. Use Vector3.setFromMatrixPosition( matrix ) instead."),t.setFromMatrixColumn(this,3)}}(),setRotationFromQuaternion:function(t){return console.warn("THREE.Matrix4: .setRotationFromQuaternion() has been renamed to .makeRotationFromQuaternion()."),this.makeRotationFromQuaternion(t)},multiplyToArray:function(){console.warn("THREE.Matrix4: .multiplyToArray() has been removed.")},multiplyVector3:function(t){return console.warn("THREE.Matrix4: .multiplyVector3() has been removed. Use vector.applyMatrix4( matrix ) instead."),t.applyMatrix4(this)},multiplyVector4:function(t){return console.warn("THREE.Matrix4: .multiplyVector4() has been removed. Use vector.applyMatrix4( matrix ) instead."),t.applyMatrix4(this)},multiplyVector3Array:function(){console.error("THREE.Matrix4: .multiplyVector3Array() has been removed.")},rotateAxis:function(t){console.warn("THREE.Matrix4: .rotateAxis() has been removed. Use Vector3.transformDirection( matrix ) instead."),t.transformDirection(this)},crossVector:function(t){return console.warn("THREE.Matrix4: .crossVector() has been removed. Use vector.applyMatrix4( matrix ) instead."),t.applyMatrix4(this)},translate:function(){console.error("THREE.Matrix4: .translate() has been removed.")},rotateX:function(){console.error("THREE.Matrix4: .rotateX() has been removed.")},rotateY:function(){console.error("THREE.Matrix4: .rotateY() has been removed.")},rotateZ:function(){console.error("THREE.Matrix4: .rotateZ() has been removed.")},rotateByAxis:function(){console.error("THREE.Matrix4: .rotateByAxis() has been removed.")},applyToBuffer:function(t){return console.warn("THREE.Matrix4: .applyToBuffer() has been removed. Use matrix.applyToBufferAttribute( attribute ) instead."),this.applyToBufferAttribute(t)},applyToVector3Array:function(){console.error("THREE.Matrix4: .applyToVector3Array() has been removed.")},makeFrustum:function(t,e,i,n,r,a){return console.warn("THREE.Matrix4: .makeFrustum() has been removed. Use .makePerspective( left, right, top, bottom, near, far ) instead."),this.makePerspective(t,e,n,i,r,a)}}),it.prototype.isIntersectionLine=function(t){return console.warn("THREE.Plane: .isIntersectionLine() has been renamed to .intersectsLine()."),this.intersectsLine(t)},B.prototype.multiplyVector3=function(t){return console.warn("THREE.Quaternion: .multiplyVector3() has been removed. Use is now vector.applyQuaternion( quaternion ) instead."),t.applyQuaternion(this)},Object.assign(ne.prototype,{isIntersectionBox:function(t){return console.warn("THREE.Ray: .isIntersectionBox() has been renamed to .intersectsBox()."),this.intersectsBox(t)},isIntersectionPlane:function(t){return console.warn("THREE.Ray: .isIntersectionPlane() has been renamed to .intersectsPlane()."),this.intersectsPlane(t)},isIntersectionSphere:function(t){return console.warn("THREE.Ray: .isIntersectionSphere() has been renamed to .intersectsSphere()."),this.intersectsSphere(t)}}),Object.assign(ae.prototype,{area:function(){return console.warn("THREE.Triangle: .area() has been renamed to .getArea()."),this.getArea()},barycoordFromPoint:function(t,e){return console.warn("THREE.Triangle: .barycoordFromPoint() has been renamed to .getBarycoord()."),this.getBarycoord(t,e)},midpoint:function(t){return console.warn("THREE.Triangle: .midpoint() has been renamed to .getMidpoint()."),this.getMidpoint(t)},normal:function(t){return console.warn("THREE.Triangle: .normal() has been renamed to .getNormal()."),this.getNormal(t)},plane:function(t){return console.warn("THREE.Triangle: .plane() has been renamed to .getPlane()."),this.getPlane(t)}}),Object.assign(ae,{barycoordFromPoint:function(t,e,i,n,r){return console.warn("THREE.Triangle: .barycoordFromPoint() has been renamed to .getBarycoord()."),ae.getBarycoord(t,e,i,n,r)},normal:function(t,e,i,n){return console.warn("THREE.Triangle: .normal() has been renamed to .getNormal()."),ae.getNormal(t,e,i,n)}}),Object.assign(ha.prototype,{extractAllPoints:function(t){return console.warn("THREE.Shape: .extractAllPoints() has been removed. Use .extractPoints() instead."),this.extractPoints(t)},extrude:function(t){return console.warn("THREE.Shape: .extrude() has been removed. Use ExtrudeGeometry() instead."),new ir(this,t)},makeGeometry:function(t){return console.warn("THREE.Shape: .makeGeometry() has been removed. Use ShapeGeometry() instead."),new dr(this,t)}}),Object.assign(U.prototype,{fromAttribute:function(t,e,i){return console.warn("THREE.Vector2: .fromAttribute() has been renamed to .fromBufferAttribute()."),this.fromBufferAttribute(t,e,i)},distanceToManhattan:function(t){return console.warn("THREE.Vector2: .distanceToManhattan() has been renamed to .manhattanDistanceTo()."),this.manhattanDistanceTo(t)},lengthManhattan:function(){return console.warn("THREE.Vector2: .lengthManhattan() has been renamed to .manhattanLength()."),this.manhattanLength()}}),Object.assign(F.prototype,{setEulerFromRotationMatrix:function(){console.error("THREE.Vector3: .setEulerFromRotationMatrix() has been removed. Use Euler.setFromRotationMatrix() instead.")},setEulerFromQuaternion:function(){console.error("THREE.Vector3: .setEulerFromQuaternion() has been removed. Use Euler.setFromQuaternion() instead.")},getPositionFromMatrix:function(t){return console.warn("THREE.Vector3: .getPositionFromMatrix() has been renamed to .setFromMatrixPosition()."),this.setFromMatrixPosition(t)},getScaleFromMatrix:function(t){return console.warn("THREE.Vector3: .getScaleFromMatrix() has been renamed to .setFromMatrixScale()."),this.setFromMatrixScale(t)},getColumnFromMatrix:function(t,e){return console.warn("THREE.Vector3: .getColumnFromMatrix() has been renamed to .setFromMatrixColumn()."),this.setFromMatrixColumn(e,t)},applyProjection:function(t){return console.warn("THREE.Vector3: .applyProjection() has been removed. Use .applyMatrix4( m ) instead."),this.applyMatrix4(t)},fromAttribute:function(t,e,i){return console.warn("THREE.Vector3: .fromAttribute() has been renamed to .fromBufferAttribute()."),this.fromBufferAttribute(t,e,i)},distanceToManhattan:function(t){return console.warn("THREE.Vector3: .distanceToManhattan() has been renamed to .manhattanDistanceTo()."),this.manhattanDistanceTo(t)},lengthManhattan:function(){return console.warn("THREE.Vector3: .lengthManhattan() has been renamed to .manhattanLength()."),this.manhattanLength()}}),Object.assign(J.prototype,{fromAttribute:function(t,e,i){return console.warn("THREE.Vector4: .fromAttribute() has been renamed to .fromBufferAttribute()."),this.fromBufferAttribute(t,e,i)},lengthManhattan:function(){return console.warn("THREE.Vector4: .lengthManhattan() has been renamed to .manhattanLength()."),this.manhattanLength()}}),Object.assign(At.prototype,{computeTangents:function(){console.error("THREE.Geometry: .computeTangents() has been removed.")},computeLineDistances:function(){console.error("THREE.Geometry: .computeLineDistances() has been removed. Use THREE.Line.computeLineDistances() instead.")}}),Object.assign(bt.prototype,{getChildByName:function(t){return console.warn("THREE.Object3D: .getChildByName() has been renamed to .getObjectByName()."),this.getObjectByName(t)},renderDepth:function(){console.warn("THREE.Object3D: .renderDepth has been removed. Use .renderOrder, instead.")},translate:function(t,e){return console.warn("THREE.Object3D: .translate() has been removed. Use .translateOnAxis( axis, distance ) instead."),this.translateOnAxis(e,t)},getWorldRotation:function(){console.error("THREE.Object3D: .getWorldRotation() has been removed. Use THREE.Object3D.getWorldQuaternion( target ) instead.")}}),Object.defineProperties(bt.prototype,{eulerOrder:{get:function(){return console.warn("THREE.Object3D: .eulerOrder is now .rotation.order."),this.rotation.order},set:function(t){console.warn("THREE.Object3D: .eulerOrder is now .rotation.order."),this.rotation.order=t}},useQuaternion:{get:function(){console.warn("THREE.Object3D: .useQuaternion has been removed. The library now uses quaternions by default.")},set:function(){console.warn("THREE.Object3D: .useQuaternion has been removed. The library now uses quaternions by default.")}}}),Object.defineProperties(Qi.prototype,{objects:{get:function(){return console.warn("THREE.LOD: .objects has been renamed to .levels."),this.levels}}}),Object.defineProperty(Ki.prototype,"useVertexTexture",{get:function(){console.warn("THREE.Skeleton: useVertexTexture has been removed.")},set:function(){console.warn("THREE.Skeleton: useVertexTexture has been removed.")}}),Object.defineProperty(Gr.prototype,"__arcLengthDivisions",{get:function(){return console.warn("THREE.Curve: .__arcLengthDivisions is now .arcLengthDivisions."),this.arcLengthDivisions},set:function(t){console.warn("THREE.Curve: .__arcLengthDivisions is now .arcLengthDivisions."),this.arcLengthDivisions=t}}),Hi.prototype.setLens=function(t,e){console.warn("THREE.PerspectiveCamera.setLens is deprecated. Use .setFocalLength and .filmGauge for a photographic setup."),void 0!==e&&(this.filmGauge=e),this.setFocalLength(t)},Object.defineProperties(la.prototype,{onlyShadow:{set:function(){console.warn("THREE.Light: .onlyShadow has been removed.")}},shadowCameraFov:{set:function(t){console.warn("THREE.Light: .shadowCameraFov is now .shadow.camera.fov."),this.shadow.camera.fov=t}},shadowCameraLeft:{set:function(t){console.warn("THREE.Light: .shadowCameraLeft is now .shadow.camera.left."),this.shadow.camera.left=t}},shadowCameraRight:{set:function(t){console.warn("THREE.Light: .shadowCameraRight is now .shadow.camera.right."),this.shadow.camera.right=t}},shadowCameraTop:{set:function(t){console.warn("THREE.Light: .shadowCameraTop is now .shadow.camera.top."),this.shadow.camera.top=t}},shadowCameraBottom:{set:function(t){console.warn("THREE.Light: .shadowCameraBottom is now .shadow.camera.bottom."),this.shadow.camera.bottom=t}},shadowCameraNear:{set:function(t){console.warn("THREE.Light: .shadowCameraNear is now .shadow.camera.near."),this.shadow.camera.near=t}},shadowCameraFar:{set:function(t){console.warn("THREE.Light: .shadowCameraFar is now .shadow.camera.far."),this.shadow.camera.far=t}},shadowCameraVisible:{set:function(){console.warn("THREE.Light: .shadowCameraVisible has been removed. Use new THREE.CameraHelper( light.shadow.camera ) instead.")}},shadowBias:{set:function(t){console.warn("THREE.Light: .shadowBias is now .shadow.bias."),this.shadow.bias=t}},shadowDarkness:{set:function(){console.warn("THREE.Light: .shadowDarkness has been removed.")}},shadowMapWidth:{set:function(t){console.warn("THREE.Light: .shadowMapWidth is now .shadow.mapSize.width."),this.shadow.mapSize.width=t}},shadowMapHeight:{set:function(t){console.warn("THREE.Light: .shadowMapHeight is now .shadow.mapSize.height."),this.shadow.mapSize.height=t}}}),Object.defineProperties(Lt.prototype,{length:{get:function(){return console.warn("THREE.BufferAttribute: .length has been deprecated. Use .count instead."),this.array.length}},copyIndicesArray:function(){console.error("THREE.BufferAttribute: .copyIndicesArray() has been removed.")}}),Object.assign(Gt.prototype,{addIndex:function(t){console.warn("THREE.BufferGeometry: .addIndex() has been renamed to .setIndex()."),this.setIndex(t)},addDrawCall:function(t,e,i){void 0!==i&&console.warn("THREE.BufferGeometry: .addDrawCall() no longer supports indexOffset."),console.warn("THREE.BufferGeometry: .addDrawCall() is now .addGroup()."),this.addGroup(t,e)},clearDrawCalls:function(){console.warn("THREE.BufferGeometry: .clearDrawCalls() is now .clearGroups()."),this.clearGroups()},computeTangents:function(){console.warn("THREE.BufferGeometry: .computeTangents() has been removed.")},computeOffsets:function(){console.warn("THREE.BufferGeometry: .computeOffsets() has been removed.")}}),Object.defineProperties(Gt.prototype,{drawcalls:{get:function(){return console.error("THREE.BufferGeometry: .drawcalls has been renamed to .groups."),this.groups}},offsets:{get:function(){return console.warn("THREE.BufferGeometry: .offsets has been renamed to .groups."),this.groups}}}),Object.assign(nr.prototype,{getArrays:function(){console.error("THREE.ExtrudeBufferGeometry: .getArrays() has been removed.")},addShapeList:function(){console.error("THREE.ExtrudeBufferGeometry: .addShapeList() has been removed.")},addShape:function(){console.error("THREE.ExtrudeBufferGeometry: .addShape() has been removed.")}}),Object.defineProperties(Io.prototype,{dynamic:{set:function(){console.warn("THREE.Uniform: .dynamic has been removed. Use object.onBeforeRender() instead.")}},onUpdate:{value:function(){return console.warn("THREE.Uniform: .onUpdate() has been removed. Use object.onBeforeRender() instead."),this}}}),Object.defineProperties(te.prototype,{wrapAround:{get:function(){console.warn("THREE.Material: .wrapAround has been removed.")},set:function(){console.warn("THREE.Material: .wrapAround has been removed.")}},wrapRGB:{get:function(){return console.warn("THREE.Material: .wrapRGB has been removed."),new ct}},shading:{get:function(){console.error("THREE."+this.type+": .shading has been removed. Use the boolean .flatShading instead.")},set:function(t){console.warn("THREE."+this.type+": .shading has been removed. Use the boolean .flatShading instead."),this.flatShading=1===t}}}),Object.defineProperties(Lr.prototype,{metal:{get:function(){return console.warn("THREE.MeshPhongMaterial: .metal has been removed. Use THREE.MeshStandardMaterial instead."),!1},set:function(){console.warn("THREE.MeshPhongMaterial: .metal has been removed. Use THREE.MeshStandardMaterial instead")}}}),Object.defineProperties(ie.prototype,{derivatives:{get:function(){return console.warn("THREE.ShaderMaterial: .derivatives has been moved to .extensions.derivatives."),this.extensions.derivatives},set:function(t){console.warn("THREE. ShaderMaterial: .derivatives has been moved to .extensions.derivatives."),this.extensions.derivatives=t}}}),Object.assign(Wi.prototype,{getCurrentRenderTarget:function(){return console.warn("THREE.WebGLRenderer: .getCurrentRenderTarget() is now .getRenderTarget()."),this.getRenderTarget()},getMaxAnisotropy:function(){return console.warn("THREE.WebGLRenderer: .getMaxAnisotropy() is now .capabilities.getMaxAnisotropy()."),this.capabilities.getMaxAnisotropy()},getPrecision:function(){return console.warn("THREE.WebGLRenderer: .getPrecision() is now .capabilities.precision."),this.capabilities.precision},resetGLState:function(){return console.warn("THREE.WebGLRenderer: .resetGLState() is now .state.reset()."),this.state.reset()},supportsFloatTextures:function(){return console.warn("THREE.WebGLRenderer: .supportsFloatTextures() is now .extensions.get( 'OES_texture_float' )."),this.extensions.get("OES_texture_float")},supportsHalfFloatTextures:function(){return console.warn("THREE.WebGLRenderer: .supportsHalfFloatTextures() is now .extensions.get( 'OES_texture_half_float' )."),this.extensions.get("OES_texture_half_float")},supportsStandardDerivatives:function(){return console.warn("THREE.WebGLRenderer: .supportsStandardDerivatives() is now .extensions.get( 'OES_standard_derivatives' )."),this.extensions.get("OES_standard_derivatives")},supportsCompressedTextureS3TC:function(){return console.warn("THREE.WebGLRenderer: .supportsCompressedTextureS3TC() is now .extensions.get( 'WEBGL_compressed_texture_s3tc' )."),this.extensions.get("WEBGL_compressed_texture_s3tc")},supportsCompressedTexturePVRTC:function(){return console.warn("THREE.WebGLRenderer: .supportsCompressedTexturePVRTC() is now .extensions.get( 'WEBGL_compressed_texture_pvrtc' )."),this.extensions.get("WEBGL_compressed_texture_pvrtc")},supportsBlendMinMax:function(){return console.warn("THREE.WebGLRenderer: .supportsBlendMinMax() is now .extensions.get( 'EXT_blend_minmax' )."),this.extensions.get("EXT_blend_minmax")},supportsVertexTextures:function(){return console.warn("THREE.WebGLRenderer: .supportsVertexTextures() is now .capabilities.vertexTextures."),this.capabilities.vertexTextures},supportsInstancedArrays:function(){return console.warn("THREE.WebGLRenderer: .supportsInstancedArrays() is now .extensions.get( 'ANGLE_instanced_arrays' )."),this.extensions.get("ANGLE_instanced_arrays")},enableScissorTest:function(t){console.warn("THREE.WebGLRenderer: .enableScissorTest() is now .setScissorTest()."),this.setScissorTest(t)},initMaterial:function(){console.warn("THREE.WebGLRenderer: .initMaterial() has been removed.")},addPrePlugin:function(){console.warn("THREE.WebGLRenderer: .addPrePlugin() has been removed.")},addPostPlugin:function(){console.warn("THREE.WebGLRenderer: .addPostPlugin() has been removed.")},updateShadowMap:function(){console.warn("THREE.WebGLRenderer: .updateShadowMap() has been removed.")},setFaceCulling:function(){console.warn("THREE.WebGLRenderer: .setFaceCulling() has been removed.")}}),Object.defineProperties(Wi.prototype,{shadowMapEnabled:{get:function(){return this.shadowMap.enabled},set:function(t){console.warn("THREE.WebGLRenderer: .shadowMapEnabled is now .shadowMap.enabled."),this.shadowMap.enabled=t}},shadowMapType:{get:function(){return this.shadowMap.type},set:function(t){console.warn("THREE.WebGLRenderer: .shadowMapType is now .shadowMap.type."),this.shadowMap.type=t}},shadowMapCullFace:{get:function(){console.warn("THREE.WebGLRenderer: .shadowMapCullFace has been removed. Set Material.shadowSide instead.")},set:function(){console.warn("THREE.WebGLRenderer: .shadowMapCullFace has been removed. Set Material.shadowSide instead.")}}}),Object.defineProperties(Di.prototype,{cullFace:{get:function(){console.warn("THREE.WebGLRenderer: .shadowMap.cullFace has been removed. Set Material.shadowSide instead.")},set:function(){console.warn("THREE.WebGLRenderer: .shadowMap.cullFace has been removed. Set Material.shadowSide instead.")}},renderReverseSided:{get:function(){console.warn("THREE.WebGLRenderer: .shadowMap.renderReverseSided has been removed. Set Material.shadowSide instead.")},set:function(){console.warn("THREE.WebGLRenderer: .shadowMap.renderReverseSided has been removed. Set Material.shadowSide instead.")}},renderSingleSided:{get:function(){console.warn("THREE.WebGLRenderer: .shadowMap.renderSingleSided has been removed. Set Material.shadowSide instead.")},set:function(){console.warn("THREE.WebGLRenderer: .shadowMap.renderSingleSided has been removed. Set Material.shadowSide instead.")}}}),Object.defineProperties(Q.prototype,{wrapS:{get:function(){return console.warn("THREE.WebGLRenderTarget: .wrapS is now .texture.wrapS."),this.texture.wrapS},set:function(t){console.warn("THREE.WebGLRenderTarget: .wrapS is now .texture.wrapS."),this.texture.wrapS=t}},wrapT:{get:function(){return console.warn("THREE.WebGLRenderTarget: .wrapT is now .texture.wrapT."),this.texture.wrapT},set:function(t){console.warn("THREE.WebGLRenderTarget: .wrapT is now .texture.wrapT."),this.texture.wrapT=t}},magFilter:{get:function(){return console.warn("THREE.WebGLRenderTarget: .magFilter is now .texture.magFilter."),this.texture.magFilter},set:function(t){console.warn("THREE.WebGLRenderTarget: .magFilter is now .texture.magFilter."),this.texture.magFilter=t}},minFilter:{get:function(){return console.warn("THREE.WebGLRenderTarget: .minFilter is now .texture.minFilter."),this.texture.minFilter},set:function(t){console.warn("THREE.WebGLRenderTarget: .minFilter is now .texture.minFilter."),this.texture.minFilter=t}},anisotropy:{get:function(){return console.warn("THREE.WebGLRenderTarget: .anisotropy is now .texture.anisotropy."),this.texture.anisotropy},set:function(t){console.warn("THREE.WebGLRenderTarget: .anisotropy is now .texture.anisotropy."),this.texture.anisotropy=t}},offset:{get:function(){return console.warn("THREE.WebGLRenderTarget: .offset is now .texture.offset."),this.texture.offset},set:function(t){console.warn("THREE.WebGLRenderTarget: .offset is now .texture.offset."),this.texture.offset=t}},repeat:{get:function(){return console.warn("THREE.WebGLRenderTarget: .repeat is now .texture.repeat."),this.texture.repeat},set:function(t){console.warn("THREE.WebGLRenderTarget: .repeat is now .texture.repeat."),this.texture.repeat=t}},format:{get:function(){return console.warn("THREE.WebGLRenderTarget: .format is now .texture.format."),this.texture.format},set:function(t){console.warn("THREE.WebGLRenderTarget: .format is now .texture.format."),this.texture.format=t}},type:{get:function(){return console.warn("THREE.WebGLRenderTarget: .type is now .texture.type."),this.texture.type},set:function(t){console.warn("THREE.WebGLRenderTarget: .type is now .texture.type."),this.texture.type=t}},generateMipmaps:{get:function(){return console.warn("THREE.WebGLRenderTarget: .generateMipmaps is now .texture.generateMipmaps."),this.texture.generateMipmaps},set:function(t){console.warn("THREE.WebGLRenderTarget: .generateMipmaps is now .texture.generateMipmaps."),this.texture.generateMipmaps=t}}}),Object.defineProperties(ji.prototype,{standing:{set:function(){console.warn("THREE.WebVRManager: .standing has been removed.")}}}),uo.prototype.load=function(t){console.warn("THREE.Audio: .load has been deprecated. Use THREE.AudioLoader instead.");var e=this;return(new co).load(t,(function(t){e.setBuffer(t)})),this},fo.prototype.getData=function(){return console.warn("THREE.AudioAnalyser: .getData() is now .getFrequencyData()."),this.getFrequencyData()},ho.prototype.updateCubeMap=function(t,e){return console.warn("THREE.CubeCamera: .updateCubeMap() is now .update()."),this.update(t,e)};class hs{constructor(t){this.elapsedTime=t||0,this.running=!1}getElapsedTime(){if(this.running){const t=performance.now()/1e3;this.elapsedTime+=t-this.oldTime,this.oldTime=t}return this.elapsedTime}start(){this.oldTime=performance.now()/1e3,this.running=!0}pause(){this.running=!1}}let ls=1;const us=new lo,ps=new co;var ds=null,fs=null,ms=65280,gs=16711935,vs=16711680,ys=65280,xs=255,bs=3145728,ws=1792,_s=window.Math,Ms=function(t){var e=(16711680&t)>>16,i=(65280&t)>>8,n=255&t;return((.299*e+.587*i+.114*n|0)<<16)+((-.169*e-.331*i+.5*n+128|0)<<8)+(.5*e-.419*i-.081*n+128|0)},Es=function(t,e){var i=Ms(t),n=Ms(e);return _s.abs((i&vs)-(n&vs))>bs||_s.abs((i&ys)-(n&ys))>ws||_s.abs((i&xs)-(n&xs))>6},Ts=function(t,e,i){e!==i?(fs[t]=(3*(e&ms)+(i&ms)>>2&ms)+(3*(e&gs)+(i&gs)>>2&gs),fs[t]|=4278190080&e):fs[t]=e},Ss=function(t,e,i,n){fs[t]=(((e&ms)<<1)+(i&ms)+(n&ms)>>2&ms)+(((e&gs)<<1)+(i&gs)+(n&gs)>>2&gs),fs[t]|=4278190080&e},As=function(t,e,i){e!==i?(fs[t]=(7*(e&ms)+(i&ms)>>3&ms)+(7*(e&gs)+(i&gs)>>3&gs),fs[t]|=4278190080&e):fs[t]=e},Ls=function(t,e,i,n){fs[t]=(((e&ms)<<1)+7*(i&ms)+7*(n&ms)>>4&ms)+(((e&gs)<<1)+7*(i&gs)+7*(n&gs)>>4&gs),fs[t]|=4278190080&e},Rs=function(t,e,i){e!==i?(fs[t]=((e&ms)+(i&ms)>>1&ms)+((e&gs)+(i&gs)>>1&gs),fs[t]|=4278190080&e):fs[t]=e},Ps=function(t,e,i,n){fs[t]=(5*(e&ms)+((i&ms)<<1)+(n&ms)>>3&ms)+(5*(e&gs)+((i&gs)<<1)+(n&gs)>>3&gs),fs[t]|=4278190080&e},Cs=function(t,e,i,n){fs[t]=(6*(e&ms)+(i&ms)+(n&ms)>>3&ms)+(6*(e&gs)+(i&gs)+(n&gs)>>3&gs),fs[t]|=4278190080&e},Os=function(t,e,i){e!==i?(fs[t]=(5*(e&ms)+3*(i&ms)>>3&ms)+(5*(e&gs)+3*(i&gs)>>3&gs),fs[t]|=4278190080&e):fs[t]=e},ks=function(t,e,i,n){fs[t]=(((e&ms)<<1)+3*(i&ms)+3*(n&ms)>>3&ms)+(((e&gs)<<1)+3*(i&gs)+3*(n&gs)>>3&gs),fs[t]|=4278190080&e},Is=function(t,e){var i=e.charAt(0).toUpperCase()+e.substr(1);return t[e]||t["ms"+i]||t["moz"+i]||t["webkit"+i]||t["o"+i]};function Ns(t,e){if(-1===[2,3,4].indexOf(e))return t;var i,n,r,a;t instanceof HTMLCanvasElement?(n=(i=t).getContext("2d"),r=i,a=n.getImageData(0,0,i.width,i.height).data):(a=function(t,e,i,n,r){var a=document.createElement("canvas"),o=a.getContext("2d"),s=Is(o,"backingStorePixelRatio")||1;o.getImageDataHD=Is(o,"getImageDataHD");var c=t.width/s,h=t.height/s;return a.width=Math.ceil(c),a.height=Math.ceil(h),o.drawImage(t,0,0,c,h),1===s?o.getImageData(e,i,n,r):o.getImageDataHD(e,i,n,r)}(t,0,0,t.width,t.height).data,r=document.createElement("canvas"));for(var o,s=t.width*t.height,c=ds=new Array(s),h=fs=new Array(s*e*e),l=0;l<s;l++)c[l]=(a[3+(o=l<<2)]<<24)+(a[o+2]<<16)+(a[o+1]<<8)+a[o];2===e?Ds(t.width,t.height):3===e?Us(t.width,t.height):4===e&&zs(t.width,t.height),r.width=t.width*e,r.height=t.height*e;for(var u,p,d=r.getContext("2d"),f=d.getImageData(0,0,r.width,r.height),m=f.data,g=h.length,v=0;v<g;v++)p=(4278190080&(u=h[v]))>>24,m[3+(o=v<<2)]=p<0?p+256:0,m[o+2]=(16711680&u)>>16,m[o+1]=(65280&u)>>8,m[o]=255&u;return ds=c=null,fs=h=null,d.putImageData(f,0,0),r}var Ds=function(t,e){var i,n,r,a,o,s,c,h=[],l=t<<1,u=0,p=0,d=Es,f=_s,m=Ms,g=Ts,v=Ss,y=Ps,x=Cs,b=ks,w=ds,_=fs,M=vs;for(n=0;n<e;n++){for(a=n>0?-t:0,o=n<e-1?t:0,i=0;i<t;i++){h[2]=w[p+a],h[5]=w[p],h[8]=w[p+o],i>0?(h[1]=w[p+a-1],h[4]=w[p-1],h[7]=w[p+o-1]):(h[1]=h[2],h[4]=h[5],h[7]=h[8]),i<t-1?(h[3]=w[p+a+1],h[6]=w[p+1],h[9]=w[p+o+1]):(h[3]=h[2],h[6]=h[5],h[9]=h[8]);var E=0,T=1;for(s=m(h[5]),r=1;r<10;r++)5!==r&&(h[r]!==h[5]&&(c=m(h[r]),(f.abs((s&M)-(c&M))>3145728||f.abs((65280&s)-(65280&c))>1792||f.abs((255&s)-(255&c))>6)&&(E|=T)),T<<=1);switch(E){case 0:case 1:case 4:case 32:case 128:case 5:case 132:case 160:case 33:case 129:case 36:case 133:case 164:case 161:case 37:case 165:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 2:case 34:case 130:case 162:v(u,h[5],h[1],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 16:case 17:case 48:case 49:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 64:case 65:case 68:case 69:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 8:case 12:case 136:case 140:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 3:case 35:case 131:case 163:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 6:case 38:case 134:case 166:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 20:case 21:case 52:case 53:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 144:case 145:case 176:case 177:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[8]);break;case 192:case 193:case 196:case 197:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[6]);break;case 96:case 97:case 100:case 101:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 40:case 44:case 168:case 172:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 9:case 13:case 137:case 141:g(u,h[5],h[2]),v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 18:case 50:v(u,h[5],h[1],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 80:case 81:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):v(u+l+1,h[5],h[6],h[8]);break;case 72:case 76:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 10:case 138:d(h[4],h[2])?g(u,h[5],h[4]):v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 66:v(u,h[5],h[1],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 24:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 7:case 39:case 135:g(u,h[5],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 148:case 149:case 180:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[8]);break;case 224:case 228:case 225:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 41:case 169:case 45:g(u,h[5],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 22:case 54:v(u,h[5],h[1],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 208:case 209:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 104:case 108:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 11:case 139:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 19:case 51:d(h[2],h[6])?(g(u,h[5],h[4]),g(u+1,h[5],h[3])):(y(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[6])),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 146:case 178:v(u,h[5],h[1],h[4]),d(h[2],h[6])?(g(u+1,h[5],h[3]),g(u+l+1,h[5],h[8])):(b(u+1,h[5],h[2],h[6]),y(u+l+1,h[5],h[6],h[8])),v(u+l,h[5],h[8],h[4]);break;case 84:case 85:v(u,h[5],h[4],h[2]),d(h[6],h[8])?(g(u+1,h[5],h[2]),g(u+l+1,h[5],h[9])):(y(u+1,h[5],h[6],h[2]),b(u+l+1,h[5],h[6],h[8])),v(u+l,h[5],h[7],h[4]);break;case 112:case 113:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),d(h[6],h[8])?(g(u+l,h[5],h[4]),g(u+l+1,h[5],h[9])):(y(u+l,h[5],h[8],h[4]),b(u+l+1,h[5],h[6],h[8]));break;case 200:case 204:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?(g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6])):(b(u+l,h[5],h[8],h[4]),y(u+l+1,h[5],h[8],h[6]));break;case 73:case 77:d(h[8],h[4])?(g(u,h[5],h[2]),g(u+l,h[5],h[7])):(y(u,h[5],h[4],h[2]),b(u+l,h[5],h[8],h[4])),v(u+1,h[5],h[2],h[6]),v(u+l+1,h[5],h[9],h[6]);break;case 42:case 170:d(h[4],h[2])?(g(u,h[5],h[4]),g(u+l,h[5],h[8])):(b(u,h[5],h[4],h[2]),y(u+l,h[5],h[4],h[8])),v(u+1,h[5],h[3],h[6]),v(u+l+1,h[5],h[6],h[8]);break;case 14:case 142:d(h[4],h[2])?(g(u,h[5],h[4]),g(u+1,h[5],h[6])):(b(u,h[5],h[4],h[2]),y(u+1,h[5],h[2],h[6])),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 67:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 70:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[7],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 28:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 152:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 194:v(u,h[5],h[1],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[6]);break;case 98:v(u,h[5],h[1],h[4]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 56:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 25:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 26:case 31:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 82:case 214:v(u,h[5],h[1],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 88:case 248:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 74:case 107:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 27:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 86:v(u,h[5],h[1],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[9]);break;case 216:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 106:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 30:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 210:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 120:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[9]);break;case 75:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[7]),v(u+l+1,h[5],h[9],h[6]);break;case 29:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 198:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[6]);break;case 184:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 99:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 57:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 71:g(u,h[5],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[7],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 156:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 226:v(u,h[5],h[1],h[4]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 60:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 195:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[6]);break;case 102:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[6]),g(u+l,h[5],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 153:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 58:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 83:g(u,h[5],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 92:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 202:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[6]);break;case 78:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),g(u+1,h[5],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 154:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 114:v(u,h[5],h[1],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 89:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 90:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 55:case 23:d(h[2],h[6])?(g(u,h[5],h[4]),_[u+1]=h[5]):(y(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[6])),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[8]);break;case 182:case 150:v(u,h[5],h[1],h[4]),d(h[2],h[6])?(_[u+1]=h[5],g(u+l+1,h[5],h[8])):(b(u+1,h[5],h[2],h[6]),y(u+l+1,h[5],h[6],h[8])),v(u+l,h[5],h[8],h[4]);break;case 213:case 212:v(u,h[5],h[4],h[2]),d(h[6],h[8])?(g(u+1,h[5],h[2]),_[u+l+1]=h[5]):(y(u+1,h[5],h[6],h[2]),b(u+l+1,h[5],h[6],h[8])),v(u+l,h[5],h[7],h[4]);break;case 241:case 240:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[2]),d(h[6],h[8])?(g(u+l,h[5],h[4]),_[u+l+1]=h[5]):(y(u+l,h[5],h[8],h[4]),b(u+l+1,h[5],h[6],h[8]));break;case 236:case 232:v(u,h[5],h[1],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?(_[u+l]=h[5],g(u+l+1,h[5],h[6])):(b(u+l,h[5],h[8],h[4]),y(u+l+1,h[5],h[8],h[6]));break;case 109:case 105:d(h[8],h[4])?(g(u,h[5],h[2]),_[u+l]=h[5]):(y(u,h[5],h[4],h[2]),b(u+l,h[5],h[8],h[4])),v(u+1,h[5],h[2],h[6]),v(u+l+1,h[5],h[9],h[6]);break;case 171:case 43:d(h[4],h[2])?(_[u]=h[5],g(u+l,h[5],h[8])):(b(u,h[5],h[4],h[2]),y(u+l,h[5],h[4],h[8])),v(u+1,h[5],h[3],h[6]),v(u+l+1,h[5],h[6],h[8]);break;case 143:case 15:d(h[4],h[2])?(_[u]=h[5],g(u+1,h[5],h[6])):(b(u,h[5],h[4],h[2]),y(u+1,h[5],h[2],h[6])),v(u+l,h[5],h[7],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 124:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[9]);break;case 203:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6]);break;case 62:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 211:g(u,h[5],h[4]),g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 118:v(u,h[5],h[1],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[9]);break;case 217:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 110:g(u,h[5],h[4]),g(u+1,h[5],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 155:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 188:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 185:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 61:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 157:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 103:g(u,h[5],h[4]),g(u+1,h[5],h[6]),g(u+l,h[5],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 227:g(u,h[5],h[4]),v(u+1,h[5],h[3],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 230:v(u,h[5],h[1],h[4]),g(u+1,h[5],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 199:g(u,h[5],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[7],h[4]),g(u+l+1,h[5],h[6]);break;case 220:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 158:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 234:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[6]);break;case 242:v(u,h[5],h[1],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 59:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 121:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 87:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 79:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 122:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 94:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 218:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 91:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 229:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 167:g(u,h[5],h[4]),g(u+1,h[5],h[6]),v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[6],h[8]);break;case 173:g(u,h[5],h[2]),v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 181:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[8]);break;case 186:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 115:g(u,h[5],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 93:g(u,h[5],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 206:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),g(u+1,h[5],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[6]);break;case 205:case 201:g(u,h[5],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?g(u+l,h[5],h[7]):x(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[6]);break;case 174:case 46:d(h[4],h[2])?g(u,h[5],h[4]):x(u,h[5],h[4],h[2]),g(u+1,h[5],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 179:case 147:g(u,h[5],h[4]),d(h[2],h[6])?g(u+1,h[5],h[3]):x(u+1,h[5],h[2],h[6]),v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[8]);break;case 117:case 116:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),d(h[6],h[8])?g(u+l+1,h[5],h[9]):x(u+l+1,h[5],h[6],h[8]);break;case 189:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 231:g(u,h[5],h[4]),g(u+1,h[5],h[6]),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[6]);break;case 126:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[9]);break;case 219:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[3]),g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 125:d(h[8],h[4])?(g(u,h[5],h[2]),_[u+l]=h[5]):(y(u,h[5],h[4],h[2]),b(u+l,h[5],h[8],h[4])),g(u+1,h[5],h[2]),g(u+l+1,h[5],h[9]);break;case 221:g(u,h[5],h[2]),d(h[6],h[8])?(g(u+1,h[5],h[2]),_[u+l+1]=h[5]):(y(u+1,h[5],h[6],h[2]),b(u+l+1,h[5],h[6],h[8])),g(u+l,h[5],h[7]);break;case 207:d(h[4],h[2])?(_[u]=h[5],g(u+1,h[5],h[6])):(b(u,h[5],h[4],h[2]),y(u+1,h[5],h[2],h[6])),g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6]);break;case 238:g(u,h[5],h[4]),g(u+1,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],g(u+l+1,h[5],h[6])):(b(u+l,h[5],h[8],h[4]),y(u+l+1,h[5],h[8],h[6]));break;case 190:g(u,h[5],h[4]),d(h[2],h[6])?(_[u+1]=h[5],g(u+l+1,h[5],h[8])):(b(u+1,h[5],h[2],h[6]),y(u+l+1,h[5],h[6],h[8])),g(u+l,h[5],h[8]);break;case 187:d(h[4],h[2])?(_[u]=h[5],g(u+l,h[5],h[8])):(b(u,h[5],h[4],h[2]),y(u+l,h[5],h[4],h[8])),g(u+1,h[5],h[3]),g(u+l+1,h[5],h[8]);break;case 243:g(u,h[5],h[4]),g(u+1,h[5],h[3]),d(h[6],h[8])?(g(u+l,h[5],h[4]),_[u+l+1]=h[5]):(y(u+l,h[5],h[8],h[4]),b(u+l+1,h[5],h[6],h[8]));break;case 119:d(h[2],h[6])?(g(u,h[5],h[4]),_[u+1]=h[5]):(y(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[6])),g(u+l,h[5],h[4]),g(u+l+1,h[5],h[9]);break;case 237:case 233:g(u,h[5],h[2]),v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6]);break;case 175:case 47:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),g(u+1,h[5],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[6],h[8]);break;case 183:case 151:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[8]);break;case 245:case 244:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 250:g(u,h[5],h[4]),g(u+1,h[5],h[3]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 123:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[3]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[9]);break;case 95:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[7]),g(u+l+1,h[5],h[9]);break;case 222:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 252:v(u,h[5],h[1],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 249:g(u,h[5],h[2]),v(u+1,h[5],h[3],h[2]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 235:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),v(u+1,h[5],h[3],h[6]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6]);break;case 111:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),g(u+1,h[5],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),v(u+l+1,h[5],h[9],h[6]);break;case 63:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[8]),v(u+l+1,h[5],h[9],h[8]);break;case 159:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[8]),g(u+l+1,h[5],h[8]);break;case 215:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),v(u+l,h[5],h[7],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 246:v(u,h[5],h[1],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),g(u+l,h[5],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 254:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 253:g(u,h[5],h[2]),g(u+1,h[5],h[2]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 251:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[3]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 239:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),g(u+1,h[5],h[6]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),g(u+l+1,h[5],h[6]);break;case 127:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:v(u+1,h[5],h[2],h[6]),d(h[8],h[4])?_[u+l]=h[5]:v(u+l,h[5],h[8],h[4]),g(u+l+1,h[5],h[9]);break;case 191:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),g(u+l,h[5],h[8]),g(u+l+1,h[5],h[8]);break;case 223:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:v(u+l+1,h[5],h[6],h[8]);break;case 247:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),g(u+l,h[5],h[4]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9]);break;case 255:d(h[4],h[2])?_[u]=h[5]:g(u,h[5],h[4]),d(h[2],h[6])?_[u+1]=h[5]:g(u+1,h[5],h[3]),d(h[8],h[4])?_[u+l]=h[5]:g(u+l,h[5],h[7]),d(h[6],h[8])?_[u+l+1]=h[5]:g(u+l+1,h[5],h[9])}p++,u+=2}u+=l}},Us=function(t,e){var i,n,r,a,o,s,c,h=[],l=3*t,u=0,p=0,d=Es,f=_s,m=Ms,g=Ts,v=Ss,y=As,x=Ls,b=Rs,w=ds,_=fs,M=vs;for(n=0;n<e;n++){for(a=n>0?-t:0,o=n<e-1?t:0,i=0;i<t;i++){h[2]=w[p+a],h[5]=w[p],h[8]=w[p+o],i>0?(h[1]=w[p+a-1],h[4]=w[p-1],h[7]=w[p+o-1]):(h[1]=h[2],h[4]=h[5],h[7]=h[8]),i<t-1?(h[3]=w[p+a+1],h[6]=w[p+1],h[9]=w[p+o+1]):(h[3]=h[2],h[6]=h[5],h[9]=h[8]);var E=0,T=1;for(s=m(h[5]),r=1;r<10;r++)5!==r&&(h[r]!==h[5]&&(c=m(h[r]),(f.abs((s&M)-(c&M))>3145728||f.abs((65280&s)-(65280&c))>1792||f.abs((255&s)-(255&c))>6)&&(E|=T)),T<<=1);switch(E){case 0:case 1:case 4:case 32:case 128:case 5:case 132:case 160:case 33:case 129:case 36:case 133:case 164:case 161:case 37:case 165:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 2:case 34:case 130:case 162:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 16:case 17:case 48:case 49:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 64:case 65:case 68:case 69:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 8:case 12:case 136:case 140:g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 3:case 35:case 131:case 163:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 6:case 38:case 134:case 166:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 20:case 21:case 52:case 53:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 144:case 145:case 176:case 177:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 192:case 193:case 196:case 197:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 96:case 97:case 100:case 101:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 40:case 44:case 168:case 172:g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 9:case 13:case 137:case 141:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 18:case 50:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 80:case 81:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9])):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 72:case 76:g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 10:case 138:d(h[4],h[2])?(g(u,h[5],h[1]),_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 66:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 24:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 7:case 39:case 135:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 148:case 149:case 180:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 224:case 228:case 225:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 41:case 169:case 45:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 22:case 54:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 208:case 209:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 104:case 108:g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 11:case 139:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 19:case 51:d(h[2],h[6])?(g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+2]=h[5]):(v(u,h[5],h[4],h[2]),g(u+1,h[2],h[5]),b(u+2,h[2],h[6]),g(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 146:case 178:d(h[2],h[6])?(_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+2]=h[5],g(u+(l<<1)+2,h[5],h[8])):(g(u+1,h[5],h[2]),b(u+2,h[2],h[6]),g(u+l+2,h[6],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]);break;case 84:case 85:d(h[6],h[8])?(g(u+2,h[5],h[2]),_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9])):(v(u+2,h[5],h[2],h[6]),g(u+l+2,h[6],h[5]),g(u+(l<<1)+1,h[5],h[8]),b(u+(l<<1)+2,h[6],h[8])),v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]);break;case 112:case 113:d(h[6],h[8])?(_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9])):(g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),b(u+(l<<1)+2,h[6],h[8])),v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5];break;case 200:case 204:d(h[8],h[4])?(_[u+l]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6])):(g(u+l,h[5],h[4]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]);break;case 73:case 77:d(h[8],h[4])?(g(u,h[5],h[2]),_[u+l]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5]):(v(u,h[5],h[4],h[2]),g(u+l,h[4],h[5]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[5],h[8])),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1)+2,h[5],h[9]);break;case 42:case 170:d(h[4],h[2])?(g(u,h[5],h[1]),_[u+1]=h[5],_[u+l]=h[5],g(u+(l<<1),h[5],h[8])):(b(u,h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[4],h[5]),v(u+(l<<1),h[5],h[8],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 14:case 142:d(h[4],h[2])?(g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5]):(b(u,h[4],h[2]),g(u+1,h[2],h[5]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4])),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 67:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 70:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 28:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 152:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 194:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 98:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 56:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 25:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 26:case 31:d(h[4],h[2])?(_[u]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+l,h[5],h[4])),_[u+1]=h[5],d(h[2],h[6])?(_[u+2]=h[5],_[u+l+2]=h[5]):(x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 82:case 214:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 88:case 248:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4])),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 74:case 107:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2])),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 27:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 86:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 216:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 106:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 30:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 210:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 120:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 75:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 29:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 198:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 184:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 99:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 57:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 71:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 156:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 226:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 60:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 195:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 102:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 153:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 58:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 83:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 92:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 202:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 78:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 154:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 114:g(u,h[5],h[1]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 89:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 90:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 55:case 23:d(h[2],h[6])?(g(u,h[5],h[4]),_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(v(u,h[5],h[4],h[2]),g(u+1,h[2],h[5]),b(u+2,h[2],h[6]),g(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 182:case 150:d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5],g(u+(l<<1)+2,h[5],h[8])):(g(u+1,h[5],h[2]),b(u+2,h[2],h[6]),g(u+l+2,h[6],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]);break;case 213:case 212:d(h[6],h[8])?(g(u+2,h[5],h[2]),_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(v(u+2,h[5],h[2],h[6]),g(u+l+2,h[6],h[5]),g(u+(l<<1)+1,h[5],h[8]),b(u+(l<<1)+2,h[6],h[8])),v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]);break;case 241:case 240:d(h[6],h[8])?(_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),b(u+(l<<1)+2,h[6],h[8])),v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5];break;case 236:case 232:d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6])):(g(u+l,h[5],h[4]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]);break;case 109:case 105:d(h[8],h[4])?(g(u,h[5],h[2]),_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(v(u,h[5],h[4],h[2]),g(u+l,h[4],h[5]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[5],h[8])),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1)+2,h[5],h[9]);break;case 171:case 43:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5],g(u+(l<<1),h[5],h[8])):(b(u,h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[4],h[5]),v(u+(l<<1),h[5],h[8],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 143:case 15:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5]):(b(u,h[4],h[2]),g(u+1,h[2],h[5]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4])),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 124:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 203:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 62:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 211:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 118:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 217:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 110:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 155:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 188:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 185:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 61:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 157:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 103:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 227:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 230:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 199:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 220:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 158:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 234:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[6]);break;case 242:g(u,h[5],h[1]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[4]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 59:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 121:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 87:g(u,h[5],h[4]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 79:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 122:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 94:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 218:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 91:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 229:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 167:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 173:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 181:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 186:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 115:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 93:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 206:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 205:case 201:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?g(u+(l<<1),h[5],h[7]):v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 174:case 46:d(h[4],h[2])?g(u,h[5],h[1]):v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 179:case 147:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?g(u+2,h[5],h[3]):v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 117:case 116:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?g(u+(l<<1)+2,h[5],h[9]):v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 189:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 231:g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 126:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 219:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 125:d(h[8],h[4])?(g(u,h[5],h[2]),_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(v(u,h[5],h[4],h[2]),g(u+l,h[4],h[5]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[5],h[8])),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 221:d(h[6],h[8])?(g(u+2,h[5],h[2]),_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(v(u+2,h[5],h[2],h[6]),g(u+l+2,h[6],h[5]),g(u+(l<<1)+1,h[5],h[8]),b(u+(l<<1)+2,h[6],h[8])),g(u,h[5],h[2]),g(u+1,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]);break;case 207:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5]):(b(u,h[4],h[2]),g(u+1,h[2],h[5]),v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4])),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 238:d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6])):(g(u+l,h[5],h[4]),b(u+(l<<1),h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l+1]=h[5],g(u+l+2,h[5],h[6]);break;case 190:d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5],g(u+(l<<1)+2,h[5],h[8])):(g(u+1,h[5],h[2]),b(u+2,h[2],h[6]),g(u+l+2,h[6],h[5]),v(u+(l<<1)+2,h[5],h[6],h[8])),g(u,h[5],h[1]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]);break;case 187:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5],g(u+(l<<1),h[5],h[8])):(b(u,h[4],h[2]),g(u+1,h[5],h[2]),g(u+l,h[4],h[5]),v(u+(l<<1),h[5],h[8],h[4])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 243:d(h[6],h[8])?(_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(g(u+l+2,h[5],h[6]),v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[8],h[5]),b(u+(l<<1)+2,h[6],h[8])),g(u,h[5],h[4]),_[u+1]=h[5],g(u+2,h[5],h[3]),g(u+l,h[5],h[4]),_[u+l+1]=h[5];break;case 119:d(h[2],h[6])?(g(u,h[5],h[4]),_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(v(u,h[5],h[4],h[2]),g(u+1,h[2],h[5]),b(u+2,h[2],h[6]),g(u+l+2,h[5],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 237:case 233:g(u,h[5],h[2]),g(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 175:case 47:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 183:case 151:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],v(u+(l<<1),h[5],h[8],h[4]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 245:case 244:v(u,h[5],h[4],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 250:g(u,h[5],h[1]),_[u+1]=h[5],g(u+2,h[5],h[3]),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4])),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 123:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2])),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 95:d(h[4],h[2])?(_[u]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+l,h[5],h[4])),_[u+1]=h[5],d(h[2],h[6])?(_[u+2]=h[5],_[u+l+2]=h[5]):(x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[9]);break;case 222:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 252:g(u,h[5],h[1]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4])),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 249:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 235:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2])),g(u+2,h[5],h[3]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 111:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?(_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 63:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?(_[u+2]=h[5],_[u+l+2]=h[5]):(x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l]=h[5],_[u+l+1]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[9]);break;case 159:d(h[4],h[2])?(_[u]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+l,h[5],h[4])),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 215:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 246:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6])),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 254:g(u,h[5],h[1]),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5]):(y(u+1,h[5],h[2]),x(u+2,h[5],h[2],h[6])),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5]):(y(u+l,h[5],h[4]),x(u+(l<<1),h[5],h[8],h[4])),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),y(u+(l<<1)+1,h[5],h[8]),v(u+(l<<1)+2,h[5],h[6],h[8]));break;case 253:g(u,h[5],h[2]),g(u+1,h[5],h[2]),g(u+2,h[5],h[2]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 251:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5]):(x(u,h[5],h[4],h[2]),y(u+1,h[5],h[2])),g(u+2,h[5],h[3]),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+l]=h[5],_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(y(u+l,h[5],h[4]),v(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),d(h[6],h[8])?(_[u+l+2]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+l+2,h[5],h[6]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 239:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],g(u+2,h[5],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],g(u+l+2,h[5],h[6]),d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],g(u+(l<<1)+2,h[5],h[6]);break;case 127:d(h[4],h[2])?(_[u]=h[5],_[u+1]=h[5],_[u+l]=h[5]):(v(u,h[5],h[4],h[2]),y(u+1,h[5],h[2]),y(u+l,h[5],h[4])),d(h[2],h[6])?(_[u+2]=h[5],_[u+l+2]=h[5]):(x(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l+1]=h[5],d(h[8],h[4])?(_[u+(l<<1)]=h[5],_[u+(l<<1)+1]=h[5]):(x(u+(l<<1),h[5],h[8],h[4]),y(u+(l<<1)+1,h[5],h[8])),g(u+(l<<1)+2,h[5],h[9]);break;case 191:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[8]),g(u+(l<<1)+1,h[5],h[8]),g(u+(l<<1)+2,h[5],h[8]);break;case 223:d(h[4],h[2])?(_[u]=h[5],_[u+l]=h[5]):(x(u,h[5],h[4],h[2]),y(u+l,h[5],h[4])),d(h[2],h[6])?(_[u+1]=h[5],_[u+2]=h[5],_[u+l+2]=h[5]):(y(u+1,h[5],h[2]),v(u+2,h[5],h[2],h[6]),y(u+l+2,h[5],h[6])),_[u+l+1]=h[5],g(u+(l<<1),h[5],h[7]),d(h[6],h[8])?(_[u+(l<<1)+1]=h[5],_[u+(l<<1)+2]=h[5]):(y(u+(l<<1)+1,h[5],h[8]),x(u+(l<<1)+2,h[5],h[6],h[8]));break;case 247:g(u,h[5],h[4]),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),g(u+l,h[5],h[4]),_[u+l+1]=h[5],_[u+l+2]=h[5],g(u+(l<<1),h[5],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8]);break;case 255:d(h[4],h[2])?_[u]=h[5]:v(u,h[5],h[4],h[2]),_[u+1]=h[5],d(h[2],h[6])?_[u+2]=h[5]:v(u+2,h[5],h[2],h[6]),_[u+l]=h[5],_[u+l+1]=h[5],_[u+l+2]=h[5],d(h[8],h[4])?_[u+(l<<1)]=h[5]:v(u+(l<<1),h[5],h[8],h[4]),_[u+(l<<1)+1]=h[5],d(h[6],h[8])?_[u+(l<<1)+2]=h[5]:v(u+(l<<1)+2,h[5],h[6],h[8])}p++,u+=3}u+=l<<1}},zs=function(t,e){var i,n,r,a,o,s,c,h=[],l=t<<2,u=0,p=0,d=Es,f=_s,m=Ms,g=Ts,v=Ss,y=As,x=Rs,b=Ps,w=Cs,_=Os,M=ds,E=fs,T=vs;for(n=0;n<e;n++){for(a=n>0?-t:0,o=n<e-1?t:0,i=0;i<t;i++){h[2]=M[p+a],h[5]=M[p],h[8]=M[p+o],i>0?(h[1]=M[p+a-1],h[4]=M[p-1],h[7]=M[p+o-1]):(h[1]=h[2],h[4]=h[5],h[7]=h[8]),i<t-1?(h[3]=M[p+a+1],h[6]=M[p+1],h[9]=M[p+o+1]):(h[3]=h[2],h[6]=h[5],h[9]=h[8]);var S=0,A=1;for(s=m(h[5]),r=1;r<10;r++)5!==r&&(h[r]!==h[5]&&(c=m(h[r]),(f.abs((s&T)-(c&T))>3145728||f.abs((65280&s)-(65280&c))>1792||f.abs((255&s)-(255&c))>6)&&(S|=A)),A<<=1);switch(S){case 0:case 1:case 4:case 32:case 128:case 5:case 132:case 160:case 33:case 129:case 36:case 133:case 164:case 161:case 37:case 165:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 2:case 34:case 130:case 162:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 16:case 17:case 48:case 49:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 64:case 65:case 68:case 69:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 8:case 12:case 136:case 140:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 3:case 35:case 131:case 163:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 6:case 38:case 134:case 166:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 20:case 21:case 52:case 53:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 144:case 145:case 176:case 177:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 192:case 193:case 196:case 197:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 96:case 97:case 100:case 101:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 40:case 44:case 168:case 172:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 9:case 13:case 137:case 141:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 18:case 50:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),E[u+l+2]=h[5],x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 80:case 81:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 72:case 76:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(x(u+(l<<1),h[4],h[5]),E[u+(l<<1)+1]=h[5],x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 10:case 138:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5]),E[u+l+1]=h[5]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 66:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 24:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 7:case 39:case 135:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 148:case 149:case 180:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 224:case 228:case 225:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 41:case 169:case 45:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 22:case 54:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 208:case 209:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 104:case 108:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 11:case 139:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 19:case 51:d(h[2],h[6])?(_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u,h[5],h[2]),g(u+1,h[2],h[5]),_(u+2,h[2],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),v(u+l+3,h[6],h[5],h[2])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 146:case 178:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l+3,h[5],h[8])):(v(u+2,h[2],h[5],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),_(u+l+3,h[6],h[2]),g(u+(l<<1)+3,h[6],h[5]),g(u+3*l+3,h[5],h[6])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]);break;case 84:case 85:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),d(h[6],h[8])?(_(u+3,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(g(u+3,h[5],h[6]),g(u+l+3,h[6],h[5]),w(u+(l<<1)+2,h[5],h[6],h[8]),_(u+(l<<1)+3,h[6],h[8]),v(u+3*l+2,h[8],h[5],h[6]),x(u+3*l+3,h[8],h[6])),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 112:case 113:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(w(u+(l<<1)+2,h[5],h[6],h[8]),v(u+(l<<1)+3,h[6],h[5],h[8]),g(u+3*l,h[5],h[8]),g(u+3*l+1,h[8],h[5]),_(u+3*l+2,h[8],h[6]),x(u+3*l+3,h[8],h[6]));break;case 200:case 204:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6])):(v(u+(l<<1),h[4],h[5],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),_(u+3*l+1,h[8],h[4]),g(u+3*l+2,h[8],h[5]),g(u+3*l+3,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]);break;case 73:case 77:d(h[8],h[4])?(_(u,h[5],h[2]),y(u+l,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u,h[5],h[4]),g(u+l,h[4],h[5]),_(u+(l<<1),h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),v(u+3*l+1,h[8],h[5],h[4])),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 42:case 170:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+(l<<1),h[5],h[8]),_(u+3*l,h[5],h[8])):(x(u,h[2],h[4]),v(u+1,h[2],h[5],h[4]),_(u+l,h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),g(u+(l<<1),h[4],h[5]),g(u+3*l,h[5],h[4])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 14:case 142:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(x(u,h[2],h[4]),_(u+1,h[2],h[4]),g(u+2,h[2],h[5]),g(u+3,h[5],h[2]),v(u+l,h[4],h[5],h[2]),w(u+l+1,h[5],h[4],h[2])),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 67:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 70:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 28:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 152:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 194:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 98:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 56:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 25:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 26:case 31:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l+1]=h[5],E[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 82:case 214:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 88:case 248:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6]));break;case 74:case 107:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 27:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 86:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 216:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 106:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 30:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 210:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 120:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 75:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 29:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 198:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 184:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 99:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 57:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 71:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 156:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 226:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 60:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 195:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 102:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 153:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 58:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 83:_(u,h[5],h[4]),y(u+1,h[5],h[4]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 92:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 202:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 78:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 154:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 114:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6])),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]);break;case 89:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 90:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 55:case 23:d(h[2],h[6])?(_(u,h[5],h[4]),y(u+1,h[5],h[4]),E[u+2]=h[5],E[u+3]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5]):(g(u,h[5],h[2]),g(u+1,h[2],h[5]),_(u+2,h[2],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),v(u+l+3,h[6],h[5],h[2])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 182:case 150:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],y(u+(l<<1)+3,h[5],h[8]),_(u+3*l+3,h[5],h[8])):(v(u+2,h[2],h[5],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),_(u+l+3,h[6],h[2]),g(u+(l<<1)+3,h[6],h[5]),g(u+3*l+3,h[5],h[6])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]);break;case 213:case 212:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),d(h[6],h[8])?(_(u+3,h[5],h[2]),y(u+l+3,h[5],h[2]),E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(g(u+3,h[5],h[6]),g(u+l+3,h[6],h[5]),w(u+(l<<1)+2,h[5],h[6],h[8]),_(u+(l<<1)+3,h[6],h[8]),v(u+3*l+2,h[8],h[5],h[6]),x(u+3*l+3,h[8],h[6])),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 241:case 240:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(w(u+(l<<1)+2,h[5],h[6],h[8]),v(u+(l<<1)+3,h[6],h[5],h[8]),g(u+3*l,h[5],h[8]),g(u+3*l+1,h[8],h[5]),_(u+3*l+2,h[8],h[6]),x(u+3*l+3,h[8],h[6]));break;case 236:case 232:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5],y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6])):(v(u+(l<<1),h[4],h[5],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),_(u+3*l+1,h[8],h[4]),g(u+3*l+2,h[8],h[5]),g(u+3*l+3,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]);break;case 109:case 105:d(h[8],h[4])?(_(u,h[5],h[2]),y(u+l,h[5],h[2]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(g(u,h[5],h[4]),g(u+l,h[4],h[5]),_(u+(l<<1),h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),v(u+3*l+1,h[8],h[5],h[4])),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 171:case 43:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5],E[u+l+1]=h[5],y(u+(l<<1),h[5],h[8]),_(u+3*l,h[5],h[8])):(x(u,h[2],h[4]),v(u+1,h[2],h[5],h[4]),_(u+l,h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),g(u+(l<<1),h[4],h[5]),g(u+3*l,h[5],h[4])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 143:case 15:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l]=h[5],E[u+l+1]=h[5]):(x(u,h[2],h[4]),_(u+1,h[2],h[4]),g(u+2,h[2],h[5]),g(u+3,h[5],h[2]),v(u+l,h[4],h[5],h[2]),w(u+l+1,h[5],h[4],h[2])),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 124:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 203:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 62:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 211:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 118:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 217:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 110:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 155:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 188:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 185:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 61:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 157:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 103:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 227:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 230:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 199:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 220:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6]));break;case 158:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 234:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 242:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]);break;case 59:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),E[u+l+1]=h[5],y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 121:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 87:_(u,h[5],h[4]),y(u+1,h[5],h[4]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),E[u+l+2]=h[5],b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 79:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l+1]=h[5],y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 122:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 94:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l+2]=h[5],d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 218:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6]));break;case 91:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),E[u+l+1]=h[5],d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 229:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 167:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 173:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 181:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 186:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 115:_(u,h[5],h[4]),y(u+1,h[5],h[4]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6])),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]);break;case 93:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6]));break;case 206:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 205:case 201:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),d(h[8],h[4])?(g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7])):(g(u+(l<<1),h[5],h[4]),E[u+(l<<1)+1]=h[5],v(u+3*l,h[5],h[8],h[4]),g(u+3*l+1,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 174:case 46:d(h[4],h[2])?(_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1])):(v(u,h[5],h[2],h[4]),g(u+1,h[5],h[2]),g(u+l,h[5],h[4]),E[u+l+1]=h[5]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 179:case 147:_(u,h[5],h[4]),y(u+1,h[5],h[4]),d(h[2],h[6])?(g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3])):(g(u+2,h[5],h[2]),v(u+3,h[5],h[2],h[6]),E[u+l+2]=h[5],g(u+l+3,h[5],h[6])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 117:case 116:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9])):(E[u+(l<<1)+2]=h[5],g(u+(l<<1)+3,h[5],h[6]),g(u+3*l+2,h[5],h[8]),v(u+3*l+3,h[5],h[8],h[6])),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]);break;case 189:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 231:_(u,h[5],h[4]),y(u+1,h[5],h[4]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 126:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 219:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 125:d(h[8],h[4])?(_(u,h[5],h[2]),y(u+l,h[5],h[2]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(g(u,h[5],h[4]),g(u+l,h[4],h[5]),_(u+(l<<1),h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),v(u+3*l+1,h[8],h[5],h[4])),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 221:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),d(h[6],h[8])?(_(u+3,h[5],h[2]),y(u+l+3,h[5],h[2]),E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(g(u+3,h[5],h[6]),g(u+l+3,h[6],h[5]),w(u+(l<<1)+2,h[5],h[6],h[8]),_(u+(l<<1)+3,h[6],h[8]),v(u+3*l+2,h[8],h[5],h[6]),x(u+3*l+3,h[8],h[6])),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 207:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l]=h[5],E[u+l+1]=h[5]):(x(u,h[2],h[4]),_(u+1,h[2],h[4]),g(u+2,h[2],h[5]),g(u+3,h[5],h[2]),v(u+l,h[4],h[5],h[2]),w(u+l+1,h[5],h[4],h[2])),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 238:_(u,h[5],h[1]),g(u+1,h[5],h[1]),y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5],y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6])):(v(u+(l<<1),h[4],h[5],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),x(u+3*l,h[8],h[4]),_(u+3*l+1,h[8],h[4]),g(u+3*l+2,h[8],h[5]),g(u+3*l+3,h[5],h[8])),y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]);break;case 190:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],y(u+(l<<1)+3,h[5],h[8]),_(u+3*l+3,h[5],h[8])):(v(u+2,h[2],h[5],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),_(u+l+3,h[6],h[2]),g(u+(l<<1)+3,h[6],h[5]),g(u+3*l+3,h[5],h[6])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]);break;case 187:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5],E[u+l+1]=h[5],y(u+(l<<1),h[5],h[8]),_(u+3*l,h[5],h[8])):(x(u,h[2],h[4]),v(u+1,h[2],h[5],h[4]),_(u+l,h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),g(u+(l<<1),h[4],h[5]),g(u+3*l,h[5],h[4])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 243:_(u,h[5],h[4]),y(u+1,h[5],h[4]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),d(h[6],h[8])?(E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(w(u+(l<<1)+2,h[5],h[6],h[8]),v(u+(l<<1)+3,h[6],h[5],h[8]),g(u+3*l,h[5],h[8]),g(u+3*l+1,h[8],h[5]),_(u+3*l+2,h[8],h[6]),x(u+3*l+3,h[8],h[6]));break;case 119:d(h[2],h[6])?(_(u,h[5],h[4]),y(u+1,h[5],h[4]),E[u+2]=h[5],E[u+3]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5]):(g(u,h[5],h[2]),g(u+1,h[2],h[5]),_(u+2,h[2],h[6]),x(u+3,h[2],h[6]),w(u+l+2,h[5],h[6],h[2]),v(u+l+3,h[6],h[5],h[2])),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 237:case 233:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[6]),v(u+3,h[5],h[2],h[6]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),w(u+l+2,h[5],h[6],h[2]),b(u+l+3,h[5],h[6],h[2]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5],y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 175:case 47:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l]=h[5],E[u+l+1]=h[5],y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),w(u+(l<<1)+2,h[5],h[6],h[8]),b(u+(l<<1)+3,h[5],h[6],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[6]),v(u+3*l+3,h[5],h[8],h[6]);break;case 183:case 151:_(u,h[5],h[4]),y(u+1,h[5],h[4]),E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),E[u+l+2]=h[5],E[u+l+3]=h[5],b(u+(l<<1),h[5],h[4],h[8]),w(u+(l<<1)+1,h[5],h[4],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),v(u+3*l,h[5],h[8],h[4]),b(u+3*l+1,h[5],h[8],h[4]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 245:case 244:v(u,h[5],h[2],h[4]),b(u+1,h[5],h[2],h[4]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),b(u+l,h[5],h[4],h[2]),w(u+l+1,h[5],h[4],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 250:_(u,h[5],h[1]),g(u+1,h[5],h[1]),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6]));break;case 123:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 95:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l+1]=h[5],E[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 222:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 252:_(u,h[5],h[1]),b(u+1,h[5],h[2],h[1]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 249:_(u,h[5],h[2]),_(u+1,h[5],h[2]),b(u+2,h[5],h[2],h[3]),_(u+3,h[5],h[3]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5];break;case 235:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),b(u+l+3,h[5],h[6],h[3]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5],y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 111:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l]=h[5],E[u+l+1]=h[5],y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),b(u+(l<<1)+3,h[5],h[6],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 63:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l]=h[5],E[u+l+1]=h[5],E[u+l+2]=h[5],y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),b(u+3*l+2,h[5],h[8],h[9]),_(u+3*l+3,h[5],h[9]);break;case 159:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),E[u+l+1]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[7]),b(u+3*l+1,h[5],h[8],h[7]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 215:_(u,h[5],h[4]),y(u+1,h[5],h[4]),E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),E[u+l+2]=h[5],E[u+l+3]=h[5],b(u+(l<<1),h[5],h[4],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 246:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),b(u+l,h[5],h[4],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 254:_(u,h[5],h[1]),g(u+1,h[5],h[1]),d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),g(u+l,h[5],h[1]),y(u+l+1,h[5],h[1]),E[u+l+2]=h[5],d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 253:_(u,h[5],h[2]),_(u+1,h[5],h[2]),_(u+2,h[5],h[2]),_(u+3,h[5],h[2]),y(u+l,h[5],h[2]),y(u+l+1,h[5],h[2]),y(u+l+2,h[5],h[2]),y(u+l+3,h[5],h[2]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5],E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 251:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),g(u+2,h[5],h[3]),_(u+3,h[5],h[3]),E[u+l+1]=h[5],y(u+l+2,h[5],h[3]),g(u+l+3,h[5],h[3]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5];break;case 239:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],y(u+2,h[5],h[6]),_(u+3,h[5],h[6]),E[u+l]=h[5],E[u+l+1]=h[5],y(u+l+2,h[5],h[6]),_(u+l+3,h[5],h[6]),E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[6]),_(u+(l<<1)+3,h[5],h[6]),d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5],y(u+3*l+2,h[5],h[6]),_(u+3*l+3,h[5],h[6]);break;case 127:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],d(h[2],h[6])?(E[u+2]=h[5],E[u+3]=h[5],E[u+l+3]=h[5]):(x(u+2,h[2],h[5]),x(u+3,h[2],h[6]),x(u+l+3,h[6],h[5])),E[u+l]=h[5],E[u+l+1]=h[5],E[u+l+2]=h[5],d(h[8],h[4])?(E[u+(l<<1)]=h[5],E[u+3*l]=h[5],E[u+3*l+1]=h[5]):(x(u+(l<<1),h[4],h[5]),x(u+3*l,h[8],h[4]),x(u+3*l+1,h[8],h[5])),E[u+(l<<1)+1]=h[5],y(u+(l<<1)+2,h[5],h[9]),g(u+(l<<1)+3,h[5],h[9]),g(u+3*l+2,h[5],h[9]),_(u+3*l+3,h[5],h[9]);break;case 191:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),E[u+l]=h[5],E[u+l+1]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],y(u+(l<<1),h[5],h[8]),y(u+(l<<1)+1,h[5],h[8]),y(u+(l<<1)+2,h[5],h[8]),y(u+(l<<1)+3,h[5],h[8]),_(u+3*l,h[5],h[8]),_(u+3*l+1,h[5],h[8]),_(u+3*l+2,h[5],h[8]),_(u+3*l+3,h[5],h[8]);break;case 223:d(h[4],h[2])?(E[u]=h[5],E[u+1]=h[5],E[u+l]=h[5]):(x(u,h[2],h[4]),x(u+1,h[2],h[5]),x(u+l,h[4],h[5])),E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),E[u+l+1]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],g(u+(l<<1),h[5],h[7]),y(u+(l<<1)+1,h[5],h[7]),E[u+(l<<1)+2]=h[5],d(h[6],h[8])?(E[u+(l<<1)+3]=h[5],E[u+3*l+2]=h[5],E[u+3*l+3]=h[5]):(x(u+(l<<1)+3,h[6],h[5]),x(u+3*l+2,h[8],h[5]),x(u+3*l+3,h[8],h[6])),_(u+3*l,h[5],h[7]),g(u+3*l+1,h[5],h[7]);break;case 247:_(u,h[5],h[4]),y(u+1,h[5],h[4]),E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),_(u+l,h[5],h[4]),y(u+l+1,h[5],h[4]),E[u+l+2]=h[5],E[u+l+3]=h[5],_(u+(l<<1),h[5],h[4]),y(u+(l<<1)+1,h[5],h[4]),E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],_(u+3*l,h[5],h[4]),y(u+3*l+1,h[5],h[4]),E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6]);break;case 255:d(h[4],h[2])?E[u]=h[5]:v(u,h[5],h[2],h[4]),E[u+1]=h[5],E[u+2]=h[5],d(h[2],h[6])?E[u+3]=h[5]:v(u+3,h[5],h[2],h[6]),E[u+l]=h[5],E[u+l+1]=h[5],E[u+l+2]=h[5],E[u+l+3]=h[5],E[u+(l<<1)]=h[5],E[u+(l<<1)+1]=h[5],E[u+(l<<1)+2]=h[5],E[u+(l<<1)+3]=h[5],d(h[8],h[4])?E[u+3*l]=h[5]:v(u+3*l,h[5],h[8],h[4]),E[u+3*l+1]=h[5],E[u+3*l+2]=h[5],d(h[6],h[8])?E[u+3*l+3]=h[5]:v(u+3*l+3,h[5],h[8],h[6])}p++,u+=4}u+=3*l}};function Bs(t,e,i){const n={offset:t.offset.clone(),repeat:t.repeat.clone()};e&&ls>1&&(e*=ls),n.frameWidth=e||t.image.height,n.frames=i||Math.floor(t.image.width/n.frameWidth),n.repeat.y=Math.max(1,n.frameWidth/t.image.height),n.frameUvWidth=n.frameWidth/t.image.width,n.isSpriteSheet=!0,n.offsetRepeatUniform=null,n.setFrame=function(e){if(e<0||e>=this.frames)throw console.log(t.image.width),console.log(n.frameWidth),new RangeError(`Invalid frame number ${e} of ${this.frames}`);this.offset.x=e*this.frameUvWidth,this.repeat.x=this.frameUvWidth,this.offsetRepeatUniform&&this.offsetRepeatUniform.set(this.offset.x,this.offset.y,this.repeat.x,this.repeat.y)};const r=new Proxy(t,{get:function(t,e){return n.hasOwnProperty(e)?n[e]:t[e]},set:function(t,e,i){return n.hasOwnProperty(e)?n[e]=i:t[e]=i,!0}});return r.setFrame(0),r}const Fs=new class extends Fr{constructor(){super(...arguments),this.cache=new Map,this.queued=new Map,this.stats=new Map}load(t,e,...i){return this.stats.has(t)||this.stats.set(t,{hits:0,loaded:0}),this.stats.get(t).loaded++,super.load(t,(function(t){if(ls>1){const e=Ns(t.image,ls);t.image=e,t.needsUpdate=!0}e&&e(t)}),...i)}get(t,e,i,n){if(this.cache.has(t)){this.stats.get(t).hits++;const i=this.cache.get(t);return e&&e(i),i}if(this.queued.has(t)){this.stats.get(t).hits++;const r=this.queued.get(t);return r.onLoad.push(e),r.onProgress.push(i),r.onError.push(n),r.texture}{const r=this.cache,a=this.queued,o={onLoad:[e],onProgress:[i],onError:[n]},s=this.load(t,(...e)=>{const i=e[0];r.set(t,i),a.delete(t),o.onLoad.forEach(t=>t&&t(...e))},(...t)=>{o.onProgress.forEach(e=>e&&e(...t))},(...e)=>{a.delete(t),o.onError.forEach(t=>t&&t(...e))});return o.texture=s,a.set(t,o),s.magFilter=f,s}}};class Gs extends bt{constructor(t){super(),Object.keys(t).forEach(e=>{this[e]instanceof Object&&this[e].copy?this[e].copy(t[e]):this[e]=t[e]}),this.persistedProps=["type","position"],this.up.set(0,0,1)}includes(t){for(let e=t;e;e=e.parent)if(e===this)return!0;return!1}getState(){const t={};return this.persistedProps.forEach(e=>{if(!(e in this))throw console.log(this),new Error("entity does not have a `"+e+"` property");t[e]=this[e]}),t}}class Hs extends Gs{constructor(t,e,i){super(t),this.size=e,this.speed=i||0,this.moveDirection=new F,this.velocity=new F,this.turnDirection=0,this.raycaster=new Fo}update(t,e){const i=t-this.lastTime;if(this.lastTime=t,i&&(this.turnDirection&&(this.rotateY(this.turnDirection*i),this.updateVelocity()),this.velocity.lengthSq()&&!this.frozen)){let n=0,r=!1;const a=this.velocity.clone().multiplyScalar(i);do{n++,r=!1;const i=a.length(),o=a.clone().normalize(),s=this.size+i;this.raycaster.set(this.position,o),this.raycaster.far=s;let c=this.raycaster.intersectObject(e,!0);for(let e of c){if(this.includes(e.object)||Vs(e.object))continue;let n=!0;if(this.onCollision&&(n=this.onCollision(e,t)),n){const t=new F;if(e.face)t.copy(e.face.normal);else{const i=new F;e.object.getWorldPosition(i).sub(e.point),t.crossVectors(o,i).cross(i).normalize()}const n=Math.min(i,s-e.distance);n&&(a.addScaledVector(t,n),r=!0)}}}while(r&&n<4);this.position.add(a)}}updateVelocity(){return this.velocity.copy(this.moveDirection).normalize().multiplyScalar(this.speed),this.localToWorld(this.velocity).sub(this.position),this.velocity.multiplyScalar(100).roundToZero().divideScalar(100)}}function Vs(t){for(let e=t;e;e=e.parent)if(e.isEthereal||e.shouldRemove)return!0;return!1}let js=null;ps.load("sounds/adlib/wall_hit.wav",t=>js=t);class Ws extends Hs{constructor(t){super(t,0,30),this.persistedProps.push("direction","isBig"),void 0===this.isBig&&(this.isBig=!0),this.scale.divideScalar(3),this.lookAt(this.position.clone().add(this.direction)),this.updateMatrixWorld(),this.moveDirection.z=1,this.updateVelocity(),this.isEthereal=!0,js&&(this.hitSound=new po(us),this.hitSound.setBuffer(js),this.add(this.hitSound)),this.isBig&&(this.light=new ma(16737792,.5,.5),this.light.distance*=2,this.add(this.light)),Fs.get("sprites/fireball.png",t=>{this.spriteSheet=Bs(t),this.sprite=new Ji(new Zi({map:this.spriteSheet})),this.isBig||(this.sprite.material.rotation=Math.floor(4*Math.random())*Math.PI/2),this.add(this.sprite)})}dispose(){this.sprite.material.dispose(),this.spriteSheet.dispose()}onCollision(t,e){if(!this.removeAtTime){let i=!1;for(let n=t.object;n;n=n.parent)if(n.onDamage){const t=this.isBig?3:1;n.onDamage(e,t),i=!0;break}!i&&this.hitSound&&this.hitSound.play()}return this.removeAtTime=e+.075,this.isBig=!1,this.removeAtTime+=.075,this.moveDirection.z=0,this.updateVelocity(),this.translateZ(-.1),!0}update(t,e){super.update(t,e);let i=Math.floor(10*t)%2;this.isBig&&(i+=2),this.spriteSheet&&this.spriteSheet.setFrame(i),t>=this.removeAtTime&&(this.shouldRemove=!0)}}class Xs extends Gs{constructor(t){super(t),this.persistedProps.push("value"),this.fps=8,this.light=new ma(17117,1,1.5),this.add(this.light),Fs.get("sprites/portal.png",t=>{this.spritesheet=new Bs(t),this.sprite=new Ji(new Zi({fog:!0,map:this.spritesheet})),this.add(this.sprite)})}dispose(){this.sprite.material.dispose(),this.spritesheet.dispose()}update(t){if(this.spritesheet){const e=Math.floor(t*this.fps)%this.spritesheet.frames;this.spritesheet.setFrame(e)}this.light.intensity=.5+.2*Math.abs(Math.sin(.5*t))+.02*Math.abs(Math.sin(this.fps*t))}}class qs extends Xs{constructor(t){super(t),this.destination=(new F).copy(t.value)}}class Ys extends Xs{}var Zs=Object.freeze({__proto__:null,Entity:Gs,Actor:Hs,Fireball:Ws,Portal:Xs,JumpGate:qs,WarpGate:Ys});class Js extends Hs{constructor(t,e,i,n,r,a,o){super(e,n,r),this.persistedProps.push("anim","animStartTime","health","lastAttackTime"),void 0===this.anim&&(this.anim="move",this.animStartTime=-Math.random()),void 0===this.health&&(this.health=i),void 0===this.lastAttackTime&&(this.lastAttackTime=0),this.lastThinkTime=Math.random(),this.isEthereal=this.health<=0,this.sprite=new Ji(new Zi({fog:!0})),this.attackInterval=a,this.animations=o.animations;const s=Object.values(this.animations).map(t=>t.start+t.length).reduce(Math.max,0);Fs.get(t,t=>{this.texture=new Bs(t,o.frameWidth,s),this.sprite.material.map=this.texture,this.sprite.material.needsUpdate=!0,this.add(this.sprite)}),this.raycaster=new Fo}attack(t,e){this.startAnimation("attack",e),this.lastAttackTime=e}onDamage(t,e){"death"!=this.anim&&(this.health-=e,this.velocity.set(0,0,0),this.health>0?this.startAnimation("pain",t):(this.isEthereal=!0,this.startAnimation("death",t),this.moveDestination=null))}dispose(){this.sprite.material.dispose,this.texture.dispose()}startAnimation(t,e){t in this.animations&&(this.anim=t,this.animStartTime=e)}hunt(t,e){"death"!=this.anim&&(this.target=t,this.maze=e)}sightTarget(){const t=this.target.position.clone().sub(this.position),e=t.length();this.raycaster.set(this.position,t.normalize()),this.raycaster.far=e;let i=this.raycaster.intersectObject(this.maze,!0);return i=i.filter(t=>!(t.object instanceof Ji)),i.length&&this.targetLocked?(this.targetPosition=this.targetPosition.clone(),this.targetLocked=!1):i.length||this.targetLocked||(this.targetPosition=this.target.position,this.targetLocked=!0),this.targetLocked}onCollision(t,e){const i=t.object;if(!this.sidestep&&i instanceof Ji){const t=this.worldToLocal(i.position.clone()).cross(this.up),n=this.worldToLocal(this.target.position.clone());this.sidestep=Math.sign(n.dot(t)),this.sidestepStartTime=e}return!0}update(t,e){if(void 0===this.animStartTime&&this.startAnimation(this.anim,t),t>this.sidestepStartTime+1&&(this.sidestep=0),this.target&&t>this.lastThinkTime+.25&&(this.think(t),this.lastThinkTime=t),this.moveDestination&&"move"==this.anim){this.velocity.copy(this.moveDestination).sub(this.position),this.sidestep&&this.velocity.cross(this.up.clone().multiplyScalar(this.sidestep)),this.velocity.clampLength(0,this.speed);let t=this.size;this.target&&(t+=this.target.size),this.velocity.length()<t&&(this.moveDestination=null,this.velocity.set(0,0,0))}else this.velocity.set(0,0,0);if(super.update(t,e),this.texture){const e=t-this.animStartTime,i=this.animations[this.anim];let n=Math.floor(e*i.speed);if(n>=i.length)if("death"==this.anim)this.removeDead&&(this.shouldRemove=!0),n=i.length-1;else{if("move"!=this.anim)return this.startAnimation("move",t),this.update(t);this.animStartTime=t,n%=i.length}this.texture.setFrame(i.start+n)}}}class Qs extends Js{think(t){if(this.sightTarget()){const e=t-this.lastAttackTime,i=this.size+this.target.size;this.position.distanceTo(this.targetPosition)<i&&"move"==this.anim&&e>=this.attackInterval?this.attack(this.targetPosition,t):this.moveDestination=this.targetPosition,this.sidestep=0}else this.moveDestination=this.targetPosition}}class Ks extends Js{constructor(...t){super(...t),ps.load("sounds/adlib/shoot.wav",t=>{this.shootSound=new po(us).setBuffer(t),this.add(this.shootSound)})}attack(t,e){super.attack(t,e);const i=t.clone().sub(this.position).normalize(),n=new Ws({type:"Fireball",position:this.position.clone().addScaledVector(i,2/3),direction:i,isBig:!1});this.parent.add(n),this.shootSound&&this.shootSound.play()}think(t){if(this.sightTarget()){const e=t-this.lastAttackTime;"move"==this.anim&&e>=this.attackInterval&&this.attack(this.targetPosition,t),this.sidestep=0}else this.moveDestination=this.targetPosition}}var $s=Object.freeze({__proto__:null,Enemy:Js,MeleeEnemy:Qs,RangedEnemy:Ks,Orc:class extends Qs{constructor(t){super("sprites/orc.png",t,3,.5,2,1,{frameWidth:51,animations:{move:{start:0,length:4,speed:4},attack:{start:4,length:2,speed:2},pain:{start:6,length:1,speed:4},death:{start:6,length:4,speed:6}}})}},Troll:class extends Qs{constructor(t){super("sprites/troll.png",t,10,.75,5,1,{frameWidth:64,animations:{move:{start:0,length:4,speed:6},attack:{start:4,length:3,speed:5},pain:{start:7,length:1,speed:4},death:{start:7,length:4,speed:6}}})}},Bat:class extends Qs{constructor(t){super("sprites/bat.png",t,1,.5,5,0,{frameWidth:40,animations:{move:{start:0,length:4,speed:16},death:{start:4,length:2,speed:8}}}),this.sprite.scale.x=.625;this.sprite.scale.multiplyScalar(.8),this.sprite.translateZ(-.1),this.removeDead=!0}},Mage:class extends Ks{constructor(t){super("sprites/mage.png",t,5,.5,1.5,2,{frameWidth:56,animations:{move:{start:0,length:2,speed:3},attack:{start:2,length:1,speed:3},pain:{start:3,length:1,speed:2},death:{start:3,length:3,speed:7}}}),this.sprite.scale.x=.875}},Demon:class extends Qs{constructor(t){super("sprites/demon.png",t,50,.75,1.5,1,{frameWidth:64,animations:{move:{start:0,length:4,speed:3.5},attack:{start:4,length:3,speed:4},pain:{start:7,length:1,speed:4},death:{start:7,length:4,speed:6}}})}},Nemesis:class extends Ks{constructor(t){super("sprites/nemesis.png",t,100,.5,5,2,{frameWidth:64,animations:{move:{start:0,length:2,speed:10},attack:{start:2,length:1,speed:2},pain:{start:3,length:1,speed:4},death:{start:3,length:7,speed:8}}})}}});class tc extends ie{constructor(t){super({vertexShader:"\nvarying vec3 vNormal;\nvarying vec3 vModelPosition;\n\n#include <common>\n#include <fog_pars_vertex>\n#include <uv_pars_vertex>\n\nvoid main() {\n\t#include <begin_vertex>\n\t#include <project_vertex>\n\n\t#include <beginnormal_vertex>\n\t#include <defaultnormal_vertex>\n\n\tvNormal = normalize(transformedNormal);\n\tvModelPosition = transformed;\n\n\t#include <fog_vertex>\n\t#include <uv_vertex>\n}",fragmentShader:"\nuniform bool clampColor;\nuniform vec3 diffuse;\nuniform float interweaveMin;\nuniform float interweaveSteps;\nuniform float opacity;\nuniform int pixelate;\n\nuniform mat4 modelViewMatrix;\n\nvarying vec3 vNormal;\nvarying vec3 vModelPosition;\n\nvec3 colorPalette[16];\n\nvoid initColorPalette() {\n\tcolorPalette[0] = vec3(0, 0, 0);\n\tcolorPalette[1] = vec3(0, 0, 2.0/3.0);\n\tcolorPalette[2] = vec3(0, 2.0/3.0, 0);\n\tcolorPalette[3] = vec3(0, 2.0/3.0, 2.0/3.0);\n\tcolorPalette[4] = vec3(2.0/3.0, 0, 0);\n\tcolorPalette[5] = vec3(2.0/3.0, 0, 2.0/3.0);\n\tcolorPalette[6] = vec3(2.0/3.0, 1.0/3.0, 0);\n\tcolorPalette[7] = vec3(2.0/3.0, 2.0/3.0, 2.0/3.0);\n\tcolorPalette[8] = vec3(1.0/3.0, 1.0/3.0, 1.0/3.0);\n\tcolorPalette[9] = vec3(1.0/3.0, 1.0/3.0, 1);\n\tcolorPalette[10] = vec3(1.0/3.0, 1, 1.0/3.0);\n\tcolorPalette[11] = vec3(1.0/3.0, 1, 1);\n\tcolorPalette[12] = vec3(1, 1.0/3.0, 1.0/3.0);\n\tcolorPalette[13] = vec3(1, 1.0/3.0, 1);\n\tcolorPalette[14] = vec3(1, 1, 1.0/3.0);\n\tcolorPalette[15] = vec3(1, 1, 1);\n}\n\nvec3 clampToPalette(const in vec3 color) {\n\tfloat smallestDistance = 100000.0;\n\tvec3 closestColor = color;\n\tfor (int i = 0; i < 16; i++) {\n\t\tfloat distance = distance(color, colorPalette[i]);\n\t\tif (distance < smallestDistance) {\n\t\t\tsmallestDistance = distance;\n\t\t\tclosestColor = colorPalette[i];\n\t\t}\n\t}\n\treturn closestColor;\n}\n\n#include <common>\n#include <bsdfs>\n#include <fog_pars_fragment>\n#include <lights_pars_begin>\n#include <lights_pars_maps>\n#include <map_pars_fragment>\n#include <uv_pars_fragment>\n\nvoid RE_Direct_Pixelated(const in IncidentLight directLight, const in GeometricContext geometry, const in vec3 material, inout ReflectedLight reflectedLight) {\n\n\tfloat dotNL = saturate(dot(geometry.normal, directLight.direction));\n\tvec3 irradiance = dotNL * directLight.color;\n\n\t#ifndef PHYSICALLY_CORRECT_LIGHTS\n\t\tirradiance *= PI; // punctual light\n\t#endif\n\n\treflectedLight.directDiffuse += mix(irradiance * material, irradiance, 0.5);\n}\n\nvoid RE_IndirectDiffuse_Pixelated(const in vec3 irradiance, const in GeometricContext geometry, const in vec3 material, inout ReflectedLight reflectedLight) {\n\treflectedLight.indirectDiffuse += irradiance * material;  // FIXME: this is a color right now\n}\n\n#define RE_Direct          RE_Direct_Pixelated\n#define RE_IndirectDiffuse RE_IndirectDiffuse_Pixelated\n\nvoid main() {\n\tinitColorPalette();\n\n\tvec4 diffuseColor = vec4(diffuse, opacity);\n\n\t#include <map_fragment>\n\n\t#include <normal_fragment_begin>\n\t#include <normal_fragment_maps>\n\n\tReflectedLight reflectedLight = ReflectedLight(vec3(0.0), vec3(0.0), vec3(0.0), vec3(0.0));\n\n\tvec3 material = diffuseColor.rgb;\n\n\tvec3 modelPosition = vModelPosition;\n\tfloat interweave = 1.0;\n\tif (pixelate > 0) {\n\t\tmodelPosition = floor(modelPosition * float(pixelate));\n\t\tfloat interweaveStep = (1.0 - interweaveMin) / interweaveSteps;\n\t\tinterweave = interweaveMin + interweaveStep * mod(dot(modelPosition, vec3(1)), interweaveSteps);\n\t\tmodelPosition /= float(pixelate);\n\t}\n\tvec3 vViewPosition = -(modelViewMatrix * vec4(modelPosition, 1.0)).xyz;\n\n\t#include <lights_fragment_begin>\n\t#include <lights_fragment_maps>\n\t#include <lights_fragment_end>\n\n\tvec3 outgoingLight = reflectedLight.indirectDiffuse;\n\toutgoingLight += reflectedLight.directDiffuse * interweave;\n\tgl_FragColor = vec4(outgoingLight, diffuseColor.a);\n\n\tif (clampColor) {\n\t\tgl_FragColor.rgb = clampToPalette(gl_FragColor.rgb);\n\t}\n\n\t#include <fog_fragment>\n}",uniforms:ot.merge([lt.common,lt.fog,lt.lights,{clampColor:{value:!0},interweaveMin:{value:2/3},interweaveSteps:{value:3},pixelate:{value:64}}]),fog:!0,lights:!0}),this.setValues(t)}get clampColor(){return this.uniforms.clampColor.value}set clampColor(t){this.uniforms.clampColor.value=t}get color(){return this.uniforms.diffuse.value}set color(t){t.isColor?this.uniforms.diffuse.value=t:this.uniforms.diffuse.value=new ct(t)}get interweaveMin(){return this.uniforms.interweaveMin.value}set interweaveMin(t){this.uniforms.interweaveMin.value=t}get interweaveSteps(){return this.uniforms.interweaveSteps.value}set interweaveSteps(t){this.uniforms.interweaveSteps.value=t}get map(){return this.uniforms.map.value}set map(t){this.uniforms.map.value=t}get pixelate(){return this.uniforms.pixelate.value}set pixelate(t){this.uniforms.pixelate.value=t}}class ec extends jt{constructor(t){super(1,1),this.translate(t.x,t.y,-.5)}}class ic extends jt{constructor(t,e){super(1,1),this.rotateX(Math.PI/2),this.translate(0,-.5,0);this.rotateZ(["south","east","north","west"].indexOf(t)*Math.PI/2),e&&this.translate(e.x,e.y,0)}}function nc(t){return Object.keys(t).map(e=>{const i=(new Gt).fromGeometry(t[e]),n=Fs.get("walls/"+e+".png");n.anisotropy=8;const r=new tc({map:n}),a=new oe(i,r);return a.dispose=function(){i.dispose(),r.dispose(),n.dispose()},a})}function rc(t,e,i,n){i=i||{};const r={east:"light",west:"light",north:"dark",south:"dark"};return e.forEach(e=>{const a=t+"_"+r[e];a in i||(i[a]=new At),i[a].merge(new ic(e,n))}),i}class ac extends Gs{constructor(t,e){super(t),this.type=this.type||"Door",this.persistedProps.push("color"),this.removeFunc=e;const i=new Vt(1,1,1);i.rotateX(Math.PI/2);const n=new tc;Fs.get("walls/"+this.color+"_door.png",t=>{t.anisotropy=8;const e=new Bs(t);n.map=e,n.needsUpdate=!0}),this.mesh=new oe(i,n),this.add(this.mesh),this.frequency=9+.5*Math.random(),this.adjacent=[],ps.load("sounds/adlib/use_key.wav",t=>{this.unlockSound=new po(us),this.unlockSound.setBuffer(t),this.add(this.unlockSound)})}dispose(){this.mesh.geometry.dispose(),this.mesh.material.dispose(),this.mesh.material.map.dispose()}getState(){return null}update(t){if(this.mesh.material.map){Math.floor(this.frequency*t)}}unlock(t){return!this.shouldRemove&&(this.shouldRemove=!0,this.removeFunc(),this.adjacent.forEach(t=>t.unlock(!0)),!0)}}class oc extends Gs{constructor(t,e){super(t),this.type="ExplodingWall",this.persistedProps.push("ignition","faces","wall"),this.removeFunc=e,this.walls=nc(rc(this.wall,this.faces)),this.add(...this.walls);const i=new Vt(1,1,1).rotateX(Math.PI/2),n=new ee({transparent:!0});this.box=new oe(i,n),Fs.get("walls/exploding.png",t=>{this.box.material.map=new Bs(t,64,3),this.box.material.needsUpdate=!0}),this.adjacent=[],this.burnDuration=.25,this.spreadDuration=this.burnDuration/2}dispose(){this.box.geometry.dispose(),this.box.material.dispose(),this.box.material.map.dispose(),this.walls.forEach(t=>t.dispose())}ignite(t){t>=this.ignition||(this.ignition=t,this.removeFunc&&this.removeFunc(),this.adjacent.forEach(e=>e.ignite(t+this.spreadDuration)))}getState(){return this.ignition?super.getState():null}onDamage(t){this.ignite(t)}update(t){const e=t-this.ignition;if(e>=this.burnDuration)this.shouldRemove=!0;else if(e>0){this.exploding||(this.exploding=!0,this.children.forEach(t=>t.shouldRemove=!0),this.add(this.box));const t=this.box.material.map;if(t){const i=Math.floor(e*t.frames/this.burnDuration);t.setFrame(i)}}}}class sc extends Gs{constructor(t,...e){super(t),this.itemFrames=e;const i=this.soundName||this.type.toLowerCase();ps.load("sounds/adlib/pickup_"+i+".wav",t=>{this.pickupSound=new po(us),this.pickupSound.setBuffer(t),this.add(this.pickupSound)}),Fs.get("sprites/items.png",t=>{this.texture=t,this.spritesheet=new Bs(t,40,11),this.spritesheet.setFrame(this.itemFrames[0]),this.sprite=new Ji(new Zi({fog:!0,map:this.spritesheet})),this.sprite.scale.multiplyScalar(.6),this.sprite.translateZ(-.2),this.add(this.sprite)})}dispose(){this.sprite.material.dispose(),this.texture.dispose()}pickup(){return this.pickupSound&&this.pickupSound.play(),this.shouldRemove=!0,this}update(t){if(this.itemFrames.length>1&&this.spritesheet){const e=Math.floor(8*t)%this.itemFrames.length,i=this.itemFrames[e];this.spritesheet.setFrame(i)}}}function cc(t,e){return class extends sc{constructor(i){super(Object.assign(i,e),...t)}}}const hc=cc([0,1]),lc=cc([2,3]),uc=cc([4]),pc=cc([5],{soundName:"key"}),dc=cc([6],{soundName:"key"}),fc=cc([7],{soundName:"key"}),mc=cc([8],{soundName:"key"}),gc=cc([9]),vc=cc([10]);var yc=Object.freeze({__proto__:null,Item:sc,Bolt:hc,Nuke:lc,Potion:uc,RedKey:pc,YellowKey:dc,GreenKey:fc,BlueKey:mc,Scroll:gc,Treasure:vc,Grelminar:class extends Gs{constructor(t){super(t),Fs.get("sprites/grelminar.png",t=>{this.sprite=new Ji(new Zi({fog:!0,map:t})),this.add(this.sprite)})}pickup(){return console.log("Game over, man! Game over!"),this}}});function xc(t,e,i,n,r){[t[[i-1,n]],t[[i+1,n]],t[[i,n-1]],t[[i,n+1]]].filter(t=>void 0!==t).filter(r||(t=>!0)).forEach(t=>{e.adjacent.push(t),t.adjacent.push(e)}),t[[i,n]]=e}class bc{constructor(t){Object.assign(this,t),"string"==typeof this.layout[0]&&(this.layout=this.layout.map(t=>t.split("")))}getTile(t){const e=this.height-1-Math.round(t.y),i=Math.round(t.x);if(e<0||e>=this.height||i<0||i>=this.width)return null;const n=this.layout[e][i];return" "==n?{type:"floor"}:this.legend[n]}adjacentTiles(t){const e=t.x,i=t.y;return{east:this.getTile(new U(e+1,i)),west:this.getTile(new U(e-1,i)),north:this.getTile(new U(e,i+1)),south:this.getTile(new U(e,i-1))}}adjacentBorders(t){const e=this.getTile(t),i=this.adjacentTiles(t);return Object.keys(i).filter(t=>{const n=i[t];return n&&n.type!=e.type})}getPlayerStart(){return this.entities.filter(t=>"Player"==t.type).shift()||this.playerStart}toScene(){const t=new Yi;return t.add(new ya(void 0!==this.ambientLight?this.ambientLight:16777215)),this.fog&&(t.fog=new qi(this.fog.color,this.fog.near,this.fog.far)),function(t,e){const i={},n={},r=new At,a={};for(let o=0;o<t.width;o++)for(let s=0;s<t.height;s++){const c=new U(o,s),h=t.getTile(c),l=t.adjacentBorders(c),u=()=>t.layout[t.height-1-s][o]=" ";if("wall"==h.type)rc(h.value,l,a,c);else if("exploding_wall"==h.type){const t=new oc({position:c,wall:h.value,faces:l},u);xc(n,t,o,s),e.add(t)}else if("door"==h.type){const t=new ac({color:h.value,position:c},u);xc(i,t,o,s,e=>e.color==t.color),e.add(t)}"wall"!=h.type&&r.merge(new ec(c))}const o=new tc({color:5592405,clampColor:!1,pixelate:0});e.add(new oe((new Gt).fromGeometry(r),o)),e.add(...nc(a))}(this,t),function(t,e){const i=Object.assign({},$s,yc,Zs,{ExplodingWall:oc});t.forEach(t=>{const n=new F(t.position[0],t.position[1],0),r=i[t.type];r?e.add(new r(t)):console.warn("class not found for",t.type,"at",n)})}(this.entities,t),t}}class wc extends Hs{constructor(t,e){super(t,2/3,5),this.persistedProps.push("direction","inventory"),t.direction&&(this.direction=t.direction),this.inventory||(this.inventory={}),this.game=e,this.audioListener=us,this.add(this.audioListener),this.camera=new Hi(45,0,.01,256),this.camera.rotation.set(0,Math.PI,0),this.add(this.camera),this.light=new ma(15031040,0,0),this.add(this.light),this.score=0,this.footstepIdx=0,this.footsteps=[];for(let t=0;t<2;t++)ps.load("sounds/adlib/footstep"+t+".wav",t=>{const e=new uo(us);e.setBuffer(t),e.setVolume(1/3),this.footsteps.push(e)});Fs.get("sprites/hand.png",t=>{const e=Bs(t,88,2);e.repeat.y=88/72,this.hand=new Ji(new Zi({map:e})),this.hand.setFrame=t=>{e.setFrame(t),this.light.intensity=t},this.hand.scale.divideScalar(20),this.hand.outPosition=new F(-.0032,-.0165,.1),this.hand.inPosition=new F(-.0032,-.033,.05),this.hand.position.copy(this.hand.inPosition),this.add(this.hand);["shoot","big_shoot"].forEach(t=>{const e="sounds/adlib/"+t+".wav";ps.load(e,e=>{const i=new po(us).setBuffer(e);this[t.replace("_","")+"Sound"]=i,this.hand.add(i)})})})}get direction(){return this.getWorldDirection(new F)}set direction(t){const e=(new F).copy(t),i=this.position.clone().add(e);this.lookAt(i)}onCollision(t,e){for(let e=t.object;e;e=e.parent){if(e.pickup)return this.pickupItem(e),!1;if(e instanceof ac)return!this.unlockDoor(e);if(e instanceof qs){const t=this.velocity.clone().normalize().multiplyScalar(2/3);return this.warpToPosition=e.destination.clone().add(t),!1}e instanceof Ys&&this.game.changeMap(e.value)}return!0}pickupItem(t){if(t instanceof vc)this.score+=t.value;else{const e=t.type.toLowerCase();void 0===this.inventory[e]&&(this.inventory[e]=0),this.inventory[e]+=1}t.pickup()}unlockDoor(t){const e=t.color+"key";return!!this.inventory[e]&&(t.unlock()&&(this.inventory[e]-=1),!0)}update(t,e){if(super.update(t,e),this.hand&&this.updateHand(t),this.velocity.lengthSq()&&!this.frozen){t-this.lastStep>7/3/this.speed&&(this.footsteps[this.footstepIdx].play(),this.footstepIdx=(this.footstepIdx+1)%this.footsteps.length,this.lastStep=t)}else this.lastStep=t}updateHand(t){const e=this.hand.position.clone().sub(this.hand.inPosition).length()/this.hand.outPosition.clone().sub(this.hand.inPosition).length();if(this.chargeStarted){const i=t-this.chargeStarted;this.hand.position.lerpVectors(this.hand.inPosition,this.hand.outPosition,Math.min(1,Math.max(e,2*i)));const n=Math.ceil(8*i)%2;this.hand.setFrame(n),this.light.distance=2.5*Math.min(1,i)}else if(this.lastFire){const i=t-this.lastFire;this.hand.position.lerpVectors(this.hand.outPosition,this.hand.inPosition,Math.min(1,Math.max(1-e,i-1)))}}binds(){return{ArrowUp:this.moveForward.bind(this),ArrowLeft:this.turnLeft.bind(this),ArrowDown:this.moveBackward.bind(this),ArrowRight:this.turnRight.bind(this),KeyW:this.moveForward.bind(this),KeyA:this.moveLeft.bind(this),KeyS:this.moveBackward.bind(this),KeyD:this.moveRight.bind(this),ShiftLeft:this.sprint.bind(this),Space:this.shoot.bind(this)}}updateMovement(){let t=0,e=0,i=0;this.movingForward&&(t+=1),this.movingBackward&&(t-=1),this.movingLeft&&(e+=1),this.movingRight&&(e-=1),this.turningLeft&&(i+=1),this.turningRight&&(i-=1),this.moveDirection.z=t,this.moveDirection.x=e,this.turnDirection=i,this.updateVelocity()}stopMovement(){this.movingForward=!1,this.movingBackward=!1,this.movingLeft=!1,this.movingRight=!1,this.turningLeft=!1,this.turningRight=!1,this.updateMovement()}moveForward(t){this.movingForward=t,this.updateMovement()}moveBackward(t){this.movingBackward=t,this.updateMovement()}moveLeft(t){this.movingLeft=t,this.updateMovement()}moveRight(t){this.movingRight=t,this.updateMovement()}sprint(t){this.speed*=t>0?2:.5,this.updateVelocity()}turnLeft(t){this.turningLeft=t,this.updateMovement()}turnRight(t){this.turningRight=t,this.updateMovement()}shoot(t){if(t&&!this.chargeStarted)this.chargeStarted=this.lastTime,this.hand.setFrame(1),this.light.distance=0;else if(this.chargeStarted){const t=this.lastTime-this.chargeStarted,e=this.getWorldDirection(new F),i=new Ws({type:"Fireball",position:this.position.clone().addScaledVector(e,2/3),direction:e,isBig:t>1});this.parent.add(i);const n=i.isBig?this.bigshootSound:this.shootSound;n.isPlaying&&n.stop(),n.play(),this.chargeStarted=0,this.lastFire=this.lastTime,this.hand.setFrame(0);const r=this.hand.position.clone().sub(this.hand.inPosition).length()/this.hand.outPosition.clone().sub(this.hand.inPosition).length();this.hand.position.lerpVectors(this.hand.inPosition,this.hand.outPosition,Math.min(1,r+.25))}}}class _c extends ie{constructor(t,e){super({vertexShader:"\n#define USE_MAP = 1;\n#include <uv_pars_vertex>\n\nvoid main() {\n\t#include <begin_vertex>\n\t#include <project_vertex>\n\t#include <uv_vertex>\n}",fragmentShader:"\nuniform float aspectRatio;\nuniform float pixelate;\nuniform sampler2D tex1;\nuniform sampler2D tex2;\nuniform float weight;\n\n#define USE_MAP = 1;\n#include <uv_pars_fragment>\n\nvec4 mod289(vec4 x)\n{\n  return x - floor(x * (1.0 / 289.0)) * 289.0;\n}\n\nvec4 permute(vec4 x)\n{\n  return mod289(((x*34.0)+1.0)*x);\n}\n\nvec4 taylorInvSqrt(vec4 r)\n{\n  return 1.79284291400159 - 0.85373472095314 * r;\n}\n\nvec2 fade(vec2 t) {\n  return t*t*t*(t*(t*6.0-15.0)+10.0);\n}\n\n// Classic Perlin noise\nfloat cnoise(vec2 P)\n{\n  vec4 Pi = floor(P.xyxy) + vec4(0.0, 0.0, 1.0, 1.0);\n  vec4 Pf = fract(P.xyxy) - vec4(0.0, 0.0, 1.0, 1.0);\n  Pi = mod289(Pi); // To avoid truncation effects in permutation\n  vec4 ix = Pi.xzxz;\n  vec4 iy = Pi.yyww;\n  vec4 fx = Pf.xzxz;\n  vec4 fy = Pf.yyww;\n\n  vec4 i = permute(permute(ix) + iy);\n\n  vec4 gx = fract(i * (1.0 / 41.0)) * 2.0 - 1.0 ;\n  vec4 gy = abs(gx) - 0.5 ;\n  vec4 tx = floor(gx + 0.5);\n  gx = gx - tx;\n\n  vec2 g00 = vec2(gx.x,gy.x);\n  vec2 g10 = vec2(gx.y,gy.y);\n  vec2 g01 = vec2(gx.z,gy.z);\n  vec2 g11 = vec2(gx.w,gy.w);\n\n  vec4 norm = taylorInvSqrt(vec4(dot(g00, g00), dot(g01, g01), dot(g10, g10), dot(g11, g11)));\n  g00 *= norm.x;  \n  g01 *= norm.y;  \n  g10 *= norm.z;  \n  g11 *= norm.w;  \n\n  float n00 = dot(g00, vec2(fx.x, fy.x));\n  float n10 = dot(g10, vec2(fx.y, fy.y));\n  float n01 = dot(g01, vec2(fx.z, fy.z));\n  float n11 = dot(g11, vec2(fx.w, fy.w));\n\n  vec2 fade_xy = fade(Pf.xy);\n  vec2 n_x = mix(vec2(n00, n01), vec2(n10, n11), fade_xy.x);\n  float n_xy = mix(n_x.x, n_x.y, fade_xy.y);\n  return 2.3 * n_xy;\n}\n\n// Classic Perlin noise, periodic variant\nfloat pnoise(vec2 P, vec2 rep)\n{\n  vec4 Pi = floor(P.xyxy) + vec4(0.0, 0.0, 1.0, 1.0);\n  vec4 Pf = fract(P.xyxy) - vec4(0.0, 0.0, 1.0, 1.0);\n  Pi = mod(Pi, rep.xyxy); // To create noise with explicit period\n  Pi = mod289(Pi);        // To avoid truncation effects in permutation\n  vec4 ix = Pi.xzxz;\n  vec4 iy = Pi.yyww;\n  vec4 fx = Pf.xzxz;\n  vec4 fy = Pf.yyww;\n\n  vec4 i = permute(permute(ix) + iy);\n\n  vec4 gx = fract(i * (1.0 / 41.0)) * 2.0 - 1.0 ;\n  vec4 gy = abs(gx) - 0.5 ;\n  vec4 tx = floor(gx + 0.5);\n  gx = gx - tx;\n\n  vec2 g00 = vec2(gx.x,gy.x);\n  vec2 g10 = vec2(gx.y,gy.y);\n  vec2 g01 = vec2(gx.z,gy.z);\n  vec2 g11 = vec2(gx.w,gy.w);\n\n  vec4 norm = taylorInvSqrt(vec4(dot(g00, g00), dot(g01, g01), dot(g10, g10), dot(g11, g11)));\n  g00 *= norm.x;  \n  g01 *= norm.y;  \n  g10 *= norm.z;  \n  g11 *= norm.w;  \n\n  float n00 = dot(g00, vec2(fx.x, fy.x));\n  float n10 = dot(g10, vec2(fx.y, fy.y));\n  float n01 = dot(g01, vec2(fx.z, fy.z));\n  float n11 = dot(g11, vec2(fx.w, fy.w));\n\n  vec2 fade_xy = fade(Pf.xy);\n  vec2 n_x = mix(vec2(n00, n01), vec2(n10, n11), fade_xy.x);\n  float n_xy = mix(n_x.x, n_x.y, fade_xy.y);\n  return 2.3 * n_xy;\n}\n\nvoid main() {\n\tvec4 color1 = texture2D(tex1, vUv);\n\tvec4 color2 = texture2D(tex2, vUv);\n\tvec2 pixel = vUv * vec2(pixelate * aspectRatio, pixelate);\n\tpixel = floor(pixel) + vec2(0.5);\n\tfloat noise = cnoise(pixel);\n\tnoise = step(1.0-2.0*weight, noise);\n\tgl_FragColor = mix(color1, color2, noise);\n}",uniforms:ot.merge([lt.common,{aspectRatio:{value:1},pixelate:{value:128},tex1:{value:t},tex2:{value:e},weight:{value:0}}])})}}class Mc{constructor(t,e,i,n){this.fbo1=i,this.fbo2=n,this.camera=new _t(-t/2,t/2,e/2,-e/2,-10,10),this.material=new _c(i.texture,n.texture),this.plane=new oe(new Wt(1,1),this.material),this.scene=new Yi,this.scene.add(this.camera,this.plane)}setMix(t){this.material.uniforms.weight.value=t}setSize(t,e){this.material.uniforms.aspectRatio.value=t/e,this.camera.left=-t/2,this.camera.right=t/2,this.camera.top=e/2,this.camera.bottom=-e/2,this.camera.updateProjectionMatrix(),this.plane.scale.set(t,e,1)}}F.prototype.copy=function(t){return"x"in t&&(this.x=t.x),"y"in t&&(this.y=t.y),"z"in t&&(this.z=t.z),this};class Ec{constructor(t){this.actor=t,this.touches={},this.touchOrigins={},document.addEventListener("touchstart",this.onTouchStart.bind(this)),document.addEventListener("touchmove",this.onTouchMove.bind(this)),document.addEventListener("touchend",this.onTouchEnd.bind(this))}partition(t){const e=[],i=[];for(let n of t)n.screenX<window.screen.width/2?e.push(n):i.push(n);return{left:e,right:i}}moveForward(){this.movingForward=!0,this.actor.moveForward(.5)}onTouchStart(t){this.touches=this.partition(t.touches),this.actor.shoot&&this.actor.shoot(this.touches.right.length),this.touches.left.length&&!this.movingForward&&(this.timeoutId=window.setTimeout(this.moveForward.bind(this),500));for(let e of t.changedTouches)this.touchOrigins[e.identifier]={x:e.screenX,y:e.screenY}}onTouchMove(t){if(this.touches=this.partition(t.touches),this.touches.left.length<1)return;let e=0;for(let t of this.touches.left){const i=this.touchOrigins[t.identifier].x-t.screenX;Math.abs(i)>Math.abs(e)&&(e=i)}let i=e/25;i=Math.min(i,1.5),Math.abs(i)<.5?i=0:this.movingForward||(window.clearTimeout(this.timeoutId),this.timeoutId=window.setTimeout(this.moveForward.bind(this),500)),this.actor.turnDirection=i}onTouchEnd(t){this.touches=this.partition(t.touches),this.actor.shoot&&this.actor.shoot(this.touches.right.length),this.touches.left.length<1&&(window.clearTimeout(this.timeoutId),this.timeoutId=void 0,this.movingForward&&(this.actor.moveForward(-.5),this.movingForward=!1),this.actor.turnDirection=0);for(let e of t.changedTouches)delete this.touchOrigins[e.identifier]}}return t.Game=class{constructor(t,e,i,n,r){this.name=t,this.container=e,this.hud=i,this.hudInventory=i.children.inventory,this.hudLocation=i.children.location,ls=r;const a=JSON.parse(localStorage.getItem(this.name)||"{}");this.fromSave="mapName"in a&&a.mapName==n,this.clock=new hs(a.gameTime),this.mapName=n||a.mapName,this.player=new wc(a.player||{type:"Player"},this),this.renderer=new Wi({antialias:!0}),this.renderer.physicallyCorrectLights=!0,e.appendChild(this.renderer.domElement),this.fbo1=new Q(window.innerWidth,window.innerHeight,{minFilter:v,magFilter:v,format:T,stencilBuffer:!1}),this.fbo2=new Q(window.innerWidth,window.innerHeight,{minFilter:v,magFilter:v,format:T,stencilBuffer:!1}),this.transition=new Mc(window.innerWidth,window.innerHeight,this.fbo1,this.fbo2)}onKey(t){const e=this.player.binds();return e.Enter=this.save.bind(this),i=>{const n=e[i.code];n&&!i.repeat&&n(t)}}onMouseButton(t){return e=>{this.player.shoot(t)}}onMouseMove(t){this.player.rotateY(-t.movementX/2e3),this.player.updateVelocity()}play(){this.clock.start(),this.isActive=!0,this.render()}pause(){this.clock.pause(),this.isActive=!1}render(){const t=this.clock.getElapsedTime(),e=[];this.scene.traverse(i=>{i.update&&i.update(t,this.scene),i.shouldRemove&&e.push(i)}),e.forEach(t=>t.parent.remove(t)),this.player.warpToPosition&&(this.transitionStart=t,this.renderer.render(this.scene,this.player.camera,this.fbo1,!0),this.player.position.copy(this.player.warpToPosition),this.player.warpToPosition=null);const i=t-this.transitionStart,n=2/3;i<n?(this.renderer.render(this.scene,this.player.camera,this.fbo2,!0),this.player.frozen=!0,this.transition.setMix(i/n),this.transition.material.uniforms.tex1.value=this.fbo1.texture,this.transition.material.uniforms.tex2.value=this.fbo2.texture,this.renderer.render(this.transition.scene,this.transition.camera)):(this.player.frozen=!1,this.renderer.render(this.scene,this.player.camera));const r=this.map.getTile(this.player.position);r&&"floor"==r.type?this.hudLocation.innerText=r.value||"":this.hudLocation.innerText="",this.isActive&&requestAnimationFrame(this.render.bind(this))}resizeView(t,e){[this.renderer,this.fbo1,this.fbo2,this.transition].forEach(i=>i.setSize(t,e));[this.player.camera].forEach(i=>{i.aspect=t/e,i.updateProjectionMatrix()})}changeMap(t){if(this.loading)return;this.renderer.render(this.scene,this.player.camera,this.fbo1,!0),this.player.frozen=!0,this.loading=!0;const e=this;this.loadMap(t).then(i=>{e.save(),e.scene.traverse(t=>t.dispose&&t.dispose()),e.transitionStart=e.clock.getElapsedTime(),e.mapName=t,e.map=i,e.scene=i.toScene(),e.scene.add(e.player),e.player.position.copy(i.playerStart.position),e.player.direction=i.playerStart.direction,e.huntPlayer(),e.playMusic(i.music),e.loading=!1})}playMusic(t){t!=this.musicFile&&(this.music&&this.music.isPlaying&&this.music.stop(),this.musicFile=t,t&&(this.music=this.music||new uo(us),ps.load(t,e=>{t==this.musicFile&&this.music.setBuffer(e).setLoop(!0).play()})))}huntPlayer(){this.scene.traverse(t=>{"hunt"in t&&t.hunt(this.player,this.scene)})}loadMap(t){const e=localStorage.getItem(t);if(e){const t=new bc(JSON.parse(e));return Promise.resolve(t)}return this.loadMapIndex().then(e=>{const i=e.maps.find(e=>e.name==t);return fetch("maps/"+(i?i.file:t+".map.json"))}).then(t=>t.json()).then(t=>new bc(t))}loadMapIndex(){return this.mapIndex||(this.mapIndex=fetch("maps/index.json").then(t=>t.json()).catch(()=>({maps:[]}))),this.mapIndex}getGlobalState(){return{date:new Date,gameTime:this.clock.getElapsedTime(),mapName:this.mapName,player:this.player.getState()}}getMapState(){const t=[];this.scene.traverse(e=>{if(e instanceof wc)return;const i=e.getState&&e.getState();i&&t.push(i)});const e=Object.assign({},this.map);return e.layout=e.layout.map(t=>t.join("")),e.entities=t,e}save(){localStorage.setItem(this.name,JSON.stringify(this.getGlobalState())),localStorage.setItem(this.mapName,JSON.stringify(this.getMapState()))}setup(){const t=this,e=[["keydown",this.onKey(!0)],["keyup",this.onKey(!1)],["mousedown",this.onMouseButton(!0)],["mouseup",this.onMouseButton(!1)],["mousemove",this.onMouseMove.bind(this)]];this.player.inventory=new Proxy(this.player.inventory,{set:function(e,i,n,r){return e[i]=n,t.drawInventory(e),!0}}),this.drawInventory(this.player.inventory),this.touchControls=new Ec(this.player),document.addEventListener("pointerlockchange",t=>{document.pointerLockElement===this.renderer.domElement?(e.forEach(([t,e])=>document.addEventListener(t,e)),this.container.classList.add("playing")):(e.forEach(([t,e])=>document.removeEventListener(t,e)),this.container.classList.remove("playing"),this.player.stopMovement())}),this.loadMap(this.mapName).then(e=>{t.map=e,t.scene=e.toScene(),t.scene.add(t.player),t.fromSave||(t.player.position.copy(e.playerStart.position),t.player.direction=e.playerStart.direction),t.huntPlayer(),t.playMusic(e.music),t.play()})}drawInventory(t){this.hudInventory.innerText=Object.entries(t).filter(([t,e])=>e).map(([t,e])=>`${t}: ${e}`).join("\n")}},t}({});
 //# sourceMappingURL=cat3d.js.map
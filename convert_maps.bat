@@ -1,20 +1 @@
-map2json.exe -indent=true extracted_assets\maps\1_The_Approach.c3dmap extracted_assets\text\1.dat > build\maps\Approach.map.json
-map2json.exe -indent=true extracted_assets\maps\2_Nemesis's_Keep.c3dmap extracted_assets\text\2.dat > build\maps\Nemesis's_Keep.map.json
-map2json.exe -indent=true extracted_assets\maps\3_Ground_Floor.c3dmap extracted_assets\text\3.dat > build\maps\Ground_Floor.map.json
-map2json.exe -indent=true extracted_assets\maps\4_Second_Floor.c3dmap extracted_assets\text\4.dat > build\maps\Second_Floor.map.json
-map2json.exe -indent=true extracted_assets\maps\5_Third_Floor.c3dmap extracted_assets\text\5.dat > build\maps\Third_Floor.map.json
-map2json.exe -indent=true extracted_assets\maps\6_Tower_One.c3dmap extracted_assets\text\6.dat > build\maps\Tower_One.map.json
-map2json.exe -indent=true extracted_assets\maps\7_Tower_Two.c3dmap extracted_assets\text\7.dat > build\maps\Tower_Two.map.json
-map2json.exe -indent=true extracted_assets\maps\8_Secret_Halls.c3dmap extracted_assets\text\8.dat > build\maps\Secret_Halls.map.json
-map2json.exe -indent=true extracted_assets\maps\9_Access_Floor.c3dmap extracted_assets\text\9.dat > build\maps\Access_Floor.map.json
-map2json.exe -indent=true extracted_assets\maps\10_The_Dungeon.c3dmap extracted_assets\text\10.dat > build\maps\Dungeon.map.json
-map2json.exe -indent=true extracted_assets\maps\11_Lower_Dungeon.c3dmap extracted_assets\text\11.dat > build\maps\Lower_Dungeon.map.json
-map2json.exe -indent=true extracted_assets\maps\12_Catacomb.c3dmap extracted_assets\text\12.dat > build\maps\Catacomb.map.json
-map2json.exe -indent=true extracted_assets\maps\13_Lower_Reaches.c3dmap extracted_assets\text\13.dat > build\maps\Lower_Reaches.map.json
-map2json.exe -indent=true extracted_assets\maps\14_The_Warrens.c3dmap extracted_assets\text\14.dat > build\maps\Warrens.map.json
-map2json.exe -indent=true extracted_assets\maps\15_Hidden_Caverns.c3dmap extracted_assets\text\15.dat > build\maps\Hidden_Caverns.map.json
-map2json.exe -indent=true extracted_assets\maps\16_The_Fens_of_Insanity.c3dmap extracted_assets\text\16.dat > build\maps\Fens_of_Insanity.map.json
-map2json.exe -indent=true extracted_assets\maps\17_Chaos_Corridors.c3dmap extracted_assets\text\17.dat > build\maps\Chaos_Corridors.map.json
-map2json.exe -indent=true extracted_assets\maps\18_The_Labyrinth.c3dmap extracted_assets\text\18.dat > build\maps\Labyrinth.map.json
-map2json.exe -indent=true extracted_assets\maps\19_Halls_of_Blood.c3dmap extracted_assets\text\19.dat > build\maps\Halls_of_Blood.map.json
-map2json.exe -indent=true extracted_assets\maps\20_Nemesis's_Lair.c3dmap extracted_assets\text\20.dat > build\maps\Nemesis's_Lair.map.json
+map2json.exe batch -v1 -indent -o build\maps extracted_assets\maps
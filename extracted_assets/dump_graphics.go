@@ -180,6 +180,10 @@ func (d Dimensions) String() string {
 	return fmt.Sprintf("%vx%v", d.Width, d.Height)
 }
 
+// StartPics is the chunk of the first picture in the picture table. Chunks
+// 0 to 2 hold the tables and 3 and 4 the fonts.
+const StartPics = 5
+
 type PictureTable []Dimensions
 
 func (g *Graphics) PictureTable() (PictureTable, error) {
@@ -203,7 +207,10 @@ func (g *Graphics) Picture(i int) (*Picture, error) {
 	if err != nil {
 		return nil, err
 	}
-	dims := picTable[i-4]
+	if i < StartPics || i-StartPics >= len(picTable) {
+		return nil, fmt.Errorf("chunk %v is not a picture", i)
+	}
+	dims := picTable[i-StartPics]
 	chunk, err := g.Chunk(i)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// GameString is a NUL terminated string from the executable's data segment.
+type GameString struct {
+	ID     string `json:"id"`
+	Offset int    `json:"offset"` // from the start of the data segment
+	Text   string `json:"text"`
+}
+
+type StringTable struct {
+	Version string       `json:"version"`
+	Strings []GameString `json:"strings"`
+}
+
+// StringIDs are the strings the HUD uses. Their IDs stay the same across
+// releases whatever the wording, so every release in Releases has to give
+// an offset for each of them.
+var StringIDs = []string{
+	// pickup notices
+	"pickup_bolt",
+	"pickup_nuke",
+	"pickup_potion",
+	"pickup_key_red",
+	"pickup_key_yellow",
+	"pickup_key_green",
+	"pickup_key_blue",
+	"pickup_scroll",
+	"pickup_treasure",
+	// shown when a door is locked and there's no key for it
+	"no_way",
+	// menu labels
+	"menu_new_game",
+	"menu_load_game",
+	"menu_save_game",
+	"menu_sound",
+	"menu_controls",
+	"menu_quit",
+	// game over
+	"game_over",
+}
+
+// Release is a version of the executable, recognised by the SHA-256 of the
+// unpacked file, with where each of StringIDs is in its data segment.
+type Release struct {
+	Version string
+	SHA256  string
+	Offsets map[string]int
+}
+
+// Releases are the versions whose strings have been found. To add one,
+// unpack its executable, run this with -scan to list the strings in it, and
+// copy the offset of each of StringIDs from the list. None have been added
+// yet, since no copy of the executable is kept with this repository, so for
+// now every executable falls back to GuessStrings.
+var Releases = []Release{}
+
+// Borland's startup code puts its copyright notice at the start of the data
+// segment (DGROUP), right after a four byte NULL check area.
+var borlandCopyright = regexp.MustCompile(`(Turbo-C|Turbo C\+\+|Borland C\+\+) - Copyright [^\x00]*Borland`)
+
+var versionPattern = regexp.MustCompile(`(?i)\b(?:version |v)(\d+\.\d+)\b`)
+
+// DataSegment returns the data segment of an unpacked DOS executable.
+func DataSegment(exe []byte) ([]byte, error) {
+	if len(exe) < 0x20 || string(exe[:2]) != "MZ" {
+		return nil, fmt.Errorf("not a DOS executable")
+	}
+	sig := string(exe[0x1C:0x20])
+	if sig == "LZ09" || sig == "LZ91" {
+		return nil, fmt.Errorf("executable is compressed with LZEXE; unpack it with UNLZEXE first")
+	}
+	headerSize := int(binary.LittleEndian.Uint16(exe[8:])) * 16
+	image := exe[headerSize:]
+	loc := borlandCopyright.FindIndex(image)
+	if loc == nil {
+		return nil, fmt.Errorf("no Borland copyright notice to locate the data segment by")
+	}
+	start := loc[0] - 4
+	if start < 0 || !bytes.Equal(image[start:loc[0]], []byte{0, 0, 0, 0}) {
+		return nil, fmt.Errorf("copyright notice at %#x is not at the start of a data segment", headerSize+loc[0])
+	}
+	return image[start:], nil
+}
+
+func printable(c byte) bool {
+	return c >= 0x20 && c < 0x7F || c == '\n' || c == '\r' || c == '\t'
+}
+
+// StringAt returns the NUL terminated string at offset, which must follow a
+// NUL and be all printable.
+func StringAt(data []byte, offset int) (string, error) {
+	if offset <= 0 || offset >= len(data) || data[offset-1] != 0 {
+		return "", fmt.Errorf("no string starts at %#x", offset)
+	}
+	end := bytes.IndexByte(data[offset:], 0)
+	if end <= 0 {
+		return "", fmt.Errorf("no string starts at %#x", offset)
+	}
+	for _, c := range data[offset : offset+end] {
+		if !printable(c) {
+			return "", fmt.Errorf("the string at %#x has unprintable byte %#x", offset, c)
+		}
+	}
+	return strings.Replace(string(data[offset:offset+end]), "\r", "", -1), nil
+}
+
+// Strings returns each run of at least min printable characters that is
+// terminated by a NUL and starts right after one, for finding the offsets
+// of a new release.
+func Strings(data []byte, min int) (strs []GameString) {
+	start := 0
+	for i, c := range data {
+		if c == 0 {
+			if i-start >= min {
+				text := strings.Replace(string(data[start:i]), "\r", "", -1)
+				strs = append(strs, GameString{Offset: start, Text: text})
+			}
+			start = i + 1
+		} else if !printable(c) {
+			start = len(data) // poison the run until the next NUL
+		}
+	}
+	return
+}
+
+// FindRelease returns the release an executable is, or an error if it isn't
+// one whose strings have been found.
+func FindRelease(exe []byte) (Release, error) {
+	sum := sha256.Sum256(exe)
+	hash := hex.EncodeToString(sum[:])
+	for _, r := range Releases {
+		if r.SHA256 == hash {
+			return r, nil
+		}
+	}
+	return Release{}, fmt.Errorf("unknown executable with SHA-256 %v; add it to Releases", hash)
+}
+
+var nonWord = regexp.MustCompile(`[^a-z0-9]+`)
+
+// AssignIDs names each string after its first few words. Repeated names get
+// a numeric suffix in the order they appear.
+func AssignIDs(strs []GameString) {
+	seen := make(map[string]int)
+	for i := range strs {
+		words := strings.Fields(nonWord.ReplaceAllString(strings.ToLower(strs[i].Text), " "))
+		if len(words) > 5 {
+			words = words[:5]
+		}
+		id := strings.Join(words, "_")
+		if id == "" {
+			id = "str"
+		}
+		seen[id]++
+		if seen[id] > 1 {
+			id = fmt.Sprintf("%v_%v", id, seen[id])
+		}
+		strs[i].ID = id
+	}
+}
+
+func DetectVersion(strs []GameString) string {
+	for _, s := range strs {
+		if m := versionPattern.FindStringSubmatch(s.Text); m != nil {
+			return m[1]
+		}
+	}
+	return "unknown"
+}
+
+// GuessStrings reads the strings of an executable that isn't in Releases:
+// every string in its data segment, named after its first words, with the
+// version from the first string that gives one. The IDs aren't the same as
+// StringIDs and change with the wording.
+func GuessStrings(dgroup []byte, min int) StringTable {
+	table := StringTable{Strings: Strings(dgroup, min)}
+	AssignIDs(table.Strings)
+	table.Version = DetectVersion(table.Strings)
+	return table
+}
+
+// ReadStrings reads the strings of a known release from its data segment.
+func ReadStrings(r Release, dgroup []byte) (StringTable, error) {
+	table := StringTable{Version: r.Version}
+	for _, id := range StringIDs {
+		offset, ok := r.Offsets[id]
+		if !ok {
+			return table, fmt.Errorf("version %v has no offset for %v", r.Version, id)
+		}
+		text, err := StringAt(dgroup, offset)
+		if err != nil {
+			return table, fmt.Errorf("version %v, %v: %v", r.Version, id, err)
+		}
+		table.Strings = append(table.Strings, GameString{ID: id, Offset: offset, Text: text})
+	}
+	return table, nil
+}
+
+func main() {
+	scan := flag.Bool("scan", false, "list every string in the data segment with its offset, to add a release with")
+	min := flag.Int("min", 4, "minimum string length for -scan and executables not in Releases")
+	version := flag.String("version", "", "game version to record instead of the release's or the detected one")
+	output := flag.String("o", "", "output file when dumping a single executable (default strings-<version>.json)")
+	flag.Parse()
+	filenames := flag.Args()
+	if len(filenames) == 0 {
+		filenames = []string{"cat3d.exe"}
+	}
+	if len(filenames) > 1 && (*output != "" || *version != "") {
+		panic("-o and -version only apply to a single executable")
+	}
+
+	// Each release gets its own table since string offsets and wording differ
+	for _, filename := range filenames {
+		exe, err := ioutil.ReadFile(filename)
+		if err != nil {
+			panic(err)
+		}
+		dgroup, err := DataSegment(exe)
+		if err != nil {
+			panic(fmt.Errorf("%v: %v", filename, err))
+		}
+		if *scan {
+			for _, s := range Strings(dgroup, *min) {
+				fmt.Printf("%#x\t%q\n", s.Offset, s.Text)
+			}
+			continue
+		}
+
+		var table StringTable
+		if release, err := FindRelease(exe); err == nil {
+			if table, err = ReadStrings(release, dgroup); err != nil {
+				panic(fmt.Errorf("%v: %v", filename, err))
+			}
+		} else {
+			fmt.Fprintf(os.Stderr, "%v: %v; naming every string after its first words instead\n", filename, err)
+			table = GuessStrings(dgroup, *min)
+		}
+		if *version != "" {
+			table.Version = *version
+		}
+		outfile := *output
+		if outfile == "" {
+			outfile = fmt.Sprintf("strings-%v.json", table.Version)
+		}
+
+		out, err := json.MarshalIndent(table, "", "\t")
+		if err != nil {
+			panic(err)
+		}
+		err = ioutil.WriteFile(outfile, append(out, '\n'), 0666)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%v: %v strings from version %v written to %v\n", filename, len(table.Strings), table.Version, outfile)
+	}
+}
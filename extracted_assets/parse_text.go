@@ -0,0 +1,510 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type BitReader struct {
+	io.ByteReader
+	byte, mask byte
+}
+
+func NewBitReader(b []byte) *BitReader {
+	return &BitReader{bytes.NewReader(b), 0, 0}
+}
+
+func (r *BitReader) ReadBit() (bool, error) {
+	if r.mask == 0 {
+		byte, err := r.ReadByte()
+		if err != nil {
+			return false, err
+		}
+		r.byte = byte
+		r.mask = 0x01
+	}
+	bit := (r.byte & r.mask) != 0
+	r.mask = r.mask << 1
+	return bit, nil
+}
+
+type HuffNode struct {
+	Bit0, Bit1 uint16
+}
+
+func (n HuffNode) Value(bit bool) (val byte, leaf bool) {
+	side := n.Bit0
+	if bit {
+		side = n.Bit1
+	}
+	return byte(side), side < 256
+}
+
+type HuffTable [256]HuffNode
+
+func (t *HuffTable) root() HuffNode {
+	return t[254]
+}
+
+func (t *HuffTable) Expand(dst, src []byte) error {
+	r := NewBitReader(src)
+	for i := 0; i < len(dst); i++ {
+		node := t.root()
+		for {
+			bit, err := r.ReadBit()
+			if err != nil {
+				if err == io.EOF {
+					err = fmt.Errorf("EOF on decompressed byte %v of %v from %v compressed bytes", i, len(dst), len(src))
+				}
+				return err
+			}
+			val, leaf := node.Value(bit)
+			if leaf {
+				dst[i] = val
+				break
+			} else {
+				node = t[val]
+			}
+		}
+	}
+	return nil
+}
+
+type HeaderOffset [3]byte
+
+func (b HeaderOffset) Valid() bool {
+	return b[0] != 0xff || b[1] != 0xff || b[2] != 0xff
+}
+
+func (b HeaderOffset) Value() int {
+	var offset int
+	offset += int(b[2]) << 16
+	offset += int(b[1]) << 8
+	offset += int(b[0])
+	return offset
+}
+
+type Header [479]HeaderOffset
+
+func (h Header) ChunkLen(i int) int {
+	if !h[i].Valid() {
+		return -1
+	}
+	for k := i + 1; k < len(h); k++ {
+		if h[k].Valid() {
+			return h[k].Value() - h[i].Value()
+		}
+	}
+	return -1
+}
+
+type Asset struct {
+	data      []byte
+	header    Header
+	hufftable HuffTable
+
+	cache map[int][]byte
+}
+
+func OpenAsset(data, header, dictionary string) (*Asset, error) {
+	var a Asset
+	read := func(to interface{}, from_file string) error {
+		f, err := os.Open(from_file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return binary.Read(f, binary.LittleEndian, to)
+	}
+	err := read(&a.header, header)
+	if err != nil {
+		return nil, err
+	}
+	err = read(&a.hufftable, dictionary)
+	if err != nil {
+		return nil, err
+	}
+	a.data = make([]byte, a.header[len(a.header)-1].Value()) // last value in header is data size
+	err = read(a.data, data)
+	if err != nil {
+		return nil, err
+	}
+	a.cache = make(map[int][]byte)
+	return &a, nil
+}
+
+func (a *Asset) Chunk(i int) ([]byte, error) {
+	if data, ok := a.cache[i]; ok {
+		return data, nil
+	}
+	if !a.header[i].Valid() {
+		return nil, nil
+	}
+
+	// c_ denotes compressed, d_ denotes decompressed
+	offset := a.header[i].Value()
+	c_size := a.header.ChunkLen(i)
+	d_size := binary.LittleEndian.Uint32(a.data[offset : offset+4])
+
+	c_data := a.data[offset+4 : offset+c_size]
+	d_data := make([]byte, d_size)
+	err := a.hufftable.Expand(d_data, c_data)
+	if err != nil {
+		return nil, err
+	}
+	a.cache[i] = d_data
+	return d_data, nil
+}
+
+// CP437 maps the IBM PC character set to Unicode. The control range is
+// mapped to the glyphs the video hardware draws for it, since text screens
+// use them as graphics.
+var CP437 = [256]rune{
+	' ', '☺', '☻', '♥', '♦', '♣', '♠', '•', '◘', '○', '◙', '♂', '♀', '♪', '♫', '☼',
+	'►', '◄', '↕', '‼', '¶', '§', '▬', '↨', '↑', '↓', '→', '←', '∟', '↔', '▲', '▼',
+	' ', '!', '"', '#', '$', '%', '&', '\'', '(', ')', '*', '+', ',', '-', '.', '/',
+	'0', '1', '2', '3', '4', '5', '6', '7', '8', '9', ':', ';', '<', '=', '>', '?',
+	'@', 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O',
+	'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z', '[', '\\', ']', '^', '_',
+	'`', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o',
+	'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', '{', '|', '}', '~', '⌂',
+	'Ç', 'ü', 'é', 'â', 'ä', 'à', 'å', 'ç', 'ê', 'ë', 'è', 'ï', 'î', 'ì', 'Ä', 'Å',
+	'É', 'æ', 'Æ', 'ô', 'ö', 'ò', 'û', 'ù', 'ÿ', 'Ö', 'Ü', '¢', '£', '¥', '₧', 'ƒ',
+	'á', 'í', 'ó', 'ú', 'ñ', 'Ñ', 'ª', 'º', '¿', '⌐', '¬', '½', '¼', '¡', '«', '»',
+	'░', '▒', '▓', '│', '┤', '╡', '╢', '╖', '╕', '╣', '║', '╗', '╝', '╜', '╛', '┐',
+	'└', '┴', '┬', '├', '─', '┼', '╞', '╟', '╚', '╔', '╩', '╦', '╠', '═', '╬', '╧',
+	'╨', '╤', '╥', '╙', '╘', '╒', '╓', '╫', '╪', '┘', '┌', '▀', '▄', '█', '▌', '▐',
+	'α', 'ß', 'Γ', 'π', 'Σ', 'σ', 'µ', 'τ', 'Φ', 'Θ', 'Ω', 'δ', '∞', 'φ', 'ε', '∩',
+	'≡', '±', '≥', '≤', '⌠', '⌡', '÷', '≈', '°', '∙', '·', '√', 'ⁿ', '²', '■', '\u00A0',
+}
+
+// DecodeCP437 converts IBM PC text to a UTF-8 string. Line feeds and tabs
+// are kept as they are.
+func DecodeCP437(b []byte) string {
+	var sb strings.Builder
+	for _, c := range b {
+		if c == '\n' || c == '\t' {
+			sb.WriteByte(c)
+		} else {
+			sb.WriteRune(CP437[c])
+		}
+	}
+	return sb.String()
+}
+
+// Element is a piece of a text page: either a run of text or one of the
+// engine's caret commands.
+type Element struct {
+	Type    string `json:"type"`
+	Text    string `json:"text,omitempty"`
+	Color   *int   `json:"color,omitempty"`
+	X       *int   `json:"x,omitempty"`
+	Y       *int   `json:"y,omitempty"`
+	Width   *int   `json:"width,omitempty"`
+	Height  *int   `json:"height,omitempty"`
+	Picture *int   `json:"picture,omitempty"`
+	Delay   *int   `json:"delay,omitempty"`
+}
+
+type Page struct {
+	Elements []Element `json:"elements"`
+}
+
+// TextChunk is the structured form of an EGAGRAPH text chunk. Level text is
+// a list of lines (floor descriptions), caret-coded text is split into
+// pages, and text mode screen dumps are decoded to their 25 rows.
+type TextChunk struct {
+	Chunk int      `json:"chunk,omitempty"`
+	Kind  string   `json:"kind"`
+	Lines []string `json:"lines,omitempty"`
+	Pages []Page   `json:"pages,omitempty"`
+}
+
+const ScreenWidth = 80
+const ScreenHeight = 25
+const ScreenSize = ScreenWidth * ScreenHeight * 2
+
+// BloadMagic starts the 7 byte header BASIC's BSAVE puts in front of screen
+// dumps. Such files may also carry a trailing DOS EOF marker.
+const BloadMagic = 0xFD
+
+func ParseText(data []byte) (*TextChunk, error) {
+	if len(data) >= ScreenSize+7 && data[0] == BloadMagic {
+		return parseScreen(data[7:]), nil
+	}
+	if len(data) == ScreenSize {
+		return parseScreen(data), nil
+	}
+	if bytes.Contains(data, []byte("^P")) {
+		pages, err := parsePages(data)
+		if err != nil {
+			return nil, err
+		}
+		return &TextChunk{Kind: "pages", Pages: pages}, nil
+	}
+	return &TextChunk{Kind: "lines", Lines: splitLines(data)}, nil
+}
+
+// splitLines returns the lines of a chunk with DOS line endings removed.
+// Text ends at a NUL or ^E if either is present.
+func splitLines(data []byte) []string {
+	if end := bytes.IndexByte(data, 0); end >= 0 {
+		data = data[:end]
+	}
+	if end := bytes.Index(data, []byte("^E")); end >= 0 {
+		data = data[:end]
+	}
+	data = bytes.Replace(data, []byte("\r"), nil, -1)
+	data = bytes.TrimSuffix(data, []byte("\n"))
+	var lines []string
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		lines = append(lines, DecodeCP437(line))
+	}
+	return lines
+}
+
+func parseScreen(data []byte) *TextChunk {
+	chunk := &TextChunk{Kind: "screen"}
+	row := make([]rune, ScreenWidth)
+	for y := 0; y < ScreenHeight; y++ {
+		for x := range row {
+			row[x] = CP437[data[(y*ScreenWidth+x)*2]] // odd bytes are colour attributes
+		}
+		line := strings.TrimRight(string(row), " ")
+		chunk.Lines = append(chunk.Lines, line)
+	}
+	return chunk
+}
+
+// caretArgs is the number of comma separated numbers each caret command takes.
+var caretArgs = map[byte]int{
+	'G': 3, // y, x, picture
+	'T': 4, // y, x, picture, delay
+	'L': 2, // y, x
+	'B': 5, // y, x, width, height, color
+}
+
+func parsePages(data []byte) (pages []Page, err error) {
+	data = bytes.Replace(data, []byte("\r"), nil, -1)
+	var page *Page
+	var text []byte
+	flush := func() {
+		if len(text) > 0 && page != nil {
+			page.Elements = append(page.Elements, Element{Type: "text", Text: DecodeCP437(text)})
+		}
+		text = nil
+	}
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if c == 0 {
+			break
+		}
+		if c != '^' || i+1 >= len(data) {
+			text = append(text, c)
+			continue
+		}
+		cmd := data[i+1]
+		i += 2
+		if cmd == 'E' {
+			break
+		}
+		flush()
+		if cmd == 'P' {
+			pages = append(pages, Page{})
+			page = &pages[len(pages)-1]
+			i = skipLine(data, i) - 1
+			continue
+		}
+		if page == nil {
+			return nil, fmt.Errorf("^%c at byte %v comes before the first ^P", cmd, i-2)
+		}
+		var elem Element
+		switch cmd {
+		case 'C':
+			if i >= len(data) {
+				return nil, fmt.Errorf("^C at byte %v is missing its colour", i-2)
+			}
+			color, err := strconv.ParseInt(string(data[i]), 16, 0)
+			if err != nil {
+				return nil, fmt.Errorf("^C at byte %v: %v", i-2, err)
+			}
+			col := int(color)
+			elem = Element{Type: "color", Color: &col}
+			i++
+		case '>':
+			elem = Element{Type: "center"}
+		case ';':
+			i = skipLine(data, i)
+		case 'G', 'T', 'L', 'B':
+			var args []int
+			args, i, err = readCaretArgs(data, i, caretArgs[cmd])
+			if err != nil {
+				return nil, fmt.Errorf("^%c at byte %v: %v", cmd, i-2, err)
+			}
+			i = skipLine(data, i)
+			switch cmd {
+			case 'G':
+				elem = Element{Type: "graphic", Y: &args[0], X: &args[1], Picture: &args[2]}
+			case 'T':
+				elem = Element{Type: "timed_graphic", Y: &args[0], X: &args[1], Picture: &args[2], Delay: &args[3]}
+			case 'L':
+				elem = Element{Type: "locate", Y: &args[0], X: &args[1]}
+			case 'B':
+				elem = Element{Type: "bar", Y: &args[0], X: &args[1], Width: &args[2], Height: &args[3], Color: &args[4]}
+			}
+		default:
+			return nil, fmt.Errorf("unknown caret command ^%c at byte %v", cmd, i-2)
+		}
+		if elem.Type != "" {
+			page.Elements = append(page.Elements, elem)
+		}
+		i--
+	}
+	flush()
+	return pages, nil
+}
+
+// readCaretArgs reads count comma separated decimal numbers starting at i.
+func readCaretArgs(data []byte, i, count int) (args []int, next int, err error) {
+	for len(args) < count {
+		start := i
+		for i < len(data) && data[i] >= '0' && data[i] <= '9' {
+			i++
+		}
+		n, err := strconv.Atoi(string(data[start:i]))
+		if err != nil {
+			return nil, i, fmt.Errorf("argument %v: %v", len(args)+1, err)
+		}
+		args = append(args, n)
+		if len(args) < count {
+			if i >= len(data) || data[i] != ',' {
+				return nil, i, fmt.Errorf("expected %v arguments", count)
+			}
+			i++
+		}
+	}
+	return args, i, nil
+}
+
+// skipLine returns the index after the next newline. Commands that take
+// arguments own the rest of their line.
+func skipLine(data []byte, i int) int {
+	if end := bytes.IndexByte(data[i:], '\n'); end >= 0 {
+		return i + end + 1
+	}
+	return len(data)
+}
+
+func (t *TextChunk) Markdown() string {
+	var sb strings.Builder
+	if t.Chunk != 0 {
+		fmt.Fprintf(&sb, "# Chunk %v\n\n", t.Chunk)
+	}
+	switch t.Kind {
+	case "screen":
+		sb.WriteString("```\n")
+		sb.WriteString(strings.Join(t.Lines, "\n"))
+		sb.WriteString("\n```\n")
+	case "lines":
+		for _, line := range t.Lines {
+			if line != "" {
+				fmt.Fprintf(&sb, "- %v\n", line)
+			}
+		}
+	case "pages":
+		for i, page := range t.Pages {
+			if i > 0 {
+				sb.WriteString("\n---\n\n")
+			}
+			centered := false
+			for _, elem := range page.Elements {
+				switch elem.Type {
+				case "text":
+					text := elem.Text
+					if centered {
+						// Centring only applies to the line it starts
+						lines := strings.SplitN(text, "\n", 2)
+						text = "<center>" + strings.TrimSpace(lines[0]) + "</center>\n"
+						if len(lines) > 1 {
+							text += lines[1]
+						}
+						centered = false
+					}
+					sb.WriteString(text)
+				case "center":
+					centered = true
+				case "graphic", "timed_graphic":
+					fmt.Fprintf(&sb, "![picture %v](pictures/%v.png)\n", *elem.Picture, *elem.Picture)
+				}
+			}
+		}
+	}
+	return sb.String()
+}
+
+func main() {
+	start := flag.Int("start", 456, "first EGAGRAPH chunk to parse")
+	end := flag.Int("end", 476, "last EGAGRAPH chunk to parse")
+	outdir := flag.String("o", "text", "output directory")
+	markdown := flag.Bool("markdown", false, "also write Markdown")
+	flag.Parse()
+
+	chunks := make(map[string][]byte)
+	if flag.NArg() > 0 {
+		// Parse previously dumped chunks instead of EGAGRAPH
+		for _, name := range flag.Args() {
+			data, err := ioutil.ReadFile(name)
+			if err != nil {
+				panic(err)
+			}
+			chunks[strings.TrimSuffix(filepath.Base(name), filepath.Ext(name))] = data
+		}
+	} else {
+		asset, err := OpenAsset("EGAGRAPH.C3D", "EGAHEAD.C3D", "EGADICT.C3D")
+		if err != nil {
+			panic(err)
+		}
+		for i := *start; i <= *end; i++ {
+			data, err := asset.Chunk(i)
+			if err != nil {
+				panic(fmt.Errorf("chunk %v: %v", i, err))
+			}
+			chunks[strconv.Itoa(i)] = data
+		}
+	}
+
+	if err := os.MkdirAll(*outdir, 0755); err != nil {
+		panic(err)
+	}
+	for name, data := range chunks {
+		text, err := ParseText(data)
+		if err != nil {
+			panic(fmt.Errorf("%v: %v", name, err))
+		}
+		text.Chunk, _ = strconv.Atoi(name)
+		out, err := json.MarshalIndent(text, "", "\t")
+		if err != nil {
+			panic(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(*outdir, name+".json"), append(out, '\n'), 0666)
+		if err != nil {
+			panic(err)
+		}
+		if *markdown {
+			err = ioutil.WriteFile(filepath.Join(*outdir, name+".md"), []byte(text.Markdown()), 0666)
+			if err != nil {
+				panic(err)
+			}
+		}
+		fmt.Println(name, text.Kind)
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CurrentVersion is the map format written unless version 1 is requested.
+//
+// Version 1 spells each row of the layout as a string with one letter per
+// tile and keys the legend by letter, which is what src/map.js reads. It can
+// only tell 52 kinds of tile apart (A-Z, a-z). Version 2 writes the layout
+// as rows of legend indices and the legend as an array, so it has no limit.
+const CurrentVersion = 2
+
+// legendLetters are the symbols used for legend entries in version 1.
+const legendLetters = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// jsonMapV1 mirrors JsonMap with the version 1 layout and legend types.
+type jsonMapV1 struct {
+	Title       string               `json:"title"`
+	LevelNumber int                  `json:"levelNumber"`
+	Width       uint                 `json:"width"`
+	Height      uint                 `json:"height"`
+	Layout      []string             `json:"layout"`
+	Legend      map[string]LayoutDef `json:"legend"`
+	PlayerStart Entity               `json:"playerStart"`
+	Entities    []Entity             `json:"entities"`
+	Fog         *Fog                 `json:"fog,omitempty"`
+
+	AmbientLight *uint32 `json:"ambientLight,omitempty"`
+	Music        string  `json:"music,omitempty"`
+
+	Regions []Region `json:"regions,omitempty"`
+
+	RandomizerSeed *int64 `json:"randomizerSeed,omitempty"`
+}
+
+// jsonMapFields has JsonMap's fields without its JSON methods.
+type jsonMapFields JsonMap
+
+func (m JsonMap) MarshalJSON() ([]byte, error) {
+	switch m.Version {
+	case 1:
+		v1, err := m.toV1()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(v1)
+	case 0, CurrentVersion:
+		m.Version = CurrentVersion
+		return json.Marshal(jsonMapFields(m))
+	}
+	return nil, fmt.Errorf("unsupported map format version %v", m.Version)
+}
+
+func (m *JsonMap) UnmarshalJSON(data []byte) error {
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+	switch header.Version {
+	case 0, 1:
+		var v1 jsonMapV1
+		if err := json.Unmarshal(data, &v1); err != nil {
+			return err
+		}
+		return m.fromV1(&v1)
+	case CurrentVersion:
+		return json.Unmarshal(data, (*jsonMapFields)(m))
+	}
+	return fmt.Errorf("unsupported map format version %v", header.Version)
+}
+
+func (m *JsonMap) toV1() (*jsonMapV1, error) {
+	if len(m.Legend) > len(legendLetters) {
+		return nil, fmt.Errorf("level %v has %v kinds of tile but format version 1 can only represent %v", m.LevelNumber, len(m.Legend), len(legendLetters))
+	}
+	v1 := &jsonMapV1{
+		Title:       m.Title,
+		LevelNumber: m.LevelNumber,
+		Width:       m.Width,
+		Height:      m.Height,
+		Layout:      make([]string, len(m.Layout)),
+		Legend:      make(map[string]LayoutDef),
+		PlayerStart: m.PlayerStart,
+		Entities:    m.Entities,
+		Fog:         m.Fog,
+
+		AmbientLight: m.AmbientLight,
+		Music:        m.Music,
+
+		Regions: m.Regions,
+
+		RandomizerSeed: m.RandomizerSeed,
+	}
+	for i, def := range m.Legend {
+		v1.Legend[legendLetters[i:i+1]] = def
+	}
+	for h, row := range m.Layout {
+		line := make([]byte, len(row))
+		for w, kind := range row {
+			if kind < 0 || kind >= len(m.Legend) {
+				return nil, fmt.Errorf("tile %v of row %v has no legend entry %v", w, h, kind)
+			}
+			line[w] = legendLetters[kind]
+		}
+		v1.Layout[h] = string(line)
+	}
+	return v1, nil
+}
+
+func (m *JsonMap) fromV1(v1 *jsonMapV1) error {
+	*m = JsonMap{
+		Version:     1,
+		Title:       v1.Title,
+		LevelNumber: v1.LevelNumber,
+		Width:       v1.Width,
+		Height:      v1.Height,
+		Layout:      make([][]int, len(v1.Layout)),
+		PlayerStart: v1.PlayerStart,
+		Entities:    v1.Entities,
+		Fog:         v1.Fog,
+
+		AmbientLight: v1.AmbientLight,
+		Music:        v1.Music,
+
+		Regions: v1.Regions,
+
+		RandomizerSeed: v1.RandomizerSeed,
+	}
+	kinds := make(map[rune]int)
+	for h, line := range v1.Layout {
+		for _, symbol := range line {
+			kind, ok := kinds[symbol]
+			if !ok {
+				def, exists := v1.Legend[string(symbol)]
+				if !exists && symbol == ' ' {
+					def = LayoutDef{Type: "floor"} // src/map.js treats space as bare floor
+				} else if !exists {
+					return fmt.Errorf("layout row %v uses %q, which is not in the legend", h, symbol)
+				}
+				kind = len(m.Legend)
+				m.Legend = append(m.Legend, def)
+				kinds[symbol] = kind
+			}
+			m.Layout[h] = append(m.Layout[h], kind)
+		}
+	}
+	return nil
+}
+
+// numberArray matches an indented array of numbers. JSON strings cannot hold
+// raw newlines, so requiring one keeps string contents from matching.
+var numberArray = regexp.MustCompile(`\[\n\s*-?[0-9.]+(,\n\s*-?[0-9.]+)*\n\s*\]`)
+
+// MarshalIndent is json.MarshalIndent with tabs, except that arrays of
+// numbers stay on one line so version 2 layouts remain readable.
+func MarshalIndent(v interface{}) ([]byte, error) {
+	out, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return numberArray.ReplaceAllFunc(out, func(array []byte) []byte {
+		return []byte(strings.Join(strings.Fields(string(array)), ""))
+	}), nil
+}
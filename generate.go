@@ -0,0 +1,338 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"time"
+)
+
+func init() {
+	Commands["generate"] = GenerateCommand
+}
+
+// wallNames are the kinds of wall LayoutDict has.
+var wallNames = []string{"stone", "slime", "white", "blood", "tar", "gold", "hell"}
+
+// Generator makes new levels out of rooms joined by corridors, the same
+// every time for the same settings.
+type Generator struct {
+	Seed          int64
+	Width, Height uint
+	// Difficulty from 0 to 2 brings more monsters and locked doors and
+	// fewer potions.
+	Difficulty  int
+	LevelNumber int // the warp gate leads to the next level
+	Title       string
+}
+
+// genRoom is a room's floor, from Min to Max inclusive.
+type genRoom struct {
+	Min, Max Vec2
+}
+
+func (r genRoom) Center() Vec2 {
+	return Vec2{(r.Min.X + r.Max.X) / 2, (r.Min.Y + r.Max.Y) / 2}
+}
+
+func (r genRoom) Contains(p Vec2) bool {
+	return p.X >= r.Min.X && p.Y >= r.Min.Y && p.X <= r.Max.X && p.Y <= r.Max.Y
+}
+
+// monsterTiers are the monsters that turn up from each level on.
+var monsterTiers = []struct {
+	From  int
+	Types []string
+}{
+	{1, []string{"Orc", "Bat"}},
+	{4, []string{"Troll", "Mage"}},
+	{10, []string{"Demon"}},
+}
+
+// Generate makes a level and checks it can be finished. Layouts that can't
+// are thrown away and tried again.
+func (g Generator) Generate() (*JsonMap, error) {
+	if g.Width < 12 || g.Height < 12 || g.Width > 255 || g.Height > 255 {
+		return nil, fmt.Errorf("levels must be from 12x12 to 255x255 tiles, not %vx%v", g.Width, g.Height)
+	}
+	if g.LevelNumber < 1 || g.LevelNumber >= len(MapNames) {
+		return nil, fmt.Errorf("level number %v has no next level for its warp gate", g.LevelNumber)
+	}
+	if g.Difficulty < 0 || g.Difficulty >= Difficulties {
+		return nil, fmt.Errorf("difficulty must be from 0 to %v", Difficulties-1)
+	}
+	rng := rand.New(rand.NewSource(g.Seed))
+	for attempt := 0; attempt < 100; attempt++ {
+		if m := g.generate(rng); m != nil {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("couldn't make a level that can be finished with seed %v", g.Seed)
+}
+
+func (g Generator) generate(rng *rand.Rand) *JsonMap {
+	w, h := int(g.Width), int(g.Height)
+	wall := wallNames[rng.Intn(len(wallNames))]
+	tiles := make([][]LayoutDef, h) // by position, so tiles[y][x]
+	for y := range tiles {
+		tiles[y] = make([]LayoutDef, w)
+		for x := range tiles[y] {
+			tiles[y][x] = Wall(wall)
+		}
+	}
+	set := func(p Vec2, def LayoutDef) { tiles[p.Y][p.X] = def }
+	get := func(p Vec2) LayoutDef { return tiles[p.Y][p.X] }
+
+	// rooms are kept a tile apart so each has walls of its own
+	var rooms []genRoom
+	for try := 0; try < 500 && len(rooms) < w*h/40+2; try++ {
+		rw, rh := 3+rng.Intn(minInt(6, w-4)), 3+rng.Intn(minInt(6, h-4))
+		r := genRoom{Min: Vec2{1 + rng.Intn(w-rw-1), 1 + rng.Intn(h-rh-1)}}
+		r.Max = Vec2{r.Min.X + rw - 1, r.Min.Y + rh - 1}
+		overlaps := false
+		for _, other := range rooms {
+			if r.Min.X <= other.Max.X+2 && other.Min.X <= r.Max.X+2 && r.Min.Y <= other.Max.Y+2 && other.Min.Y <= r.Max.Y+2 {
+				overlaps = true
+			}
+		}
+		if !overlaps {
+			rooms = append(rooms, r)
+		}
+	}
+	if len(rooms) < 2 {
+		return nil
+	}
+	for _, r := range rooms {
+		for y := r.Min.Y; y <= r.Max.Y; y++ {
+			for x := r.Min.X; x <= r.Max.X; x++ {
+				set(Vec2{x, y}, Floor(""))
+			}
+		}
+	}
+
+	// join each room to the nearest one before it, so they form a tree,
+	// remembering where each corridor leaves the later room
+	parent := make([]int, len(rooms))
+	doorway := make([]Vec2, len(rooms))
+	for i := 1; i < len(rooms); i++ {
+		a := rooms[i].Center()
+		parent[i] = 0
+		for j := 1; j < i; j++ {
+			b, best := rooms[j].Center(), rooms[parent[i]].Center()
+			if abs(float64(a.X-b.X))+abs(float64(a.Y-b.Y)) < abs(float64(a.X-best.X))+abs(float64(a.Y-best.Y)) {
+				parent[i] = j
+			}
+		}
+		b := rooms[parent[i]].Center()
+		p := a
+		step := func(d Vec2) {
+			p = Vec2{p.X + d.X, p.Y + d.Y}
+			if !rooms[i].Contains(p) && doorway[i] == (Vec2{}) {
+				doorway[i] = p
+			}
+			if get(p).Type == "wall" {
+				set(p, Floor(""))
+			}
+		}
+		horizontalFirst := rng.Intn(2) == 0
+		for p.X != b.X && horizontalFirst {
+			step(Vec2{sign(b.X - p.X), 0})
+		}
+		for p.Y != b.Y {
+			step(Vec2{0, sign(b.Y - p.Y)})
+		}
+		for p.X != b.X {
+			step(Vec2{sign(b.X - p.X), 0})
+		}
+	}
+
+	// start in the first room and warp from the room furthest from it
+	depth := make([]int, len(rooms))
+	goal := 0
+	for i := 1; i < len(rooms); i++ {
+		depth[i] = depth[parent[i]] + 1
+		if depth[i] > depth[goal] {
+			goal = i
+		}
+	}
+	m := &JsonMap{
+		Version:     CurrentVersion,
+		Title:       g.Title,
+		LevelNumber: g.LevelNumber,
+		Entities:    []Entity{},
+	}
+	directions := []Vec2{{0, 1}, {1, 0}, {0, -1}, {-1, 0}}
+	m.PlayerStart = Entity{Position: rooms[0].Center(), Direction: &directions[rng.Intn(len(directions))]}
+	m.Entities = append(m.Entities, Entity{Type: "WarpGate", Position: rooms[goal].Center(), Value: MapNames[g.LevelNumber]})
+	used := map[Vec2]bool{rooms[0].Center(): true, rooms[goal].Center(): true}
+	place := func(room int, e Entity) bool {
+		r := rooms[room]
+		for try := 0; try < 20; try++ {
+			p := Vec2{r.Min.X + rng.Intn(r.Max.X-r.Min.X+1), r.Min.Y + rng.Intn(r.Max.Y-r.Min.Y+1)}
+			if !used[p] {
+				used[p] = true
+				e.Position = p
+				m.Entities = append(m.Entities, e)
+				return true
+			}
+		}
+		return false
+	}
+
+	// lock doors on the way to the goal, hiding each key somewhere the
+	// doors locked so far don't shut off
+	var path []int
+	for i := goal; i != 0; i = parent[i] {
+		path = append([]int{i}, path...)
+	}
+	locks := minInt(g.Difficulty+1+rng.Intn(2), len(KeyColors), len(path))
+	colors := rng.Perm(len(KeyColors))
+	locked := make(map[int]string)
+	lockAt := rng.Perm(len(path))[:locks]
+	sort.Ints(lockAt)
+	for n, i := range lockAt {
+		room := path[i]
+		var open []int
+		for r := range rooms {
+			behind := false
+			for a := r; a != 0; a = parent[a] {
+				behind = behind || a == room
+			}
+			if !behind {
+				open = append(open, r)
+			}
+		}
+		color := KeyColors[colors[n]]
+		if !place(open[rng.Intn(len(open))], Entity{Type: capitalize(color) + "Key"}) {
+			return nil
+		}
+		locked[room] = color
+		set(doorway[room], Door(color))
+	}
+	// some side rooms are behind walls to shoot through
+	for i := 1; i < len(rooms); i++ {
+		if locked[i] == "" && indexOf(path, i) < 0 && rng.Intn(4) == 0 {
+			set(doorway[i], Exploding(wall))
+		}
+	}
+
+	// items are scarcer and monsters commoner the harder it is
+	monsters := []string{}
+	for _, tier := range monsterTiers {
+		if g.LevelNumber >= tier.From {
+			monsters = append(monsters, tier.Types...)
+		}
+	}
+	items := []string{"Bolt", "Nuke", "Potion", "Treasure"}
+	for i := 1; i < len(rooms); i++ {
+		r := rooms[i]
+		area := (r.Max.X - r.Min.X + 1) * (r.Max.Y - r.Min.Y + 1)
+		for n := rng.Intn(4 - g.Difficulty); n > 0; n-- {
+			e := Entity{Type: items[rng.Intn(len(items))]}
+			if e.Type == "Treasure" {
+				e.Value = g.LevelNumber * 100 // as Convert does
+			}
+			place(i, e)
+		}
+		for n := rng.Intn(area/8 + g.Difficulty + 1); n > 0; n-- {
+			place(i, Entity{Type: monsters[rng.Intn(len(monsters))], MinDifficulty: rng.Intn(Difficulties)})
+		}
+	}
+
+	rows := make([][]LayoutDef, h)
+	for row := range rows {
+		rows[row] = tiles[h-1-row]
+	}
+	m.SetLayout(rows)
+	m.Fog = DefaultFog(m)
+	m.Regions = Segment(m)
+	if !Solvable(m) || CheckMap(m) != nil {
+		return nil
+	}
+	return m
+}
+
+func minInt(n int, others ...int) int {
+	for _, o := range others {
+		if o < n {
+			n = o
+		}
+	}
+	return n
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
+
+func indexOf(list []int, n int) int {
+	for i, v := range list {
+		if v == n {
+			return i
+		}
+	}
+	return -1
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return string(s[0]-'a'+'A') + s[1:]
+}
+
+func GenerateCommand(args []string) {
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	output := flags.String("o", "", "output map JSON file (default standard output)")
+	seed := flags.Int64("seed", 0, "random seed (default one from the clock, which is reported)")
+	width := flags.Uint("width", 32, "width in tiles")
+	height := flags.Uint("height", 32, "height in tiles")
+	difficulty := flags.Int("difficulty", 1, "0 to 2: how many monsters and locked doors there are")
+	levelNo := flags.Int("level", 1, "level number, which decides the monsters and where the warp gate leads")
+	title := flags.String("title", "", "title (default one with the seed)")
+	v1 := flags.Bool("v1", false, "write the version 1 format, which is limited to 52 kinds of tile")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json generate [flags]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+		fmt.Fprintln(os.Stderr, "seed:", *seed)
+	}
+	if *title == "" {
+		*title = fmt.Sprintf("Generated %v", *seed)
+	}
+
+	g := Generator{Seed: *seed, Width: *width, Height: *height, Difficulty: *difficulty, LevelNumber: *levelNo, Title: *title}
+	m, err := g.Generate()
+	if err != nil {
+		panic(err)
+	}
+	if *v1 {
+		m.Version = 1
+	}
+	if *output != "" {
+		err = writeJSONFile(*output, m)
+	} else {
+		var out []byte
+		if out, err = MarshalIndent(m); err == nil {
+			fmt.Println(string(out))
+		}
+	}
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestGenerateDeterministic(t *testing.T) {
+	g := Generator{Seed: 3, Width: 40, Height: 30, Difficulty: 1, LevelNumber: 2, Title: "Test"}
+	a, err := g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := DiffMaps(a, b); !d.Empty() {
+		t.Errorf("seed %v made two different levels: %+v", g.Seed, d)
+	}
+	g.Seed++
+	c, err := g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if DiffMaps(a, c).Empty() {
+		t.Errorf("seeds %v and %v made the same level", g.Seed-1, g.Seed)
+	}
+}
+
+func TestGenerateSolvable(t *testing.T) {
+	for seed := int64(1); seed <= 10; seed++ {
+		for difficulty := 0; difficulty < Difficulties; difficulty++ {
+			for _, size := range [][2]uint{{12, 12}, {32, 32}, {64, 24}} {
+				g := Generator{Seed: seed, Width: size[0], Height: size[1], Difficulty: difficulty, LevelNumber: int(seed)}
+				m, err := g.Generate()
+				if err != nil {
+					t.Errorf("%+v: %v", g, err)
+					continue
+				}
+				if err := CheckMap(m); err != nil {
+					t.Errorf("%+v: %v", g, err)
+				}
+				if !Solvable(m) {
+					t.Errorf("%+v: level can't be finished", g)
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateSettings(t *testing.T) {
+	tests := []Generator{
+		{Width: 11, Height: 32, LevelNumber: 1},
+		{Width: 32, Height: 256, LevelNumber: 1},
+		{Width: 32, Height: 32, LevelNumber: 0},
+		{Width: 32, Height: 32, LevelNumber: len(MapNames)},
+		{Width: 32, Height: 32, LevelNumber: 1, Difficulty: -1},
+		{Width: 32, Height: 32, LevelNumber: 1, Difficulty: Difficulties},
+	}
+	for _, g := range tests {
+		if _, err := g.Generate(); err == nil {
+			t.Errorf("%+v: no error", g)
+		}
+	}
+}
@@ -0,0 +1,423 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+func init() {
+	Commands["glb"] = GLBCommand
+}
+
+// Geometry is a list of textured quads, in glTF's axes: the game's X east,
+// Y north and Z up become glTF X, -Z and Y.
+type Geometry struct {
+	Positions []float32
+	Normals   []float32
+	UVs       []float32
+	Indices   []uint32
+}
+
+// gltfVec converts a point in game coordinates to glTF's axes.
+func gltfVec(x, y, z float64) [3]float32 {
+	return [3]float32{float32(x), float32(z), float32(-y)}
+}
+
+// AddQuad adds a face given its corners counterclockwise from the bottom left
+// as seen from the front, and textures it with the whole image.
+func (g *Geometry) AddQuad(corners [4][3]float32, normal [3]float32) {
+	base := uint32(len(g.Positions) / 3)
+	uvs := [4][2]float32{{0, 1}, {1, 1}, {1, 0}, {0, 0}}
+	for i, c := range corners {
+		g.Positions = append(g.Positions, c[:]...)
+		g.Normals = append(g.Normals, normal[:]...)
+		g.UVs = append(g.UVs, uvs[i][:]...)
+	}
+	g.Indices = append(g.Indices, base, base+1, base+2, base, base+2, base+3)
+}
+
+// WallDirections are the directions a face can point, with whether src/geometry.js
+// uses the light or dark texture for it.
+var WallDirections = []struct {
+	Name  string
+	Dir   Vec2
+	Shade string
+}{
+	{"south", Vec2{0, -1}, "dark"},
+	{"east", Vec2{1, 0}, "light"},
+	{"north", Vec2{0, 1}, "dark"},
+	{"west", Vec2{-1, 0}, "light"},
+}
+
+// AddWallFace adds the side of the tile centered at (x, y) that faces dir.
+func (g *Geometry) AddWallFace(x, y float64, dir Vec2) {
+	dx, dy := float64(dir.X), float64(dir.Y)
+	cx, cy := x+dx/2, y+dy/2
+	rx, ry := -dy/2, dx/2 // to the right, seen from the front
+	g.AddQuad([4][3]float32{
+		gltfVec(cx-rx, cy-ry, -0.5),
+		gltfVec(cx+rx, cy+ry, -0.5),
+		gltfVec(cx+rx, cy+ry, 0.5),
+		gltfVec(cx-rx, cy-ry, 0.5),
+	}, gltfVec(dx, dy, 0))
+}
+
+// AddBox adds a unit cube centered at (x, y), like a door.
+func (g *Geometry) AddBox(x, y float64) {
+	for _, d := range WallDirections {
+		g.AddWallFace(x, y, d.Dir)
+	}
+	for _, z := range []float64{-0.5, 0.5} {
+		g.AddQuad([4][3]float32{
+			gltfVec(x-0.5, y-z, z),
+			gltfVec(x+0.5, y-z, z),
+			gltfVec(x+0.5, y+z, z),
+			gltfVec(x-0.5, y+z, z),
+		}, gltfVec(0, 0, z*2))
+	}
+}
+
+// AddFloor adds the floor under the tile centered at (x, y).
+func (g *Geometry) AddFloor(x, y float64) {
+	g.AddQuad([4][3]float32{
+		gltfVec(x-0.5, y-0.5, -0.5),
+		gltfVec(x+0.5, y-0.5, -0.5),
+		gltfVec(x+0.5, y+0.5, -0.5),
+		gltfVec(x-0.5, y+0.5, -0.5),
+	}, gltfVec(0, 0, 1))
+}
+
+// Borders returns the directions from a tile where the next tile is a
+// different type, like adjacentBorders in src/map.js.
+func Borders(m *JsonMap, p Vec2) []int {
+	def, _ := m.Tile(p)
+	var borders []int
+	for i, d := range WallDirections {
+		if adj, ok := m.Tile(Vec2{p.X + d.Dir.X, p.Y + d.Dir.Y}); ok && adj.Type != def.Type {
+			borders = append(borders, i)
+		}
+	}
+	return borders
+}
+
+type gltfDoc struct {
+	Asset          map[string]string        `json:"asset"`
+	ExtensionsUsed []string                 `json:"extensionsUsed,omitempty"`
+	Scene          int                      `json:"scene"`
+	Scenes         []gltfScene              `json:"scenes"`
+	Nodes          []gltfNode               `json:"nodes"`
+	Meshes         []gltfMesh               `json:"meshes"`
+	Materials      []map[string]interface{} `json:"materials"`
+	Textures       []map[string]int         `json:"textures,omitempty"`
+	Images         []map[string]string      `json:"images,omitempty"`
+	Samplers       []map[string]int         `json:"samplers,omitempty"`
+	Accessors      []gltfAccessor           `json:"accessors"`
+	BufferViews    []gltfBufferView         `json:"bufferViews"`
+	Buffers        []map[string]int         `json:"buffers"`
+}
+
+type gltfScene struct {
+	Name   string                 `json:"name"`
+	Nodes  []int                  `json:"nodes"`
+	Extras map[string]interface{} `json:"extras,omitempty"`
+}
+
+type gltfNode struct {
+	Name        string                 `json:"name"`
+	Mesh        *int                   `json:"mesh,omitempty"`
+	Translation *[3]float32            `json:"translation,omitempty"`
+	Children    []int                  `json:"children,omitempty"`
+	Extras      map[string]interface{} `json:"extras,omitempty"`
+}
+
+type gltfMesh struct {
+	Name       string          `json:"name"`
+	Primitives []gltfPrimitive `json:"primitives"`
+}
+
+type gltfPrimitive struct {
+	Attributes map[string]int `json:"attributes"`
+	Indices    int            `json:"indices"`
+	Material   int            `json:"material"`
+}
+
+type gltfAccessor struct {
+	BufferView    int       `json:"bufferView"`
+	ComponentType int       `json:"componentType"`
+	Count         int       `json:"count"`
+	Type          string    `json:"type"`
+	Min           []float32 `json:"min,omitempty"`
+	Max           []float32 `json:"max,omitempty"`
+}
+
+type gltfBufferView struct {
+	Buffer     int `json:"buffer"`
+	ByteOffset int `json:"byteOffset"`
+	ByteLength int `json:"byteLength"`
+	Target     int `json:"target"`
+}
+
+const (
+	gltfFloat        = 5126
+	gltfUnsignedInt  = 5125
+	gltfArrayBuffer  = 34962
+	gltfElementArray = 34963
+	gltfNearest      = 9728
+)
+
+// GLTFBuilder collects a level's meshes and the binary data they use.
+type GLTFBuilder struct {
+	Doc       gltfDoc
+	Bin       bytes.Buffer
+	Textures  string // URI of the directory holding the wall textures
+	materials map[string]int
+}
+
+func NewGLTFBuilder(textures string) *GLTFBuilder {
+	return &GLTFBuilder{
+		Doc: gltfDoc{
+			Asset:          map[string]string{"version": "2.0", "generator": "map2json"},
+			ExtensionsUsed: []string{"KHR_materials_unlit"},
+			Samplers:       []map[string]int{{"magFilter": gltfNearest, "minFilter": gltfNearest}},
+		},
+		Textures:  textures,
+		materials: make(map[string]int),
+	}
+}
+
+func (b *GLTFBuilder) view(data interface{}, target int) int {
+	offset := b.Bin.Len()
+	binary.Write(&b.Bin, binary.LittleEndian, data)
+	b.Doc.BufferViews = append(b.Doc.BufferViews, gltfBufferView{0, offset, b.Bin.Len() - offset, target})
+	return len(b.Doc.BufferViews) - 1
+}
+
+func (b *GLTFBuilder) accessor(a gltfAccessor) int {
+	b.Doc.Accessors = append(b.Doc.Accessors, a)
+	return len(b.Doc.Accessors) - 1
+}
+
+// Material returns the material for a wall texture, such as stone_light, or
+// for a plain color if name is empty.
+func (b *GLTFBuilder) Material(name string, color uint32) int {
+	key := name
+	if name == "" {
+		key = fmt.Sprintf("#%06x", color)
+	}
+	if i, ok := b.materials[key]; ok {
+		return i
+	}
+	pbr := map[string]interface{}{"metallicFactor": 0, "roughnessFactor": 1}
+	if name != "" {
+		b.Doc.Images = append(b.Doc.Images, map[string]string{"uri": b.Textures + name + ".png"})
+		b.Doc.Textures = append(b.Doc.Textures, map[string]int{"source": len(b.Doc.Images) - 1, "sampler": 0})
+		pbr["baseColorTexture"] = map[string]int{"index": len(b.Doc.Textures) - 1}
+	} else {
+		// baseColorFactor is linear, not sRGB
+		linear := func(shift uint) float64 {
+			c := float64(color>>shift&0xFF) / 255
+			return math.Pow(c, 2.2)
+		}
+		pbr["baseColorFactor"] = []float64{linear(16), linear(8), linear(0), 1}
+	}
+	b.Doc.Materials = append(b.Doc.Materials, map[string]interface{}{
+		"name":                 key,
+		"pbrMetallicRoughness": pbr,
+		"extensions":           map[string]interface{}{"KHR_materials_unlit": map[string]interface{}{}},
+	})
+	b.materials[key] = len(b.Doc.Materials) - 1
+	return b.materials[key]
+}
+
+func (b *GLTFBuilder) Primitive(g *Geometry, material int) gltfPrimitive {
+	min := []float32{math.MaxFloat32, math.MaxFloat32, math.MaxFloat32}
+	max := []float32{-math.MaxFloat32, -math.MaxFloat32, -math.MaxFloat32}
+	for i, v := range g.Positions {
+		if v < min[i%3] {
+			min[i%3] = v
+		}
+		if v > max[i%3] {
+			max[i%3] = v
+		}
+	}
+	count := len(g.Positions) / 3
+	return gltfPrimitive{
+		Attributes: map[string]int{
+			"POSITION":   b.accessor(gltfAccessor{b.view(g.Positions, gltfArrayBuffer), gltfFloat, count, "VEC3", min, max}),
+			"NORMAL":     b.accessor(gltfAccessor{b.view(g.Normals, gltfArrayBuffer), gltfFloat, count, "VEC3", nil, nil}),
+			"TEXCOORD_0": b.accessor(gltfAccessor{b.view(g.UVs, gltfArrayBuffer), gltfFloat, count, "VEC2", nil, nil}),
+		},
+		Indices:  b.accessor(gltfAccessor{b.view(g.Indices, gltfElementArray), gltfUnsignedInt, len(g.Indices), "SCALAR", nil, nil}),
+		Material: material,
+	}
+}
+
+// Node adds a node with a mesh made of the given primitives, and returns
+// its index. Translation may be nil.
+func (b *GLTFBuilder) Node(name string, primitives []gltfPrimitive, translation *[3]float32, extras map[string]interface{}) int {
+	b.Doc.Meshes = append(b.Doc.Meshes, gltfMesh{name, primitives})
+	mesh := len(b.Doc.Meshes) - 1
+	b.Doc.Nodes = append(b.Doc.Nodes, gltfNode{Name: name, Mesh: &mesh, Translation: translation, Extras: extras})
+	return len(b.Doc.Nodes) - 1
+}
+
+// LevelGLTF builds a level's walls and floor as two merged meshes, with a
+// wall face only where a wall meets another type of tile. Doors and
+// exploding walls get a node each, placed on their tile, since the game
+// removes them separately.
+func LevelGLTF(m *JsonMap, textures string) *GLTFBuilder {
+	b := NewGLTFBuilder(textures)
+	walls := make(map[string]*Geometry)
+	var wallNames []string
+	floor := &Geometry{}
+	var children []int
+
+	for y := 0; y < int(m.Height); y++ {
+		for x := 0; x < int(m.Width); x++ {
+			p := Vec2{x, y}
+			def, _ := m.Tile(p)
+			if def.Type != "wall" {
+				floor.AddFloor(float64(x), float64(y))
+			}
+			extras := map[string]interface{}{"type": def.Type, "value": def.Value, "position": p}
+			translation := gltfVec(float64(x), float64(y), 0)
+			switch def.Type {
+			case "wall":
+				for _, i := range Borders(m, p) {
+					name := def.Value + "_" + WallDirections[i].Shade
+					if walls[name] == nil {
+						walls[name] = &Geometry{}
+						wallNames = append(wallNames, name)
+					}
+					walls[name].AddWallFace(float64(x), float64(y), WallDirections[i].Dir)
+				}
+			case "door":
+				g := &Geometry{}
+				g.AddBox(0, 0)
+				primitive := b.Primitive(g, b.Material(def.Value+"_door", 0))
+				children = append(children, b.Node(fmt.Sprintf("door %v", p), []gltfPrimitive{primitive}, &translation, extras))
+			case "exploding_wall":
+				faces := make(map[string]*Geometry)
+				var names []string
+				for _, i := range Borders(m, p) {
+					name := def.Value + "_" + WallDirections[i].Shade
+					if faces[name] == nil {
+						faces[name] = &Geometry{}
+						names = append(names, name)
+					}
+					faces[name].AddWallFace(0, 0, WallDirections[i].Dir)
+				}
+				if len(names) == 0 {
+					continue
+				}
+				var primitives []gltfPrimitive
+				for _, name := range names {
+					primitives = append(primitives, b.Primitive(faces[name], b.Material(name, 0)))
+				}
+				children = append(children, b.Node(fmt.Sprintf("exploding wall %v", p), primitives, &translation, extras))
+			}
+		}
+	}
+
+	var primitives []gltfPrimitive
+	for _, name := range wallNames {
+		primitives = append(primitives, b.Primitive(walls[name], b.Material(name, 0)))
+	}
+	if len(primitives) > 0 {
+		children = append([]int{b.Node("walls", primitives, nil, nil)}, children...)
+	}
+	if len(floor.Indices) > 0 {
+		// the same gray as the floor material in src/map.js
+		children = append([]int{b.Node("floor", []gltfPrimitive{b.Primitive(floor, b.Material("", 0x555555))}, nil, nil)}, children...)
+	}
+
+	b.Doc.Nodes = append(b.Doc.Nodes, gltfNode{Name: MapName(m.LevelNumber, m.Title), Children: children})
+	b.Doc.Scenes = []gltfScene{{
+		Name:   m.Title,
+		Nodes:  []int{len(b.Doc.Nodes) - 1},
+		Extras: map[string]interface{}{"levelNumber": m.LevelNumber, "title": m.Title},
+	}}
+	b.Doc.Buffers = []map[string]int{{"byteLength": b.Bin.Len()}}
+	return b
+}
+
+// GLB returns the glTF as a single binary file.
+func (b *GLTFBuilder) GLB() ([]byte, error) {
+	doc, err := json.Marshal(b.Doc)
+	if err != nil {
+		return nil, err
+	}
+	for len(doc)%4 != 0 {
+		doc = append(doc, ' ')
+	}
+	bin := b.Bin.Bytes()
+	for len(bin)%4 != 0 {
+		bin = append(bin, 0)
+	}
+	var out bytes.Buffer
+	binary.Write(&out, binary.LittleEndian, []uint32{0x46546C67, 2, uint32(12 + 8 + len(doc) + 8 + len(bin))}) // "glTF"
+	binary.Write(&out, binary.LittleEndian, []uint32{uint32(len(doc)), 0x4E4F534A})                            // "JSON"
+	out.Write(doc)
+	binary.Write(&out, binary.LittleEndian, []uint32{uint32(len(bin)), 0x004E4942}) // "BIN"
+	out.Write(bin)
+	return out.Bytes(), nil
+}
+
+func GLBCommand(args []string) {
+	flags := flag.NewFlagSet("glb", flag.ExitOnError)
+	outdir := flags.String("o", ".", "output directory")
+	walls := flags.String("walls", "build/walls", "directory of wall textures, which the .glb files refer to")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json glb [flags] map-or-dir...")
+		fmt.Fprintln(flags.Output(), "Writes each level's geometry as <name>.glb in the output directory.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	if err := os.MkdirAll(*outdir, 0755); err != nil {
+		panic(err)
+	}
+	absDir, err := filepath.Abs(*outdir)
+	if err != nil {
+		panic(err)
+	}
+	absWalls, err := filepath.Abs(*walls)
+	if err != nil {
+		panic(err)
+	}
+	textures, err := filepath.Rel(absDir, absWalls)
+	if err != nil {
+		panic(err)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	filenames, err := loader.Expand(flags.Args())
+	if err != nil {
+		panic(err)
+	}
+	for _, filename := range filenames {
+		m, err := loader.Load(filename)
+		if err != nil {
+			panic(err)
+		}
+		glb, err := LevelGLTF(m, filepath.ToSlash(textures)+"/").GLB()
+		if err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(*outdir, MapName(m.LevelNumber, m.Title)+".glb"), glb, 0666); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/png"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+func init() {
+	Commands["image-import"] = ImageImportCommand
+}
+
+// Palette says what each color in a drawn level stands for. Colors are
+// written like #808080.
+type Palette struct {
+	Tiles    map[string]LayoutDef     `json:"tiles"`
+	Entities map[string]PaletteEntity `json:"entities"`
+}
+
+// PaletteEntity is an Entity without a position. Jump gates with the same
+// number as their value lead to each other, and a warp gate without one
+// leads to the next level.
+type PaletteEntity struct {
+	Type          string      `json:"type"`
+	Direction     *Vec2       `json:"direction,omitempty"`
+	Value         interface{} `json:"value,omitempty"`
+	MinDifficulty int         `json:"minDifficulty,omitempty"`
+}
+
+func darken(c color.RGBA, n, d uint8) color.RGBA {
+	return color.RGBA{uint8(uint(c.R) * uint(n) / uint(d)), uint8(uint(c.G) * uint(n) / uint(d)), uint8(uint(c.B) * uint(n) / uint(d)), 0xFF}
+}
+
+// DefaultPalette uses the png command's colors for walls and doors, with
+// exploding walls at half brightness and all floor in FloorColor. Entity
+// colors are different from all of them, so entities can be drawn over the
+// tiles in the same image. Warp gates have a color for the next level and
+// one for each level by number, from #6080e1 for level 1 to #6080f4 for
+// level 20.
+func DefaultPalette() Palette {
+	p := Palette{map[string]LayoutDef{}, map[string]PaletteEntity{}}
+	for name, c := range WallColors {
+		p.Tiles[hexColor(c)] = Wall(name)
+		p.Tiles[hexColor(darken(c, 1, 2))] = Exploding(name)
+	}
+	for name, c := range DoorColors {
+		p.Tiles[hexColor(c)] = Door(name)
+		p.Entities[hexColor(darken(c, 3, 4))] = PaletteEntity{Type: strings.Title(name) + "Key"}
+	}
+	p.Tiles[hexColor(FloorColor)] = Floor("")
+
+	entity := func(hex string, e PaletteEntity) {
+		p.Entities[hex] = e
+	}
+	directions := []string{"#ffffff", "#e0e0ff", "#ffe0e0", "#e0ffe0"} // north, east, south, west
+	for i, d := range []Vec2{{0, 1}, {1, 0}, {0, -1}, {-1, 0}} {
+		d := d
+		entity(directions[i], PaletteEntity{Type: "PlayerStart", Direction: &d})
+	}
+	entity("#ffff80", PaletteEntity{Type: "Bolt"})
+	entity("#ff80ff", PaletteEntity{Type: "Nuke"})
+	entity(hexColor(EntityIcons["Potion"].Color), PaletteEntity{Type: "Potion"})
+	for n := 1; n <= len(ScrollText); n++ {
+		entity(fmt.Sprintf("#f0e0b%x", n), PaletteEntity{Type: "Scroll", Value: n})
+	}
+	entity(hexColor(EntityIcons["Treasure"].Color), PaletteEntity{Type: "Treasure"})
+	entity(hexColor(EntityIcons["WarpGate"].Color), PaletteEntity{Type: "WarpGate"})
+	for n, name := range MapNames {
+		entity(fmt.Sprintf("#6080%02x", 0xe1+n), PaletteEntity{Type: "WarpGate", Value: name})
+	}
+	for n, hex := range []string{"#40ffff", "#40c0c0", "#408080"} {
+		entity(hex, PaletteEntity{Type: "JumpGate", Value: n + 1})
+	}
+	entity("#ff8000", PaletteEntity{Type: "Fireball", Direction: &Vec2{0, 1}})
+	entity("#c06000", PaletteEntity{Type: "Fireball", Direction: &Vec2{1, 0}})
+	entity("#ff00ff", PaletteEntity{Type: "Grelminar"})
+	entity("#800080", PaletteEntity{Type: "Nemesis"})
+	monsters := map[string]color.RGBA{
+		"Orc":   {0xFF, 0x20, 0x20, 0xFF},
+		"Troll": {0x20, 0xFF, 0x80, 0xFF},
+		"Bat":   {0xA0, 0xA0, 0xFF, 0xFF},
+		"Demon": {0xFF, 0x60, 0x00, 0xFF},
+		"Mage":  {0xC0, 0x40, 0xFF, 0xFF},
+	}
+	for name, c := range monsters {
+		for d := 0; d < Difficulties; d++ {
+			// harder difficulties are darker
+			entity(hexColor(darken(c, uint8(4-d), 4)), PaletteEntity{Type: name, MinDifficulty: d})
+		}
+	}
+	return p
+}
+
+func ReadPalette(filename string) (Palette, error) {
+	var p Palette
+	data, err := ioutil.ReadFile(filename)
+	if err == nil {
+		err = json.Unmarshal(data, &p)
+	}
+	if err != nil {
+		return p, err
+	}
+	// lowercase the colors so they match hexColor
+	tiles, entities := make(map[string]LayoutDef), make(map[string]PaletteEntity)
+	for c, def := range p.Tiles {
+		tiles[strings.ToLower(c)] = def
+	}
+	for c, e := range p.Entities {
+		entities[strings.ToLower(c)] = e
+	}
+	p.Tiles, p.Entities = tiles, entities
+	return p, nil
+}
+
+// readImage reads an image as one color for each tile, from the middle of
+// each scale by scale square of pixels. Transparent tiles are "".
+func readImage(filename string, scale int) ([][]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", filename, err)
+	}
+	bounds := img.Bounds()
+	if bounds.Dx()%scale != 0 || bounds.Dy()%scale != 0 {
+		return nil, fmt.Errorf("%v: %vx%v isn't a whole number of %v pixel tiles", filename, bounds.Dx(), bounds.Dy(), scale)
+	}
+	rows := make([][]string, bounds.Dy()/scale)
+	for row := range rows {
+		rows[row] = make([]string, bounds.Dx()/scale)
+		for x := range rows[row] {
+			c := color.NRGBAModel.Convert(img.At(bounds.Min.X+x*scale+scale/2, bounds.Min.Y+row*scale+scale/2)).(color.NRGBA)
+			if c.A != 0 {
+				rows[row][x] = hexColor(color.RGBA{c.R, c.G, c.B, 0xFF})
+			}
+		}
+	}
+	return rows, nil
+}
+
+// ImageMap builds a level from an image of its tiles, and an optional image
+// of the same size with its entities on a transparent background. Entities
+// can also be drawn in among the tiles, in which case they stand on the
+// floor next to them, as in text maps.
+type ImageMap struct {
+	Palette     Palette
+	Scale       int // pixels per tile
+	Title       string
+	LevelNumber int
+}
+
+func (im ImageMap) Read(tilesFile, entitiesFile string) (*JsonMap, error) {
+	tileColors, err := readImage(tilesFile, im.Scale)
+	if err != nil {
+		return nil, err
+	}
+	var entityColors [][]string
+	if entitiesFile != "" {
+		if entityColors, err = readImage(entitiesFile, im.Scale); err != nil {
+			return nil, err
+		}
+		if len(entityColors) != len(tileColors) || len(entityColors[0]) != len(tileColors[0]) {
+			return nil, fmt.Errorf("%v isn't the same size as %v", entitiesFile, tilesFile)
+		}
+	}
+
+	m := &JsonMap{Version: CurrentVersion, Title: im.Title, LevelNumber: im.LevelNumber, Entities: []Entity{}}
+	height := len(tileColors)
+	layout := make([][]LayoutDef, height)
+	var placed []Entity
+	var unknown []string
+	seen := make(map[string]bool)
+	report := func(c, where string) {
+		if c == "" {
+			c = "transparent"
+		}
+		if !seen[c] {
+			seen[c] = true
+			unknown = append(unknown, c+" first at "+where)
+		}
+	}
+	for row := range tileColors {
+		layout[row] = make([]LayoutDef, len(tileColors[row]))
+		for x, c := range tileColors[row] {
+			position := Vec2{x, height - 1 - row}
+			if def, ok := im.Palette.Tiles[c]; ok {
+				layout[row][x] = def
+			} else if e, ok := im.Palette.Entities[c]; ok && entitiesFile == "" {
+				placed = append(placed, Entity{Type: e.Type, Position: position, Direction: e.Direction, Value: e.Value, MinDifficulty: e.MinDifficulty})
+			} else {
+				report(c, fmt.Sprintf("row %v, column %v", row+1, x+1))
+			}
+			if entityColors == nil || entityColors[row][x] == "" {
+				continue
+			}
+			if e, ok := im.Palette.Entities[entityColors[row][x]]; ok {
+				placed = append(placed, Entity{Type: e.Type, Position: position, Direction: e.Direction, Value: e.Value, MinDifficulty: e.MinDifficulty})
+			} else {
+				report(entityColors[row][x], fmt.Sprintf("row %v, column %v of the entities", row+1, x+1))
+			}
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("colors not in the palette: %v", strings.Join(unknown, "; "))
+	}
+
+	// entities in among the tiles take the floor next to them
+	floors := make([]LayoutDef, len(placed))
+	for i, e := range placed {
+		floors[i] = textFloorNear(layout, e.Position)
+	}
+	starts := 0
+	for i, e := range placed {
+		row := height - 1 - e.Position.Y
+		if layout[row][e.Position.X] == (LayoutDef{}) {
+			layout[row][e.Position.X] = floors[i]
+		}
+		if def := layout[row][e.Position.X]; def.Type != "floor" {
+			return nil, fmt.Errorf("%v at row %v, column %v is on a %v tile instead of floor", e.Type, row+1, e.Position.X+1, def.Type)
+		}
+		switch e.Type {
+		case "PlayerStart":
+			starts++
+			e.Type = ""
+			if e.Direction == nil {
+				e.Direction = &Vec2{0, 1}
+			}
+			m.PlayerStart = e
+			continue
+		case "Scroll":
+			if n, ok := e.Value.(float64); ok {
+				e.Value = int(n)
+			}
+			n, _ := e.Value.(int)
+			if ScrollText[n] == "" {
+				return nil, fmt.Errorf("scroll at row %v, column %v needs a value from 1 to %v", row+1, e.Position.X+1, len(ScrollText))
+			}
+			e.Text = ScrollText[n]
+		case "Treasure":
+			if e.Value == nil {
+				e.Value = m.LevelNumber * 100 // as Convert does
+			}
+		case "WarpGate":
+			if e.Value == nil && m.LevelNumber >= 1 && m.LevelNumber < len(MapNames) {
+				e.Value = MapNames[m.LevelNumber]
+			}
+		}
+		m.Entities = append(m.Entities, e)
+	}
+	if starts != 1 {
+		return nil, fmt.Errorf("the map has %v PlayerStarts instead of one", starts)
+	}
+	if err := PairJumpGates(m.Entities); err != nil {
+		return nil, err
+	}
+
+	m.SetLayout(layout)
+	m.Fog = DefaultFog(m)
+	m.Regions = Segment(m)
+	return m, CheckMap(m)
+}
+
+func ImageImportCommand(args []string) {
+	flags := flag.NewFlagSet("image-import", flag.ExitOnError)
+	output := flags.String("o", "", "output map JSON file (default standard output)")
+	paletteFile := flags.String("palette", "", "JSON file of what each color stands for (default the one -print-palette shows)")
+	printPalette := flags.Bool("print-palette", false, "print the default palette and exit")
+	entities := flags.String("entities", "", "image of the entities on a transparent background, the same size as the map")
+	scale := flags.Int("scale", 1, "pixels per tile")
+	title := flags.String("title", "", "title")
+	levelNo := flags.Int("level", 0, "level number")
+	v1 := flags.Bool("v1", false, "write the version 1 format, which is limited to 52 kinds of tile")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json image-import [flags] map.png")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	palette := DefaultPalette()
+	if *printPalette {
+		out, err := MarshalIndent(palette)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(string(out))
+		return
+	}
+	if flags.NArg() != 1 || *scale < 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if *paletteFile != "" {
+		var err error
+		if palette, err = ReadPalette(*paletteFile); err != nil {
+			panic(err)
+		}
+	}
+
+	im := ImageMap{Palette: palette, Scale: *scale, Title: *title, LevelNumber: *levelNo}
+	m, err := im.Read(flags.Arg(0), *entities)
+	if err != nil {
+		panic(fmt.Errorf("%v: %v", flags.Arg(0), err))
+	}
+	if *v1 {
+		m.Version = 1
+	}
+	if *output != "" {
+		err = writeJSONFile(*output, m)
+	} else {
+		var out []byte
+		if out, err = MarshalIndent(m); err == nil {
+			fmt.Println(string(out))
+		}
+	}
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testImageColors are the default palette's colors for the glyphs in
+// writeTestImage's rows.
+var testImageColors = map[rune]string{
+	'#': hexColor(WallColors["stone"]),
+	'%': hexColor(darken(WallColors["stone"], 1, 2)),
+	'r': hexColor(DoorColors["red"]),
+	'.': hexColor(FloorColor),
+	'@': "#ffffff",
+	'1': "#40ffff",
+	'W': hexColor(EntityIcons["WarpGate"].Color),
+	'w': "#6080e1", // warp gate to level 1
+	'O': "#ff2020",
+	'?': "#f0e0b3",
+	'$': hexColor(EntityIcons["Treasure"].Color),
+}
+
+// writeTestImage draws rows of glyphs at a pixel each, with spaces left
+// transparent, and saves it as a PNG in dir.
+func writeTestImage(t *testing.T, dir, name string, rows ...string) string {
+	img := image.NewRGBA(image.Rect(0, 0, len(rows[0]), len(rows)))
+	for y, line := range rows {
+		for x, c := range line {
+			if c == ' ' {
+				continue
+			}
+			var r, g, b uint8
+			if _, err := fmt.Sscanf(testImageColors[c], "#%02x%02x%02x", &r, &g, &b); err != nil {
+				t.Fatalf("%q: %v", c, err)
+			}
+			img.Set(x, y, color.RGBA{r, g, b, 0xFF})
+		}
+	}
+	filename := filepath.Join(dir, name)
+	f, err := os.Create(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestImageMapRead(t *testing.T) {
+	dir := t.TempDir()
+	tiles := writeTestImage(t, dir, "tiles.png",
+		"#######",
+		"#@..1W#",
+		"#.O%..#",
+		"#1.r.$#",
+		"#######",
+	)
+	im := ImageMap{Palette: DefaultPalette(), Scale: 1, Title: "Test", LevelNumber: 3}
+	m, err := im.Read(tiles, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Width != 7 || m.Height != 5 {
+		t.Fatalf("map is %vx%v, want 7x5", m.Width, m.Height)
+	}
+	for _, test := range []struct {
+		p    Vec2
+		want LayoutDef
+	}{
+		{Vec2{0, 0}, Wall("stone")},
+		{Vec2{3, 2}, Exploding("stone")},
+		{Vec2{3, 1}, Door("red")},
+		{Vec2{2, 2}, Floor("")}, // under the orc
+		{Vec2{1, 3}, Floor("")}, // under the player start
+		{Vec2{5, 3}, Floor("")}, // under the warp gate
+	} {
+		if def, _ := m.Tile(test.p); def != test.want {
+			t.Errorf("tile at %v is %v, want %v", test.p, def, test.want)
+		}
+	}
+
+	if m.PlayerStart.Position != (Vec2{1, 3}) || *m.PlayerStart.Direction != (Vec2{0, 1}) {
+		t.Errorf("player starts at %v facing %v", m.PlayerStart.Position, *m.PlayerStart.Direction)
+	}
+	found := make(map[string][]Entity)
+	for _, e := range m.Entities {
+		found[e.Type] = append(found[e.Type], e)
+	}
+	if gates := found["JumpGate"]; len(gates) != 2 {
+		t.Errorf("%v jump gates, want 2", len(gates))
+	} else {
+		for i, g := range gates {
+			if dest, _ := ValueVec2(g.Value); dest != gates[1-i].Position {
+				t.Errorf("jump gate at %v leads to %v, want %v", g.Position, g.Value, gates[1-i].Position)
+			}
+		}
+	}
+	if warps := found["WarpGate"]; len(warps) != 1 || warps[0].Value != MapNames[3] {
+		t.Errorf("warp gates %v, want one to %v", warps, MapNames[3])
+	}
+	if orcs := found["Orc"]; len(orcs) != 1 || orcs[0].Position != (Vec2{2, 2}) {
+		t.Errorf("orcs %v, want one at (2, 2)", orcs)
+	}
+	if treasure := found["Treasure"]; len(treasure) != 1 || treasure[0].Value != 300 {
+		t.Errorf("treasure %v, want one worth 300", treasure)
+	}
+}
+
+// TestImageMapReadEntities checks entities can be drawn in an image of
+// their own over the tiles.
+func TestImageMapReadEntities(t *testing.T) {
+	dir := t.TempDir()
+	tiles := writeTestImage(t, dir, "tiles.png",
+		"#####",
+		"#...#",
+		"#####",
+	)
+	entities := writeTestImage(t, dir, "entities.png",
+		"     ",
+		" @Ow ",
+		"     ",
+	)
+	im := ImageMap{Palette: DefaultPalette(), Scale: 1, Title: "Test", LevelNumber: 5}
+	m, err := im.Read(tiles, entities)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.PlayerStart.Position != (Vec2{1, 1}) {
+		t.Errorf("player starts at %v, want (1, 1)", m.PlayerStart.Position)
+	}
+	want := []Entity{{Type: "Orc", Position: Vec2{2, 1}}, {Type: "WarpGate", Position: Vec2{3, 1}, Value: MapNames[0]}}
+	if len(m.Entities) != len(want) {
+		t.Fatalf("entities %v, want %v", m.Entities, want)
+	}
+	for i, e := range m.Entities {
+		if !sameFields(e, want[i]) || e.Position != want[i].Position {
+			t.Errorf("entity %v, want %v", e, want[i])
+		}
+	}
+}
+
+func TestImageMapReadErrors(t *testing.T) {
+	noScrollValue := DefaultPalette()
+	noScrollValue.Entities["#f0e0b3"] = PaletteEntity{Type: "Scroll"}
+	badScrollValue := DefaultPalette()
+	badScrollValue.Entities["#f0e0b3"] = PaletteEntity{Type: "Scroll", Value: len(ScrollText) + 1}
+	for _, test := range []struct {
+		name     string
+		palette  Palette
+		tiles    []string
+		entities []string
+		want     string
+	}{
+		{"entity on a wall", DefaultPalette(), []string{
+			"#####",
+			"#...#",
+			"#####",
+		}, []string{
+			"     ",
+			" @ w ",
+			" O   ",
+		}, "instead of floor"},
+		{"unknown color", DefaultPalette(), []string{
+			"#####",
+			"#@ w#",
+			"#####",
+		}, nil, "transparent"},
+		{"no player start", DefaultPalette(), []string{
+			"#####",
+			"#..w#",
+			"#####",
+		}, nil, "PlayerStart"},
+		{"one jump gate", DefaultPalette(), []string{
+			"#####",
+			"#@1w#",
+			"#####",
+		}, nil, "jump gate"},
+		{"scroll without a value", noScrollValue, []string{
+			"#####",
+			"#@?w#",
+			"#####",
+		}, nil, "scroll"},
+		{"scroll past the last", badScrollValue, []string{
+			"#####",
+			"#@?w#",
+			"#####",
+		}, nil, "scroll"},
+	} {
+		dir := t.TempDir()
+		tiles := writeTestImage(t, dir, "tiles.png", test.tiles...)
+		entities := ""
+		if test.entities != nil {
+			entities = writeTestImage(t, dir, "entities.png", test.entities...)
+		}
+		im := ImageMap{Palette: test.palette, Scale: 1, Title: "Test", LevelNumber: 1}
+		if _, err := im.Read(tiles, entities); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%v: error %v, want one about %q", test.name, err, test.want)
+		}
+	}
+}
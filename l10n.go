@@ -0,0 +1,420 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	Commands["l10n-extract"] = L10nExtractCommand
+	Commands["l10n-merge"] = L10nMergeCommand
+}
+
+// Message is one translatable string. Context is a stable ID for where the
+// string is used, so identical English text in different places can be
+// translated differently.
+type Message struct {
+	Context     string
+	ID          string
+	Translation string
+	References  []string
+	Fuzzy       bool
+}
+
+func (m *Message) key() string {
+	return m.Context + "\x04" + m.ID // gettext's own context separator
+}
+
+// Catalog is a set of messages in the order they were first added.
+type Catalog struct {
+	Messages []*Message
+	index    map[string]*Message
+}
+
+func NewCatalog() *Catalog {
+	return &Catalog{index: make(map[string]*Message)}
+}
+
+func (c *Catalog) Add(context, id, reference string) {
+	if id == "" {
+		return
+	}
+	msg := &Message{Context: context, ID: id}
+	if existing, ok := c.index[msg.key()]; ok {
+		msg = existing
+	} else {
+		c.Messages = append(c.Messages, msg)
+		c.index[msg.key()] = msg
+	}
+	for _, ref := range msg.References {
+		if ref == reference {
+			return
+		}
+	}
+	msg.References = append(msg.References, reference)
+}
+
+// Translate returns the translation of id in context, or id itself if the
+// catalog has no finished translation for it.
+func (c *Catalog) Translate(context, id string) string {
+	msg, ok := c.index[(&Message{Context: context, ID: id}).key()]
+	if !ok || msg.Fuzzy || msg.Translation == "" {
+		return id
+	}
+	return msg.Translation
+}
+
+func levelContext(levelNo int, what string) string {
+	return fmt.Sprintf("level%02d/%v", levelNo, what)
+}
+
+func scrollContext(scroll interface{}) string {
+	return fmt.Sprintf("scroll/%v", scroll)
+}
+
+func textContext(chunk, page, element int) string {
+	return fmt.Sprintf("text/%v/%v/%v", chunk, page, element)
+}
+
+func screenContext(chunk int) string {
+	return fmt.Sprintf("text/%v/screen", chunk)
+}
+
+// AddMap adds a converted map's title, floor descriptions and scroll
+// messages to the catalog.
+func (c *Catalog) AddMap(m *JsonMap, reference string) {
+	c.Add(levelContext(m.LevelNumber, "title"), m.Title, reference)
+	var floors []string
+	for _, def := range m.Legend {
+		if def.Type == "floor" {
+			floors = append(floors, def.Value)
+		}
+	}
+	sort.Strings(floors)
+	for _, desc := range floors {
+		c.Add(levelContext(m.LevelNumber, "location"), desc, reference)
+	}
+	for _, e := range m.Entities {
+		if e.Type == "Scroll" {
+			c.Add(scrollContext(e.Value), e.Text, reference)
+		}
+	}
+}
+
+// TextAsset is a text chunk as written by extracted_assets/parse_text.go. It
+// is kept generic so fields this program does not know about survive a
+// merge untouched.
+type TextAsset map[string]interface{}
+
+func (t TextAsset) chunk() int {
+	n, _ := t["chunk"].(float64)
+	return int(n)
+}
+
+// texts calls fn with the context and value of each translatable string
+// in the asset. fn returns the replacement value.
+func (t TextAsset) texts(fn func(context, text string) string) {
+	chunk := t.chunk()
+	switch t["kind"] {
+	case "screen":
+		lines, _ := t["lines"].([]interface{})
+		var text []string
+		for _, line := range lines {
+			s, _ := line.(string)
+			text = append(text, s)
+		}
+		var replaced []interface{}
+		for _, line := range strings.Split(fn(screenContext(chunk), strings.Join(text, "\n")), "\n") {
+			replaced = append(replaced, line)
+		}
+		t["lines"] = replaced
+	case "pages":
+		pages, _ := t["pages"].([]interface{})
+		for p, page := range pages {
+			page, _ := page.(map[string]interface{})
+			elements, _ := page["elements"].([]interface{})
+			for e, elem := range elements {
+				elem, _ := elem.(map[string]interface{})
+				if text, ok := elem["text"].(string); ok && elem["type"] == "text" {
+					elem["text"] = fn(textContext(chunk, p, e), text)
+				}
+			}
+		}
+	}
+	// Line lists are level text, which is extracted from the maps instead.
+}
+
+func ReadTextAssets(dir string) (assets []TextAsset, names []string, err error) {
+	if dir == "" {
+		return nil, nil, nil
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, name := range files {
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		var asset TextAsset
+		if err := json.Unmarshal(data, &asset); err != nil {
+			return nil, nil, fmt.Errorf("%v: %v", name, err)
+		}
+		assets = append(assets, asset)
+		names = append(names, name)
+	}
+	return assets, names, nil
+}
+
+func ReadJsonMap(filename string) (*JsonMap, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var m JsonMap
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("%v: %v", filename, err)
+	}
+	return &m, nil
+}
+
+func poQuote(s string) string {
+	s = strings.Replace(s, "\\", "\\\\", -1)
+	s = strings.Replace(s, "\"", "\\\"", -1)
+	s = strings.Replace(s, "\t", "\\t", -1)
+	s = strings.Replace(s, "\n", "\\n", -1)
+	return "\"" + s + "\""
+}
+
+// poString writes a PO keyword and string, breaking multi-line strings after
+// each newline as gettext tools do.
+func poString(w io.Writer, keyword, s string) {
+	lines := strings.SplitAfter(s, "\n")
+	if len(lines) > 1 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 1 {
+		fmt.Fprintf(w, "%v %v\n", keyword, poQuote(s))
+		return
+	}
+	fmt.Fprintf(w, "%v \"\"\n", keyword)
+	for _, line := range lines {
+		fmt.Fprintln(w, poQuote(line))
+	}
+}
+
+func (c *Catalog) WritePO(w io.Writer) {
+	fmt.Fprintln(w, "msgid \"\"")
+	fmt.Fprintln(w, "msgstr \"\"")
+	fmt.Fprintln(w, "\"Content-Type: text/plain; charset=UTF-8\\n\"")
+	for _, msg := range c.Messages {
+		fmt.Fprintln(w)
+		for _, ref := range msg.References {
+			fmt.Fprintf(w, "#: %v\n", ref)
+		}
+		if msg.Fuzzy {
+			fmt.Fprintln(w, "#, fuzzy")
+		}
+		poString(w, "msgctxt", msg.Context)
+		poString(w, "msgid", msg.ID)
+		poString(w, "msgstr", msg.Translation)
+	}
+}
+
+// ReadPO parses a gettext catalog. Only the features WritePO produces, plus
+// the fuzzy flag translators set, are understood.
+func ReadPO(r io.Reader) (*Catalog, error) {
+	c := NewCatalog()
+	scanner := bufio.NewScanner(r)
+	var msg *Message
+	var field *string
+	lineNo := 0
+	finish := func() {
+		if msg != nil && msg.ID != "" {
+			c.Messages = append(c.Messages, msg)
+			c.index[msg.key()] = msg
+		}
+		msg, field = nil, nil
+	}
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			finish()
+			continue
+		}
+		if msg == nil {
+			msg = &Message{}
+		}
+		if strings.HasPrefix(line, "#,") {
+			msg.Fuzzy = strings.Contains(line, "fuzzy")
+			continue
+		} else if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "\"") {
+			if field == nil {
+				return nil, fmt.Errorf("line %v: string continues nothing", lineNo)
+			}
+		} else {
+			tokens := strings.SplitN(line, " ", 2)
+			switch tokens[0] {
+			case "msgctxt":
+				if msg.ID != "" || msg.Translation != "" {
+					finish()
+					msg = &Message{}
+				}
+				field = &msg.Context
+			case "msgid":
+				field = &msg.ID
+			case "msgstr":
+				field = &msg.Translation
+			default:
+				return nil, fmt.Errorf("line %v: unsupported keyword %v", lineNo, tokens[0])
+			}
+			if len(tokens) < 2 {
+				return nil, fmt.Errorf("line %v: %v has no string", lineNo, tokens[0])
+			}
+			line = tokens[1]
+		}
+		s, err := strconv.Unquote(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %v", lineNo, err)
+		}
+		*field += s
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	finish()
+	return c, nil
+}
+
+func writeJSONFile(filename string, v interface{}) error {
+	out, err := MarshalIndent(v)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, append(out, '\n'), 0666)
+}
+
+func L10nExtractCommand(args []string) {
+	flags := flag.NewFlagSet("l10n-extract", flag.ExitOnError)
+	output := flags.String("o", "", "write the catalog template to this file instead of stdout")
+	textDir := flags.String("text", "", "directory of text chunks written by parse_text.go")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json l10n-extract [flags] map.json...")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	catalog := NewCatalog()
+	for _, name := range flags.Args() {
+		m, err := ReadJsonMap(name)
+		if err != nil {
+			panic(err)
+		}
+		catalog.AddMap(m, filepath.Base(name))
+	}
+	assets, names, err := ReadTextAssets(*textDir)
+	if err != nil {
+		panic(err)
+	}
+	for i, asset := range assets {
+		ref := filepath.Base(names[i])
+		asset.texts(func(context, text string) string {
+			catalog.Add(context, text, ref)
+			return text
+		})
+	}
+
+	w := os.Stdout
+	if *output != "" {
+		w, err = os.Create(*output)
+		if err != nil {
+			panic(err)
+		}
+		defer w.Close()
+	}
+	catalog.WritePO(w)
+}
+
+func L10nMergeCommand(args []string) {
+	flags := flag.NewFlagSet("l10n-merge", flag.ExitOnError)
+	poFile := flags.String("po", "", "translated catalog")
+	outdir := flags.String("o", "", "directory to write translated maps and text to")
+	textDir := flags.String("text", "", "directory of text chunks written by parse_text.go")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json l10n-merge -po lang.po -o dir [flags] map.json...")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if *poFile == "" || *outdir == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(*poFile)
+	if err != nil {
+		panic(err)
+	}
+	catalog, err := ReadPO(f)
+	f.Close()
+	if err != nil {
+		panic(fmt.Errorf("%v: %v", *poFile, err))
+	}
+	if err := os.MkdirAll(*outdir, 0755); err != nil {
+		panic(err)
+	}
+
+	for _, name := range flags.Args() {
+		m, err := ReadJsonMap(name)
+		if err != nil {
+			panic(err)
+		}
+		m.Title = catalog.Translate(levelContext(m.LevelNumber, "title"), m.Title)
+		for key, def := range m.Legend {
+			if def.Type == "floor" {
+				def.Value = catalog.Translate(levelContext(m.LevelNumber, "location"), def.Value)
+				m.Legend[key] = def
+			}
+		}
+		for i, r := range m.Regions {
+			m.Regions[i].Description = catalog.Translate(levelContext(m.LevelNumber, "location"), r.Description)
+		}
+		for i, e := range m.Entities {
+			if e.Type == "Scroll" {
+				m.Entities[i].Text = catalog.Translate(scrollContext(e.Value), e.Text)
+			}
+		}
+		if err := writeJSONFile(filepath.Join(*outdir, filepath.Base(name)), m); err != nil {
+			panic(err)
+		}
+	}
+
+	assets, names, err := ReadTextAssets(*textDir)
+	if err != nil {
+		panic(err)
+	}
+	if len(assets) > 0 {
+		if err := os.MkdirAll(filepath.Join(*outdir, "text"), 0755); err != nil {
+			panic(err)
+		}
+	}
+	for i, asset := range assets {
+		asset.texts(catalog.Translate)
+		if err := writeJSONFile(filepath.Join(*outdir, "text", filepath.Base(names[i])), asset); err != nil {
+			panic(err)
+		}
+	}
+}
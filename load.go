@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// MapLoader reads levels from either .c3dmap files or map JSON, so commands
+// can work on the original data and on converted or hand-edited maps alike.
+type MapLoader struct {
+	Assets   string // directory holding EGAGRAPH.C3D, for .c3dmap files
+	graphics *Asset
+}
+
+func (l *MapLoader) Load(filename string) (*JsonMap, error) {
+	if strings.EqualFold(filepath.Ext(filename), ".c3dmap") {
+		if l.graphics == nil {
+			graphics, err := OpenEGAGraph(l.Assets)
+			if err != nil {
+				return nil, err
+			}
+			l.graphics = graphics
+		}
+		return ConvertFile(filename, l.graphics), nil
+	}
+	return ReadJsonMap(filename)
+}
+
+// Expand replaces each directory in args with the maps in it: its .c3dmap
+// files if it has any, otherwise its .map.json files.
+func (l *MapLoader) Expand(args []string) ([]string, error) {
+	var filenames []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			filenames = append(filenames, arg)
+			continue
+		}
+		found, _ := filepath.Glob(filepath.Join(arg, "*.c3dmap"))
+		if len(found) == 0 {
+			found, _ = filepath.Glob(filepath.Join(arg, "*.map.json"))
+		}
+		if len(found) == 0 {
+			return nil, fmt.Errorf("%v has no maps", arg)
+		}
+		filenames = append(filenames, found...)
+	}
+	return filenames, nil
+}
+
+// LoadAll loads every map named by args, sorted by level number.
+func (l *MapLoader) LoadAll(args []string) ([]*JsonMap, error) {
+	filenames, err := l.Expand(args)
+	if err != nil {
+		return nil, err
+	}
+	var maps []*JsonMap
+	for _, filename := range filenames {
+		m, err := l.Load(filename)
+		if err != nil {
+			return nil, err
+		}
+		maps = append(maps, m)
+	}
+	sort.SliceStable(maps, func(i, j int) bool {
+		return maps[i].LevelNumber < maps[j].LevelNumber
+	})
+	return maps, nil
+}
@@ -3,13 +3,13 @@ package main
 // TODO: omit unreachable tiles?
 
 import (
-	"bufio"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -74,20 +74,11 @@ func ReadC3DMap(filename string) C3DMap {
 	return m
 }
 
-func ReadDescriptions(filename string) (descriptions []string) {
-	file, err := os.Open(filename)
-	if err != nil {
-		panic(err)
-	}
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		descriptions = append(descriptions, scanner.Text())
-	}
-	if err := scanner.Err(); err != nil {
-		panic(err)
-	}
-	return
+// Bytes encodes the map the way ReadC3DMap reads it.
+func (m C3DMap) Bytes() []byte {
+	data := []byte{byte(m.Width), byte(m.Height)}
+	data = append(data, m.Layout...)
+	return append(data, m.Entities...)
 }
 
 type LayoutDef struct {
@@ -111,16 +102,62 @@ func Floor(desc string) LayoutDef {
 	return LayoutDef{"floor", desc}
 }
 
+// JsonMap is the level format the game loads. Layout holds a row for each
+// line of the map from north to south, and each tile is an index into Legend.
+// See format.go for how it is written as JSON.
 type JsonMap struct {
-	Title       string               `json:"title"`
-	LevelNumber int                  `json:"levelNumber"`
-	Width       uint                 `json:"width"`
-	Height      uint                 `json:"height"`
-	Layout      []string             `json:"layout"`
-	Legend      map[string]LayoutDef `json:"legend"`
-	PlayerStart Entity               `json:"playerStart"`
-	Entities    []Entity             `json:"entities"`
-	Fog         *Fog                 `json:"fog,omitempty"`
+	Version     int         `json:"version"`
+	Title       string      `json:"title"`
+	LevelNumber int         `json:"levelNumber"`
+	Width       uint        `json:"width"`
+	Height      uint        `json:"height"`
+	Layout      [][]int     `json:"layout"`
+	Legend      []LayoutDef `json:"legend"`
+	PlayerStart Entity      `json:"playerStart"`
+	Entities    []Entity    `json:"entities"`
+	Fog         *Fog        `json:"fog,omitempty"`
+
+	AmbientLight *uint32 `json:"ambientLight,omitempty"` // RGB color, white if unset
+	Music        string  `json:"music,omitempty"`        // audio file to loop, relative to build/
+
+	Regions []Region `json:"regions,omitempty"` // see Segment
+
+	// RandomizerSeed is the seed of the randomize command that placed the
+	// items, if it did.
+	RandomizerSeed *int64 `json:"randomizerSeed,omitempty"`
+}
+
+// Tile returns the legend entry at a position, which counts Y up from the
+// last row like Entity.Position does.
+func (m *JsonMap) Tile(p Vec2) (LayoutDef, bool) {
+	if p.X < 0 || p.Y < 0 || p.X >= int(m.Width) || p.Y >= int(m.Height) {
+		return LayoutDef{}, false
+	}
+	return m.Legend[m.Layout[int(m.Height)-1-p.Y][p.X]], true
+}
+
+// SetLayout replaces a map's layout and legend with rows of legend entries,
+// top row first, numbering the entries in order of first appearance.
+func (m *JsonMap) SetLayout(rows [][]LayoutDef) {
+	kinds := make(map[LayoutDef]int)
+	m.Legend = nil
+	m.Layout = make([][]int, len(rows))
+	for row := range rows {
+		m.Layout[row] = make([]int, len(rows[row]))
+		for x, def := range rows[row] {
+			kind, ok := kinds[def]
+			if !ok {
+				kind = len(m.Legend)
+				kinds[def] = kind
+				m.Legend = append(m.Legend, def)
+			}
+			m.Layout[row][x] = kind
+		}
+	}
+	m.Height = uint(len(rows))
+	if len(rows) > 0 {
+		m.Width = uint(len(rows[0]))
+	}
 }
 
 var LayoutDict = map[byte]LayoutDef{
@@ -153,11 +190,16 @@ type Vec2 struct {
 	Y int `json:"y"`
 }
 
+func (v Vec2) String() string {
+	return fmt.Sprintf("(%v, %v)", v.X, v.Y)
+}
+
 type Entity struct {
 	Type          string      `json:"type,omitempty"`
 	Position      Vec2        `json:"position"`
 	Direction     *Vec2       `json:"direction,omitempty"`
 	Value         interface{} `json:"value,omitempty"`
+	Text          string      `json:"text,omitempty"`
 	MinDifficulty int         `json:"minDifficulty,omitempty"`
 }
 
@@ -212,48 +254,165 @@ var EntityDict = map[byte]Entity{
 	0x2D: Entity{Type: "Mage", MinDifficulty: 2},
 }
 
+// ScrollText is the message on each numbered scroll. The game draws scroll
+// messages as pictures, scroll n in EGAGRAPH chunk 27+n below the rolled top
+// in chunk 27, instead of keeping them with the level text, so they are
+// transcribed here. Every level shares the same eight scrolls.
+var ScrollText = map[int]string{
+	1: "Woe be to he that entereth my domain.\n\nNemesis the Lich, Esq.",
+	2: "In the Labyrinth, follow the right wall until the X, then follow the left.\n\nK. Dawnstalker",
+	3: "In the Council of the Planes, there are twenty-three seats.",
+	4: "The door is the number.",
+	5: "The number is the number of the Council.",
+	6: "Beware the false gates.\n\nThe true way has no valuable friends.",
+	7: "Do not veer off the path or you will die.",
+	8: "Knock, knock.\nWho's there?\nA spectre.\nA spectre who?\nStupid. Spectres can't knock on doors.",
+}
+
 type Fog struct {
 	Color uint32  `json:"color"`
 	Near  float32 `json:"near"`
 	Far   float32 `json:"far"`
 }
 
+// DefaultFog is the fog for a level without its own: far enough away to see
+// across most of it, and red in the last two levels.
+func DefaultFog(m *JsonMap) *Fog {
+	far := float32(m.Width)
+	if m.Height > m.Width {
+		far = float32(m.Height)
+	}
+	far *= 1.25
+	if far < 40 {
+		far = 40
+	}
+	fog := Fog{
+		Color: 0x000000,
+		Near:  1,
+		Far:   far,
+	}
+	if m.LevelNumber >= 19 {
+		fog.Color = 0xFF0000
+	}
+	return &fog
+}
+
+// Commands are the subcommands map2json runs when one is named as its first
+// argument. Without one, map2json converts a single map.
+var Commands = make(map[string]func(args []string))
+
 func main() {
+	if len(os.Args) > 1 {
+		if command, ok := Commands[os.Args[1]]; ok {
+			command(os.Args[2:])
+			return
+		}
+	}
+
 	indent := flag.Bool("indent", false, "indent JSON output")
+	assets := flag.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	v1 := flag.Bool("v1", false, "write the version 1 format, which is limited to 52 kinds of tile")
+	overridesFile := flag.String("overrides", "", "JSON file of per-level settings to use instead of the defaults")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: map2json [flags] map.c3dmap")
+		fmt.Fprintln(flag.CommandLine.Output(), "       map2json command [flags] [args]")
+		flag.PrintDefaults()
+		var names []string
+		for name := range Commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintln(flag.CommandLine.Output(), "commands:", strings.Join(names, ", "))
+	}
 	flag.Parse()
-	c3dmap := ReadC3DMap(flag.Arg(0))
-	descriptions := ReadDescriptions(flag.Arg(1))
-	for i, desc := range descriptions {
-		LayoutDict[byte(0xB4+i)] = Floor(desc)
+
+	graphics, err := OpenEGAGraph(*assets)
+	if err != nil {
+		panic(err)
+	}
+	m := ConvertFile(flag.Arg(0), graphics)
+	if *overridesFile != "" {
+		overrides, err := ReadOverrides(*overridesFile)
+		if err != nil {
+			panic(err)
+		}
+		overrides[m.LevelNumber].Apply(m)
+	}
+	if *v1 {
+		m.Version = 1
 	}
-	nextRune := 'A'
-	byteToLetter := make(map[byte]string)
-	letterToDef := make(map[string]LayoutDef)
 
-	c3dname := filepath.Base(flag.Arg(0))
+	var out []byte
+	if *indent {
+		out, err = MarshalIndent(m)
+	} else {
+		out, err = json.Marshal(m)
+	}
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(out))
+}
+
+// ParseMapFilename splits a name like "1_The_Approach.c3dmap", as written by
+// extracted_assets/dump_gamemaps.go, into the level number and title.
+func ParseMapFilename(filename string) (levelNo int, title string, err error) {
+	c3dname := filepath.Base(filename)
 	c3dname = strings.TrimSuffix(c3dname, filepath.Ext(c3dname))
 	nameTokens := strings.SplitN(strings.Replace(c3dname, "_", " ", -1), " ", 2)
-	levelNo, err := strconv.Atoi(nameTokens[0])
+	levelNo, err = strconv.Atoi(nameTokens[0])
+	if err != nil || len(nameTokens) < 2 {
+		return 0, "", fmt.Errorf("%v: file name must be the level number and title, like 1_The_Approach.c3dmap", filename)
+	}
+	return levelNo, nameTokens[1], nil
+}
+
+// ConvertFile converts a .c3dmap file, taking floor descriptions from the
+// level's text chunk.
+func ConvertFile(filename string, graphics *Asset) *JsonMap {
+	c3dmap := ReadC3DMap(filename)
+	levelNo, title, err := ParseMapFilename(filename)
 	if err != nil {
 		panic(err)
 	}
+	descriptions, err := graphics.LevelText(levelNo)
+	if err != nil {
+		panic(err)
+	}
+	return Convert(c3dmap, levelNo, title, descriptions)
+}
+
+// Convert builds the JSON form of a level. descriptions is the level's
+// text, as returned by Asset.LevelText.
+func Convert(c3dmap C3DMap, levelNo int, title string, descriptions []string) *JsonMap {
+	layoutDict := make(map[byte]LayoutDef)
+	for b, def := range LayoutDict {
+		layoutDict[b] = def
+	}
+	for i, desc := range descriptions {
+		if !IsPlaceholder(desc, i) {
+			layoutDict[byte(0xB4+i)] = Floor(desc)
+		}
+	}
+	byteToKind := make(map[byte]int)
 
 	// Treasure is worth more on later levels
 	treasure := EntityDict[0x15]
 	treasure.Value = levelNo * 100
-	EntityDict[0x15] = treasure
 
-	m := JsonMap{
-		Title:       nameTokens[1],
+	m := &JsonMap{
+		Version:     CurrentVersion,
+		Title:       title,
 		LevelNumber: levelNo,
 		Width:       c3dmap.Width,
 		Height:      c3dmap.Height,
-		Layout:      make([]string, c3dmap.Height),
+		Layout:      make([][]int, c3dmap.Height),
 	}
 
 	jumpGates := make(map[interface{}]int) // Index of existing jump gates
 
 	for h := 0; h < int(m.Height); h++ {
+		m.Layout[h] = make([]int, m.Width)
 		for w := 0; w < int(m.Width); w++ {
 			idx := w + h*int(m.Width)
 			b := c3dmap.Layout[idx]
@@ -264,7 +423,11 @@ func main() {
 			position := Vec2{w, int(m.Height) - 1 - h}
 
 			// Entity (plane 2)
-			if entity, exists := EntityDict[e]; exists {
+			entity, exists := EntityDict[e]
+			if e == 0x15 {
+				entity = treasure
+			}
+			if exists {
 				entity.Position = position
 				if entity.Type == "JumpGate" {
 					if g, exists := jumpGates[entity.Value]; exists {
@@ -275,15 +438,17 @@ func main() {
 					} else {
 						jumpGates[entity.Value] = len(m.Entities)
 					}
+				} else if entity.Type == "Scroll" {
+					entity.Text = ScrollText[entity.Value.(int)]
 				} else if entity.Type == "WarpGate" {
-					levelNo = int(b - 0xB4) // Plane 0 value denotes destination
-					if levelNo == 0 {
-						levelNo = m.LevelNumber + 1
+					dest := int(b - 0xB4) // Plane 0 value denotes destination
+					if dest == 0 {
+						dest = m.LevelNumber + 1
 					}
-					if levelNo < 0 || levelNo > 20 {
+					if dest < 0 || dest > 20 {
 						panic(fmt.Sprintf("warp gate at %v is out of bounds (0x%x)", position, b))
 					}
-					entity.Value = MapNames[levelNo-1]
+					entity.Value = MapNames[dest-1]
 
 					// Set plane 0 value to adjacent floor description
 					var adjacentFloor byte
@@ -311,54 +476,133 @@ func main() {
 			}
 
 			// Layout (plane 0)
-			if s, ok := byteToLetter[b]; ok {
-				m.Layout[h] += s
-			} else {
-				def, exist := LayoutDict[b]
-				if !exist {
+			kind, ok := byteToKind[b]
+			if !ok {
+				def, exist := layoutDict[b]
+				if !exist && b >= 0xB4 {
+					panic(fmt.Sprintf("floor 0x%x at %v uses description %v, which level %v's text does not define", b, position, b-0xB4, m.LevelNumber))
+				} else if !exist {
 					panic(fmt.Sprintf("LayoutDef for 0x%x at %v does not exist", b, position))
 				}
-				s := string(nextRune)
-				letterToDef[s] = def
-				if nextRune == 'Z' {
-					nextRune = 'a'
-				} else {
-					nextRune += 1
-				}
-				m.Layout[h] += s
-				byteToLetter[b] = s
+				kind = len(m.Legend)
+				m.Legend = append(m.Legend, def)
+				byteToKind[b] = kind
 			}
+			m.Layout[h][w] = kind
 		}
 	}
-	m.Legend = letterToDef
 
-	// Fog
-	far := float32(m.Width)
-	if m.Height > m.Width {
-		far = float32(m.Height)
-	}
-	far *= 1.25
-	if far < 40 {
-		far = 40
+	m.Fog = DefaultFog(m)
+	m.Regions = Segment(m)
+	return m
+}
+
+// Encode is the reverse of Convert, building the original form of a level.
+// Floors are numbered by their line in descriptions, the level's text, and
+// any descriptions it lacks are added to the end of the returned copy,
+// which then needs to replace the level's text for them to show up. A warp
+// gate's floor is replaced by its destination, as the game expects, and
+// treasure is worth what the level number makes it.
+func Encode(m *JsonMap, descriptions []string) (C3DMap, []string, error) {
+	descriptions = append([]string(nil), descriptions...)
+	if len(descriptions) == 0 {
+		descriptions = []string{""}
 	}
-	fog := Fog{
-		Color: 0x000000,
-		Near:  1,
-		Far:   far,
+	tileBytes := make(map[LayoutDef]byte)
+	for b, def := range LayoutDict {
+		if old, ok := tileBytes[def]; !ok || b < old {
+			tileBytes[def] = b
+		}
 	}
-	if m.LevelNumber >= 19 {
-		fog.Color = 0xFF0000
+	c3dmap := C3DMap{m.Width, m.Height, make([]byte, m.Width*m.Height), make([]byte, m.Width*m.Height)}
+	for row := range m.Layout {
+		for x, kind := range m.Layout[row] {
+			def := m.Legend[kind]
+			b, ok := tileBytes[def]
+			if def.Type == "floor" && def.Value != "" {
+				n := 1
+				for n < len(descriptions) && (descriptions[n] != def.Value || IsPlaceholder(descriptions[n], n)) {
+					n++
+				}
+				if n == len(descriptions) {
+					descriptions = append(descriptions, def.Value)
+				}
+				b, ok = byte(0xB4+n), 0xB4+n <= 0xFF
+			}
+			if !ok {
+				return C3DMap{}, nil, fmt.Errorf("%v at row %v, column %v has no byte in the original format", TileTitle(def), row, x)
+			}
+			c3dmap.Layout[row*int(m.Width)+x] = b
+		}
 	}
-	m.Fog = &fog
 
-	var out []byte
-	if *indent {
-		out, err = json.MarshalIndent(m, "", "\t")
-	} else {
-		out, err = json.Marshal(m)
+	entityBytes := make(map[string]byte)
+	key := func(e Entity) string {
+		direction := ""
+		if e.Direction != nil {
+			direction = e.Direction.String()
+		}
+		value := ""
+		if e.Type == "Scroll" {
+			value = fmt.Sprint(e.Value)
+		}
+		return fmt.Sprintf("%v %v %v %v", e.Type, direction, value, e.MinDifficulty)
 	}
-	if err != nil {
-		panic(err)
+	for b, e := range EntityDict {
+		if e.Type != "JumpGate" {
+			entityBytes[key(e)] = b
+		}
 	}
-	fmt.Println(string(out))
+	start := m.PlayerStart
+	start.Type = "PlayerStart"
+	gates := make(map[Vec2]byte) // by position of the gate waiting for its partner
+	nextGate := byte(0x1F)
+	for _, e := range append([]Entity{start}, m.Entities...) {
+		i := (int(m.Height)-1-e.Position.Y)*int(m.Width) + e.Position.X
+		if e.Position.X < 0 || e.Position.Y < 0 || e.Position.X >= int(m.Width) || e.Position.Y >= int(m.Height) {
+			return C3DMap{}, nil, fmt.Errorf("%v is outside the map", EntityTitle(e))
+		}
+		if c3dmap.Entities[i] != 0 {
+			return C3DMap{}, nil, fmt.Errorf("%v shares its tile with another entity", EntityTitle(e))
+		}
+		if e.Type == "Scroll" {
+			// JSON numbers are float64, but EntityDict's are int
+			if v, ok := e.Value.(float64); ok {
+				e.Value = int(v)
+			}
+		}
+		b, ok := entityBytes[key(e)]
+		switch e.Type {
+		case "JumpGate":
+			dest, isVec := ValueVec2(e.Value)
+			if b, ok = gates[dest]; ok {
+				delete(gates, dest)
+			} else if isVec && nextGate <= 0x21 {
+				b, ok = nextGate, true
+				gates[e.Position] = b
+				nextGate++
+			}
+		case "Treasure":
+			b, ok = 0x15, true
+		case "WarpGate":
+			dest := 0
+			for n, name := range MapNames {
+				if name == e.Value {
+					dest = n + 1
+				}
+			}
+			if dest == 0 {
+				return C3DMap{}, nil, fmt.Errorf("%v leads to a level the original format can't", EntityTitle(e))
+			}
+			c3dmap.Layout[i] = byte(0xB4 + dest)
+		}
+		if !ok {
+			return C3DMap{}, nil, fmt.Errorf("%v has no byte in the original format", EntityTitle(e))
+		}
+		c3dmap.Entities[i] = b
+	}
+	if len(gates) > 0 {
+		return C3DMap{}, nil, fmt.Errorf("jump gates must lead to each other in pairs, at most three of them")
+	}
+	return c3dmap, descriptions, nil
 }
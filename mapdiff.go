@@ -0,0 +1,272 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+func init() {
+	Commands["mapdiff"] = MapDiffCommand
+}
+
+// MapDiff is what changed between two versions of a level, by meaning
+// rather than by bytes, so the legend can be reordered or the map converted
+// between formats without showing up.
+type MapDiff struct {
+	From       string           `json:"from"`
+	To         string           `json:"to"`
+	Properties []PropertyChange `json:"properties"`
+	Tiles      []TileChange     `json:"tiles"`
+	Added      []Entity         `json:"added"`
+	Removed    []Entity         `json:"removed"`
+	Moved      []EntityMove     `json:"moved"`
+	Changed    []EntityChange   `json:"changed"`
+}
+
+type PropertyChange struct {
+	Name string `json:"name"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type TileChange struct {
+	Position Vec2      `json:"position"`
+	From     LayoutDef `json:"from"`
+	To       LayoutDef `json:"to"`
+}
+
+type EntityMove struct {
+	Entity Entity `json:"entity"` // as it was
+	To     Vec2   `json:"to"`
+}
+
+// EntityChange is an entity that stayed where it was, but with a different
+// value (such as a warp's destination), direction, text or difficulty.
+type EntityChange struct {
+	Type     string `json:"type"`
+	Position Vec2   `json:"position"`
+	Field    string `json:"field"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+}
+
+// Empty reports whether the maps are the same.
+func (d *MapDiff) Empty() bool {
+	return len(d.Properties)+len(d.Tiles)+len(d.Added)+len(d.Removed)+len(d.Moved)+len(d.Changed) == 0
+}
+
+// entityFields returns what an entity has besides its type and position, in
+// a form that compares the same whether it was converted or read from JSON.
+func entityFields(e Entity) [][2]string {
+	value := ""
+	if v, ok := ValueVec2(e.Value); ok {
+		value = v.String()
+	} else if e.Value != nil {
+		value = fmt.Sprint(e.Value)
+	}
+	direction := ""
+	if e.Direction != nil {
+		direction = e.Direction.String()
+	}
+	return [][2]string{
+		{"value", value},
+		{"direction", direction},
+		{"text", e.Text},
+		{"minDifficulty", fmt.Sprint(e.MinDifficulty)},
+	}
+}
+
+func sameFields(a, b Entity) bool {
+	fa, fb := entityFields(a), entityFields(b)
+	for i := range fa {
+		if fa[i] != fb[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DiffMaps compares two maps. Entities are matched up first where nothing
+// changed, then by position where something about them did, and finally by
+// type and fields where they moved, choosing the nearest.
+func DiffMaps(a, b *JsonMap) *MapDiff {
+	d := &MapDiff{
+		Properties: []PropertyChange{},
+		Tiles:      []TileChange{},
+		Added:      []Entity{},
+		Removed:    []Entity{},
+		Moved:      []EntityMove{},
+		Changed:    []EntityChange{},
+	}
+	property := func(name string, from, to interface{}) {
+		if f, t := fmt.Sprint(from), fmt.Sprint(to); f != t {
+			d.Properties = append(d.Properties, PropertyChange{name, f, t})
+		}
+	}
+	fog := func(m *JsonMap) string {
+		if m.Fog == nil {
+			return "none"
+		}
+		return fmt.Sprintf("#%06x from %v to %v", m.Fog.Color, m.Fog.Near, m.Fog.Far)
+	}
+	light := func(m *JsonMap) string {
+		if m.AmbientLight == nil {
+			return "none"
+		}
+		return fmt.Sprintf("#%06x", *m.AmbientLight)
+	}
+	property("title", a.Title, b.Title)
+	property("levelNumber", a.LevelNumber, b.LevelNumber)
+	property("size", fmt.Sprintf("%vx%v", a.Width, a.Height), fmt.Sprintf("%vx%v", b.Width, b.Height))
+	property("fog", fog(a), fog(b))
+	property("ambientLight", light(a), light(b))
+	property("music", a.Music, b.Music)
+	seed := func(m *JsonMap) string {
+		if m.RandomizerSeed == nil {
+			return "none"
+		}
+		return fmt.Sprint(*m.RandomizerSeed)
+	}
+	property("randomizerSeed", seed(a), seed(b))
+
+	// tiles are compared where the maps overlap; the size change covers the rest
+	for y := int(a.Height) - 1; y >= 0; y-- {
+		for x := 0; x < int(a.Width); x++ {
+			p := Vec2{x, y}
+			from, _ := a.Tile(p)
+			if to, ok := b.Tile(p); ok && from != to {
+				d.Tiles = append(d.Tiles, TileChange{p, from, to})
+			}
+		}
+	}
+
+	withStart := func(m *JsonMap) []Entity {
+		start := m.PlayerStart
+		start.Type = "PlayerStart"
+		return append([]Entity{start}, m.Entities...)
+	}
+	removed, added := withStart(a), withStart(b)
+	match := func(same func(a, b Entity) bool, matched func(a, b Entity)) {
+		for i := 0; i < len(removed); i++ {
+			for j := range added {
+				if same(removed[i], added[j]) {
+					matched(removed[i], added[j])
+					removed = append(removed[:i], removed[i+1:]...)
+					added = append(added[:j], added[j+1:]...)
+					i--
+					break
+				}
+			}
+		}
+	}
+	match(func(a, b Entity) bool {
+		return a.Type == b.Type && a.Position == b.Position && sameFields(a, b)
+	}, func(a, b Entity) {})
+	match(func(a, b Entity) bool {
+		return a.Type == b.Type && a.Position == b.Position
+	}, func(a, b Entity) {
+		fb := entityFields(b)
+		for i, f := range entityFields(a) {
+			if f != fb[i] {
+				d.Changed = append(d.Changed, EntityChange{a.Type, a.Position, f[0], f[1], fb[i][1]})
+			}
+		}
+	})
+	// pair the closest moves first so swapping two of a kind reads sensibly
+	for {
+		best, bi, bj := -1.0, 0, 0
+		for i, r := range removed {
+			for j, e := range added {
+				distance := math.Abs(float64(r.Position.X-e.Position.X)) + math.Abs(float64(r.Position.Y-e.Position.Y))
+				if r.Type == e.Type && sameFields(r, e) && (best < 0 || distance < best) {
+					best, bi, bj = distance, i, j
+				}
+			}
+		}
+		if best < 0 {
+			break
+		}
+		d.Moved = append(d.Moved, EntityMove{removed[bi], added[bj].Position})
+		removed = append(removed[:bi], removed[bi+1:]...)
+		added = append(added[:bj], added[bj+1:]...)
+	}
+	d.Removed = append(d.Removed, removed...)
+	d.Added = append(d.Added, added...)
+	return d
+}
+
+// oneLine keeps an entity on one line even if its text has several.
+func oneLine(e Entity) string {
+	return strings.Join(strings.Fields(EntityTitle(e)), " ")
+}
+
+// WriteText writes the differences a line each, marked like a unified diff.
+func (d *MapDiff) WriteText(w io.Writer) {
+	fmt.Fprintf(w, "--- %v\n+++ %v\n", d.From, d.To)
+	for _, p := range d.Properties {
+		fmt.Fprintf(w, "~ %v: %v -> %v\n", p.Name, p.From, p.To)
+	}
+	for _, t := range d.Tiles {
+		fmt.Fprintf(w, "~ tile at %v: %v -> %v\n", t.Position, TileTitle(t.From), TileTitle(t.To))
+	}
+	for _, e := range d.Removed {
+		fmt.Fprintf(w, "- %v\n", oneLine(e))
+	}
+	for _, e := range d.Added {
+		fmt.Fprintf(w, "+ %v\n", oneLine(e))
+	}
+	for _, m := range d.Moved {
+		fmt.Fprintf(w, "~ %v moved to %v\n", oneLine(m.Entity), m.To)
+	}
+	for _, c := range d.Changed {
+		name := c.Field
+		if c.Type == "WarpGate" && c.Field == "value" {
+			name = "destination"
+		}
+		fmt.Fprintf(w, "~ %v at %v: %v %q -> %q\n", c.Type, c.Position, name, c.From, c.To)
+	}
+}
+
+func MapDiffCommand(args []string) {
+	flags := flag.NewFlagSet("mapdiff", flag.ExitOnError)
+	asJSON := flags.Bool("json", false, "write the differences as JSON")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json mapdiff [flags] old-map new-map")
+		fmt.Fprintln(flags.Output(), "Maps can be .c3dmap or map JSON. Exits with status 1 if they differ, like diff.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	var maps [2]*JsonMap
+	for i, filename := range flags.Args() {
+		m, err := loader.Load(filename)
+		if err != nil {
+			panic(err)
+		}
+		maps[i] = m
+	}
+	d := DiffMaps(maps[0], maps[1])
+	d.From, d.To = flags.Arg(0), flags.Arg(1)
+	if *asJSON {
+		out, err := MarshalIndent(d)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(string(out))
+	} else if !d.Empty() {
+		d.WriteText(os.Stdout)
+	}
+	if !d.Empty() {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+)
+
+func init() {
+	Commands["png"] = PNGCommand
+}
+
+// WallColors are roughly the average color of each wall texture.
+var WallColors = map[string]color.RGBA{
+	"stone": {0x80, 0x80, 0x80, 0xFF},
+	"slime": {0x30, 0x90, 0x30, 0xFF},
+	"white": {0xE0, 0xE0, 0xE0, 0xFF},
+	"blood": {0x90, 0x10, 0x10, 0xFF},
+	"tar":   {0x40, 0x34, 0x20, 0xFF},
+	"gold":  {0xC8, 0xA0, 0x20, 0xFF},
+	"hell":  {0xD0, 0x40, 0x10, 0xFF},
+}
+
+var DoorColors = map[string]color.RGBA{
+	"red":    {0xFF, 0x30, 0x30, 0xFF},
+	"yellow": {0xFF, 0xF0, 0x40, 0xFF},
+	"green":  {0x40, 0xE0, 0x40, 0xFF},
+	"blue":   {0x40, 0x60, 0xFF, 0xFF},
+}
+
+// FloorColor matches the floor material in src/map.js.
+var FloorColor = color.RGBA{0x55, 0x55, 0x55, 0xFF}
+
+// DifficultyColors tell monsters that only appear on harder difficulties apart.
+var DifficultyColors = []color.RGBA{
+	{0xFF, 0x20, 0x20, 0xFF},
+	{0xFF, 0x90, 0x00, 0xFF},
+	{0xC0, 0x40, 0xFF, 0xFF},
+}
+
+// Icon is how an entity is drawn over its tile.
+type Icon struct {
+	Shape string // circle, ring, square, diamond or arrow
+	Size  float64
+	Color color.RGBA
+}
+
+var EntityIcons = map[string]Icon{
+	"PlayerStart": {"arrow", 0.4, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}},
+	"Player":      {"arrow", 0.4, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}},
+	"Bolt":        {"diamond", 0.2, color.RGBA{0xFF, 0xFF, 0x80, 0xFF}},
+	"Nuke":        {"diamond", 0.3, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}},
+	"Potion":      {"circle", 0.2, color.RGBA{0x40, 0xE0, 0xE0, 0xFF}},
+	"RedKey":      {"diamond", 0.35, DoorColors["red"]},
+	"YellowKey":   {"diamond", 0.35, DoorColors["yellow"]},
+	"GreenKey":    {"diamond", 0.35, DoorColors["green"]},
+	"BlueKey":     {"diamond", 0.35, DoorColors["blue"]},
+	"Scroll":      {"square", 0.2, color.RGBA{0xF0, 0xE0, 0xB0, 0xFF}},
+	"Treasure":    {"square", 0.3, color.RGBA{0xFF, 0xC0, 0x00, 0xFF}},
+	"WarpGate":    {"ring", 0.4, color.RGBA{0x60, 0x80, 0xFF, 0xFF}},
+	"JumpGate":    {"ring", 0.4, color.RGBA{0x40, 0xFF, 0xFF, 0xFF}},
+	"Fireball":    {"circle", 0.15, color.RGBA{0xFF, 0x80, 0x00, 0xFF}},
+	"Grelminar":   {"circle", 0.45, color.RGBA{0xFF, 0x00, 0xFF, 0xFF}},
+	"Nemesis":     {"circle", 0.45, color.RGBA{0xFF, 0x00, 0xFF, 0xFF}},
+}
+
+// IsMonster reports whether an entity type is drawn in its difficulty's color.
+func IsMonster(entityType string) bool {
+	switch entityType {
+	case "Troll", "Orc", "Bat", "Demon", "Mage":
+		return true
+	}
+	return false
+}
+
+func (icon Icon) Contains(dx, dy float64, dir *Vec2) bool {
+	r := icon.Size
+	switch icon.Shape {
+	case "circle":
+		return dx*dx+dy*dy <= r*r
+	case "ring":
+		d := dx*dx + dy*dy
+		return d <= r*r && d >= (r*0.6)*(r*0.6)
+	case "square":
+		return abs(dx) <= r && abs(dy) <= r
+	case "diamond":
+		return abs(dx)+abs(dy) <= r
+	case "arrow":
+		ux, uy := 0.0, -1.0
+		if dir != nil {
+			ux, uy = float64(dir.X), -float64(dir.Y) // image Y points south
+		}
+		t := dx*ux + dy*uy
+		return t >= -r && t <= r && abs(dx*uy-dy*ux) <= (r-t)/2
+	}
+	return false
+}
+
+func abs(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+// RegionColor tints the floor so areas with different descriptions stand apart.
+func RegionColor(n int) color.RGBA {
+	if n == 0 {
+		return FloorColor
+	}
+	tints := []color.RGBA{
+		{0x60, 0x50, 0x50, 0xFF},
+		{0x50, 0x60, 0x50, 0xFF},
+		{0x50, 0x50, 0x60, 0xFF},
+		{0x60, 0x60, 0x48, 0xFF},
+		{0x48, 0x60, 0x60, 0xFF},
+		{0x60, 0x48, 0x60, 0xFF},
+	}
+	return tints[(n-1)%len(tints)]
+}
+
+// Minimap draws a level with each tile as a square of Scale pixels. Entities
+// that only appear above Difficulty are left out.
+type Minimap struct {
+	Scale      int
+	Difficulty int
+}
+
+func (mm Minimap) Render(m *JsonMap) *image.RGBA {
+	s := mm.Scale
+	img := image.NewRGBA(image.Rect(0, 0, int(m.Width)*s, int(m.Height)*s))
+
+	regions := map[string]int{"": 0}
+	for _, def := range m.Legend {
+		if _, ok := regions[def.Value]; def.Type == "floor" && !ok {
+			regions[def.Value] = len(regions)
+		}
+	}
+
+	for row, line := range m.Layout {
+		for col, i := range line {
+			def := m.Legend[i]
+			x0, y0 := col*s, row*s
+			for y := 0; y < s; y++ {
+				for x := 0; x < s; x++ {
+					var c color.RGBA
+					edge := x == 0 || y == 0 || x == s-1 || y == s-1
+					switch def.Type {
+					case "wall":
+						c = WallColors[def.Value]
+					case "exploding_wall":
+						// draw cracks across the wall
+						c = WallColors[def.Value]
+						if s >= 4 && (x == y || x == s-1-y) {
+							c = FloorColor
+						}
+					case "door":
+						c = DoorColors[def.Value]
+						if s >= 4 && edge {
+							c = color.RGBA{c.R / 2, c.G / 2, c.B / 2, 0xFF}
+						}
+					default:
+						c = RegionColor(regions[def.Value])
+					}
+					img.SetRGBA(x0+x, y0+y, c)
+				}
+			}
+		}
+	}
+
+	start := m.PlayerStart
+	start.Type = "PlayerStart"
+	entities := append([]Entity{start}, m.Entities...)
+	for _, e := range entities {
+		if e.MinDifficulty > mm.Difficulty {
+			continue
+		}
+		icon, ok := EntityIcons[e.Type]
+		if IsMonster(e.Type) {
+			icon, ok = Icon{"circle", 0.35, DifficultyColors[e.MinDifficulty%len(DifficultyColors)]}, true
+		}
+		if !ok {
+			continue
+		}
+		row := int(m.Height) - 1 - e.Position.Y
+		x0, y0 := e.Position.X*s, row*s
+		if s < 4 {
+			// too small for shapes, so color the whole tile
+			for y := 0; y < s; y++ {
+				for x := 0; x < s; x++ {
+					img.SetRGBA(x0+x, y0+y, icon.Color)
+				}
+			}
+			continue
+		}
+		for y := 0; y < s; y++ {
+			for x := 0; x < s; x++ {
+				dx := (float64(x)+0.5)/float64(s) - 0.5
+				dy := (float64(y)+0.5)/float64(s) - 0.5
+				if icon.Contains(dx, dy, e.Direction) {
+					img.SetRGBA(x0+x, y0+y, icon.Color)
+				}
+			}
+		}
+	}
+	return img
+}
+
+func PNGCommand(args []string) {
+	flags := flag.NewFlagSet("png", flag.ExitOnError)
+	outdir := flags.String("o", ".", "output directory")
+	scale := flags.Int("scale", 8, "pixels per tile")
+	difficulty := flags.Int("difficulty", 2, "leave out entities that need a higher difficulty (0 to 2)")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json png [flags] map-or-dir...")
+		fmt.Fprintln(flags.Output(), "Draws each map as <name>.png in the output directory.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 || *scale < 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	filenames, err := loader.Expand(flags.Args())
+	if err != nil {
+		panic(err)
+	}
+	if err := os.MkdirAll(*outdir, 0755); err != nil {
+		panic(err)
+	}
+	minimap := Minimap{Scale: *scale, Difficulty: *difficulty}
+	for _, filename := range filenames {
+		m, err := loader.Load(filename)
+		if err != nil {
+			panic(err)
+		}
+		f, err := os.Create(filepath.Join(*outdir, MapName(m.LevelNumber, m.Title)+".png"))
+		if err != nil {
+			panic(err)
+		}
+		if err := png.Encode(f, minimap.Render(m)); err != nil {
+			panic(err)
+		}
+		if err := f.Close(); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func init() {
+	Commands["nav"] = NavCommand
+}
+
+// NavGraph is a level's tiles as a graph for pathfinding, so the game can
+// look paths up instead of working them out while it runs. Nodes are every
+// tile that isn't a solid wall, and positions count Y up from the last row
+// like Entity.Position.
+type NavGraph struct {
+	LevelNumber int    `json:"levelNumber"`
+	Name        string `json:"name"`
+	Width       uint   `json:"width"`
+	Height      uint   `json:"height"`
+
+	Nodes []NavNode `json:"nodes"`
+	Edges []NavEdge `json:"edges"`
+	// NodeAt is the node at each tile, numbered y*width+x, or -1.
+	NodeAt []int           `json:"nodeAt"`
+	Fields []DistanceField `json:"fields,omitempty"`
+}
+
+type NavNode struct {
+	Position Vec2   `json:"position"`
+	Tile     string `json:"tile"` // floor, door or exploding_wall
+}
+
+// NavEdge is a one-way step between nodes. Walking onto a door needs its
+// key, and onto an exploding wall needs a bolt or nuke to blow it up first.
+type NavEdge struct {
+	From int    `json:"from"`
+	To   int    `json:"to"`
+	Via  string `json:"via"`           // walk, door, exploding_wall or jump_gate
+	Key  string `json:"key,omitempty"` // colour of the door
+}
+
+// DistanceField is how many steps each node is from the nearest entity of a
+// type, walking only, the way enemies get around: they can't open doors,
+// blow up walls or use jump gates. Distances are -1 where there is no way.
+type DistanceField struct {
+	Target    string `json:"target"`
+	Distances []int  `json:"distances"`
+}
+
+func NewNavGraph(m *JsonMap) *NavGraph {
+	g := &NavGraph{
+		LevelNumber: m.LevelNumber,
+		Name:        MapName(m.LevelNumber, m.Title),
+		Width:       m.Width,
+		Height:      m.Height,
+		Nodes:       []NavNode{},
+		Edges:       []NavEdge{},
+		NodeAt:      make([]int, m.Width*m.Height),
+	}
+	for y := 0; y < int(m.Height); y++ {
+		for x := 0; x < int(m.Width); x++ {
+			g.NodeAt[y*int(m.Width)+x] = -1
+			if def, _ := m.Tile(Vec2{x, y}); Passable(def) {
+				g.NodeAt[y*int(m.Width)+x] = len(g.Nodes)
+				g.Nodes = append(g.Nodes, NavNode{Vec2{x, y}, def.Type})
+			}
+		}
+	}
+	for i, n := range g.Nodes {
+		for _, d := range regionNeighbors {
+			p := Vec2{n.Position.X + d.X, n.Position.Y + d.Y}
+			j := g.Node(p)
+			if j < 0 {
+				continue
+			}
+			def, _ := m.Tile(p)
+			edge := NavEdge{From: i, To: j, Via: "walk"}
+			switch def.Type {
+			case "door":
+				edge.Via, edge.Key = "door", def.Value
+			case "exploding_wall":
+				edge.Via = "exploding_wall"
+			}
+			g.Edges = append(g.Edges, edge)
+		}
+	}
+	for _, e := range m.Entities {
+		if dest, ok := ValueVec2(e.Value); ok && e.Type == "JumpGate" {
+			if from, to := g.Node(e.Position), g.Node(dest); from >= 0 && to >= 0 {
+				g.Edges = append(g.Edges, NavEdge{From: from, To: to, Via: "jump_gate"})
+			}
+		}
+	}
+	return g
+}
+
+// Node returns the node at a position, or -1 if there isn't one.
+func (g *NavGraph) Node(p Vec2) int {
+	if p.X < 0 || p.Y < 0 || p.X >= int(g.Width) || p.Y >= int(g.Height) {
+		return -1
+	}
+	return g.NodeAt[p.Y*int(g.Width)+p.X]
+}
+
+// AddField adds the distance field for entities of a type, or PlayerStart.
+// It is an error if the level has none.
+func (g *NavGraph) AddField(m *JsonMap, target string) error {
+	field := DistanceField{target, make([]int, len(g.Nodes))}
+	for i := range field.Distances {
+		field.Distances[i] = -1
+	}
+	var queue []int
+	for _, e := range append([]Entity{m.PlayerStart}, m.Entities...) {
+		if e.Type == "" {
+			e.Type = "PlayerStart"
+		}
+		if n := g.Node(e.Position); e.Type == target && n >= 0 && field.Distances[n] < 0 {
+			field.Distances[n] = 0
+			queue = append(queue, n)
+		}
+	}
+	if len(queue) == 0 {
+		return fmt.Errorf("%v has no %v", g.Name, target)
+	}
+
+	// Walking is the same both ways, so searching out from the targets finds
+	// the distance to them.
+	walks := make([][]int, len(g.Nodes))
+	for _, e := range g.Edges {
+		if e.Via == "walk" && g.Nodes[e.From].Tile == "floor" {
+			walks[e.From] = append(walks[e.From], e.To)
+		}
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, next := range walks[n] {
+			if field.Distances[next] < 0 {
+				field.Distances[next] = field.Distances[n] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	g.Fields = append(g.Fields, field)
+	return nil
+}
+
+func NavCommand(args []string) {
+	flags := flag.NewFlagSet("nav", flag.ExitOnError)
+	outdir := flags.String("o", ".", "output directory")
+	fields := flags.String("fields", "", "comma-separated entity types, such as PlayerStart,WarpGate, to add distance fields for")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json nav [flags] map-or-dir...")
+		fmt.Fprintln(flags.Output(), "Writes each level's navigation graph as <name>.nav.json in the output directory.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	filenames, err := loader.Expand(flags.Args())
+	if err != nil {
+		panic(err)
+	}
+	if err := os.MkdirAll(*outdir, 0755); err != nil {
+		panic(err)
+	}
+	for _, filename := range filenames {
+		m, err := loader.Load(filename)
+		if err != nil {
+			panic(err)
+		}
+		g := NewNavGraph(m)
+		if *fields != "" {
+			for _, target := range strings.Split(*fields, ",") {
+				if err := g.AddField(m, strings.TrimSpace(target)); err != nil {
+					fmt.Fprintln(os.Stderr, "warning:", err)
+				}
+			}
+		}
+		if err := writeJSONFile(filepath.Join(*outdir, g.Name+".nav.json"), g); err != nil {
+			panic(err)
+		}
+	}
+}
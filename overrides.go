@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+)
+
+// LevelOverride changes a converted level's defaults. Unset fields keep the
+// value map2json would otherwise use.
+type LevelOverride struct {
+	Title        *string      `json:"title"`
+	Fog          *FogOverride `json:"fog"`
+	Treasure     *int         `json:"treasure"`     // score for each treasure chest
+	AmbientLight *uint32      `json:"ambientLight"` // RGB color
+	Music        *string      `json:"music"`
+}
+
+type FogOverride struct {
+	Color *uint32  `json:"color"`
+	Near  *float32 `json:"near"`
+	Far   *float32 `json:"far"`
+}
+
+// ReadOverrides reads a JSON object of level overrides keyed by level number.
+// Unknown settings are an error so typos don't silently do nothing.
+func ReadOverrides(filename string) (map[int]LevelOverride, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("%v: %v", filename, err)
+	}
+	overrides := make(map[int]LevelOverride)
+	for key, value := range raw {
+		levelNo, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %q is not a level number", filename, key)
+		}
+		decoder := json.NewDecoder(bytes.NewReader(value))
+		decoder.DisallowUnknownFields()
+		var o LevelOverride
+		if err := decoder.Decode(&o); err != nil {
+			return nil, fmt.Errorf("%v: level %v: %v", filename, levelNo, err)
+		}
+		overrides[levelNo] = o
+	}
+	return overrides, nil
+}
+
+func (o LevelOverride) Apply(m *JsonMap) {
+	if o.Title != nil {
+		m.Title = *o.Title
+	}
+	if o.Fog != nil {
+		fog := Fog{}
+		if m.Fog != nil {
+			fog = *m.Fog
+		}
+		if o.Fog.Color != nil {
+			fog.Color = *o.Fog.Color
+		}
+		if o.Fog.Near != nil {
+			fog.Near = *o.Fog.Near
+		}
+		if o.Fog.Far != nil {
+			fog.Far = *o.Fog.Far
+		}
+		m.Fog = &fog
+	}
+	if o.Treasure != nil {
+		for i := range m.Entities {
+			if m.Entities[i].Type == "Treasure" {
+				m.Entities[i].Value = *o.Treasure
+			}
+		}
+	}
+	if o.AmbientLight != nil {
+		m.AmbientLight = o.AmbientLight
+	}
+	if o.Music != nil {
+		m.Music = *o.Music
+	}
+}
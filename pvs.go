@@ -0,0 +1,321 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+func init() {
+	Commands["pvs"] = PVSCommand
+}
+
+// Occludes reports whether a tile blocks the view. Doors count even though
+// they open, so a renderer should also draw what the tiles on either side
+// of an open door can see.
+func Occludes(def LayoutDef) bool {
+	return def.Type != "floor"
+}
+
+// TileSet is a set of tiles or wall faces as a bit for each index.
+type TileSet []uint64
+
+func NewTileSet(n int) TileSet {
+	return make(TileSet, (n+63)/64)
+}
+
+func (s TileSet) Add(i int) {
+	s[i/64] |= 1 << uint(i%64)
+}
+
+func (s TileSet) Has(i int) bool {
+	return s[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (s TileSet) Len() int {
+	n := 0
+	for _, word := range s {
+		for ; word != 0; word &= word - 1 {
+			n++
+		}
+	}
+	return n
+}
+
+// Runs returns the set as the start and length of each run of members.
+func (s TileSet) Runs() [][2]int {
+	var runs [][2]int
+	for i := 0; i < len(s)*64; i++ {
+		if !s.Has(i) {
+			continue
+		}
+		start := i
+		for i < len(s)*64 && s.Has(i) {
+			i++
+		}
+		runs = append(runs, [2]int{start, i - start})
+	}
+	return runs
+}
+
+// TileVisibility is what can be seen from a floor tile. Tiles are numbered
+// y*width+x, where y counts up from the last row like Entity.Position, and
+// wall faces are numbered tile*4 plus their index in WallDirections.
+type TileVisibility struct {
+	Position Vec2
+	Tiles    TileSet
+	Faces    TileSet
+}
+
+// PVS finds what can be seen from each floor tile, from anywhere in it, out
+// to maxDistance tiles: a tile or wall face is in the set if a straight line
+// from some point of the floor tile reaches it without crossing a tile that
+// isn't floor. Lines that only graze a corner count as getting through, so
+// the sets are never smaller than what can really be seen.
+func PVS(m *JsonMap, maxDistance float64) []TileVisibility {
+	w, h := int(m.Width), int(m.Height)
+	var pvs []TileVisibility
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if def, _ := m.Tile(Vec2{x, y}); def.Type != "floor" {
+				continue
+			}
+			v := TileVisibility{Vec2{x, y}, NewTileSet(w * h), NewTileSet(w * h * 4)}
+			v.Tiles.Add(y*w + x)
+			for _, axes := range sweepAxes {
+				sweep(m, &v, axes, maxDistance)
+			}
+			pvs = append(pvs, v)
+		}
+	}
+	return pvs
+}
+
+// sweepAxes are the directions a sweep's u and v axes point on the map. The
+// eight of them between them cover every line out of a tile, as each sweep
+// only follows lines going along u and rising at most one tile of v per
+// tile.
+var sweepAxes = [][2]Vec2{
+	{{1, 0}, {0, 1}}, {{1, 0}, {0, -1}}, {{-1, 0}, {0, 1}}, {{-1, 0}, {0, -1}},
+	{{0, 1}, {1, 0}}, {{0, 1}, {-1, 0}}, {{0, -1}, {1, 0}}, {{0, -1}, {-1, 0}},
+}
+
+// sightEpsilon widens every test a little, so rounding can only add to what
+// is seen.
+const sightEpsilon = 1e-9
+
+// lineSet is a convex polygon of lines v = a + s*u, as its corners (s, a).
+type lineSet [][2]float64
+
+// clip keeps the lines where c + cs*s + ca*a >= 0.
+func (p lineSet) clip(c, cs, ca float64) lineSet {
+	var out lineSet
+	for i, from := range p {
+		to := p[(i+1)%len(p)]
+		f := c + cs*from[0] + ca*from[1]
+		t := c + cs*to[0] + ca*to[1]
+		if f >= -sightEpsilon {
+			out = append(out, from)
+		}
+		if (f < -sightEpsilon) != (t < -sightEpsilon) && f != t {
+			k := f / (f - t)
+			out = append(out, [2]float64{from[0] + k*(to[0]-from[0]), from[1] + k*(to[1]-from[1])})
+		}
+	}
+	return out
+}
+
+// span returns the least and greatest v the lines have at u.
+func (p lineSet) span(u float64) (float64, float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, c := range p {
+		v := c[1] + c[0]*u
+		lo, hi = math.Min(lo, v), math.Max(hi, v)
+	}
+	return lo, hi
+}
+
+// sweep follows the lines out of v.Position along axes[0], one column of
+// tiles at a time. The source tile is the square from -0.5 to 0.5 on both
+// axes, and column k runs from u = k-0.5 to k+0.5. Each lineSet holds lines
+// that have got that far past the same tiles; where a column has several
+// gaps between occluding tiles, a set splits in one for each gap.
+func sweep(m *JsonMap, v *TileVisibility, axes [2]Vec2, maxDistance float64) {
+	w := int(m.Width)
+	du, dv := axes[0], axes[1]
+	at := func(u, j int) (tile int, occludes, inside bool) {
+		p := Vec2{v.Position.X + u*du.X + j*dv.X, v.Position.Y + u*du.Y + j*dv.Y}
+		def, inside := m.Tile(p)
+		return p.Y*w + p.X, !inside || Occludes(def), inside
+	}
+	faceTowards := func(d Vec2) int {
+		for i, dir := range WallDirections {
+			if dir.Dir == (Vec2{-d.X, -d.Y}) {
+				return i
+			}
+		}
+		panic("no wall direction for " + fmt.Sprint(d))
+	}
+	alongFace, acrossFace := faceTowards(du), faceTowards(dv)
+	see := func(u, j, face int) {
+		if math.Hypot(math.Max(0, float64(u)-1), math.Max(0, math.Abs(float64(j))-1)) > maxDistance {
+			return
+		}
+		tile, occludes, inside := at(u, j)
+		if !inside {
+			return
+		}
+		v.Tiles.Add(tile)
+		if occludes && face >= 0 {
+			v.Faces.Add(tile*4 + face)
+		}
+	}
+
+	// lines through the source tile, leaving its column between the same
+	// occluding tiles it's between
+	top := 0
+	for _, occludes, _ := at(0, top+1); !occludes; _, occludes, _ = at(0, top+1) {
+		top++
+	}
+	sets := []lineSet{lineSet{{0, -0.5}, {1, -1}, {1, 1}, {0, 0.5}}.clip(float64(top)+0.5, -0.5, -1)}
+
+	for u := 1; float64(u-1) <= maxDistance && len(sets) > 0; u++ {
+		in, out := float64(u)-0.5, float64(u)+0.5
+		var next []lineSet
+		for _, p := range sets {
+			if len(p) == 0 {
+				continue
+			}
+			inLo, inHi := p.span(in)
+			for j := int(math.Floor(inLo + 0.5 - sightEpsilon)); float64(j)-0.5 <= inHi+sightEpsilon; j++ {
+				if _, occludes, _ := at(u, j); occludes {
+					// stopped by the face towards the source
+					see(u, j, alongFace)
+					continue
+				}
+				// the lines coming into this gap, and how high they get
+				// before leaving the column or hitting the tile above it
+				bottom, top := j, j
+				for _, occludes, _ := at(u, bottom-1); !occludes; _, occludes, _ = at(u, bottom-1) {
+					bottom--
+				}
+				for _, occludes, _ := at(u, top+1); !occludes; _, occludes, _ = at(u, top+1) {
+					top++
+				}
+				lo, hi := float64(bottom)-0.5, float64(top)+0.5
+				gap := p.clip(-lo, in, 1).clip(hi, -in, -1)
+				if len(gap) > 0 {
+					gapLo, _ := gap.span(in)
+					_, reach := gap.span(out)
+					for k := j; k <= top; k++ {
+						if float64(k)+0.5 >= gapLo-sightEpsilon && float64(k)-0.5 <= reach+sightEpsilon {
+							see(u, k, -1)
+						}
+					}
+					if reach >= hi-sightEpsilon {
+						see(u, top+1, acrossFace)
+					}
+					next = append(next, p.clip(-lo, in, 1).clip(hi, -out, -1))
+				}
+				j = top
+			}
+		}
+		sets = next
+	}
+}
+
+// WritePVS encodes a level's visibility compactly, with sets stored as runs
+// and the whole file gzipped. All numbers are little-endian:
+//
+//	"C3PV", version (uint8, 1), width (uint8), height (uint8), 0 (uint8)
+//	number of floor tiles (uint16), then for each:
+//		x, y (uint8)
+//		number of tile runs (uint16), then start, length (uint16) of each
+//		number of face runs (uint16), then start, length (uint16) of each
+func WritePVS(m *JsonMap, pvs []TileVisibility) ([]byte, error) {
+	var out bytes.Buffer
+	buf := gzip.NewWriter(&out)
+	buf.Write([]byte("C3PV"))
+	buf.Write([]byte{1, byte(m.Width), byte(m.Height), 0})
+	binary.Write(buf, binary.LittleEndian, uint16(len(pvs)))
+	for _, v := range pvs {
+		buf.Write([]byte{byte(v.Position.X), byte(v.Position.Y)})
+		for _, set := range []TileSet{v.Tiles, v.Faces} {
+			runs := set.Runs()
+			binary.Write(buf, binary.LittleEndian, uint16(len(runs)))
+			for _, run := range runs {
+				binary.Write(buf, binary.LittleEndian, []uint16{uint16(run[0]), uint16(run[1])})
+			}
+		}
+	}
+	if err := buf.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func PVSCommand(args []string) {
+	flags := flag.NewFlagSet("pvs", flag.ExitOnError)
+	outdir := flags.String("o", ".", "output directory")
+	distance := flags.Float64("distance", 0, "how far to look, in tiles (default the level's fog distance)")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json pvs [flags] map-or-dir...")
+		fmt.Fprintln(flags.Output(), "Writes what can be seen from each floor tile as <name>.pvs.gz in the output directory.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	filenames, err := loader.Expand(flags.Args())
+	if err != nil {
+		panic(err)
+	}
+	if err := os.MkdirAll(*outdir, 0755); err != nil {
+		panic(err)
+	}
+	for _, filename := range filenames {
+		m, err := loader.Load(filename)
+		if err != nil {
+			panic(err)
+		}
+		if m.Width > 255 || m.Height > 255 || m.Width*m.Height*4 > math.MaxUint16 {
+			panic(fmt.Errorf("%v: %vx%v is too big for the PVS format", filename, m.Width, m.Height))
+		}
+		maxDistance := *distance
+		if maxDistance <= 0 && m.Fog != nil {
+			maxDistance = float64(m.Fog.Far)
+		}
+		if maxDistance <= 0 {
+			maxDistance = math.Hypot(float64(m.Width), float64(m.Height))
+		}
+
+		pvs := PVS(m, maxDistance)
+		name := MapName(m.LevelNumber, m.Title)
+		data, err := WritePVS(m, pvs)
+		if err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(*outdir, name+".pvs.gz"), data, 0666); err != nil {
+			panic(err)
+		}
+		visible := 0
+		for _, v := range pvs {
+			visible += v.Tiles.Len()
+		}
+		if len(pvs) > 0 {
+			visible /= len(pvs)
+		}
+		fmt.Printf("%v: %v floor tiles see %v of %v tiles on average, %v bytes\n", name, len(pvs), visible, m.Width*m.Height, len(data))
+	}
+}
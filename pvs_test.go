@@ -0,0 +1,120 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// castRay walks the grid from (px, py) along (dx, dy), marking each tile it
+// passes through, and the face of the tile that stops it.
+func castRay(m *JsonMap, v *TileVisibility, px, py, dx, dy, maxDistance float64) {
+	w, h := int(m.Width), int(m.Height)
+	// tiles are centered on whole numbers, so their edges are at halves
+	x, y := int(math.Floor(px+0.5)), int(math.Floor(py+0.5))
+	stepX, stepY := 1, 1
+	nextX, nextY := math.Inf(1), math.Inf(1) // distance along the ray to the next edge
+	deltaX, deltaY := math.Inf(1), math.Inf(1)
+	if dx > 0 {
+		nextX, deltaX = (float64(x)+0.5-px)/dx, 1/dx
+	} else if dx < 0 {
+		stepX, nextX, deltaX = -1, (float64(x)-0.5-px)/dx, -1/dx
+	}
+	if dy > 0 {
+		nextY, deltaY = (float64(y)+0.5-py)/dy, 1/dy
+	} else if dy < 0 {
+		stepY, nextY, deltaY = -1, (float64(y)-0.5-py)/dy, -1/dy
+	}
+	for {
+		var face int
+		if nextX < nextY {
+			if nextX > maxDistance {
+				return
+			}
+			x += stepX
+			nextX += deltaX
+			face = 3 // entered from the west, so sees the west face
+			if stepX < 0 {
+				face = 1
+			}
+		} else {
+			if nextY > maxDistance {
+				return
+			}
+			y += stepY
+			nextY += deltaY
+			face = 0
+			if stepY < 0 {
+				face = 2
+			}
+		}
+		if x < 0 || y < 0 || x >= w || y >= h {
+			return
+		}
+		tile := y*w + x
+		v.Tiles.Add(tile)
+		if def, _ := m.Tile(Vec2{x, y}); Occludes(def) {
+			v.Faces.Add(tile*4 + face)
+			return
+		}
+	}
+}
+
+// TestPVSConservative casts rays from random points of each floor tile and
+// checks the PVS has everything they hit.
+func TestPVSConservative(t *testing.T) {
+	loader := MapLoader{Assets: "extracted_assets"}
+	for _, filename := range []string{"build/maps/Approach.map.json", "build/maps/Third_Floor.map.json"} {
+		m, err := loader.Load(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		const distance = 50
+		rng := rand.New(rand.NewSource(1))
+		missedTiles, missedFaces := 0, 0
+		for _, v := range PVS(m, distance) {
+			sampled := TileVisibility{v.Position, NewTileSet(len(v.Tiles) * 64), NewTileSet(len(v.Faces) * 64)}
+			for origin := 0; origin < 40; origin++ {
+				px, py := float64(v.Position.X)+rng.Float64()-0.5, float64(v.Position.Y)+rng.Float64()-0.5
+				for r := 0; r < 720; r++ {
+					angle := 2 * math.Pi * rng.Float64()
+					castRay(m, &sampled, px, py, math.Cos(angle), math.Sin(angle), distance)
+				}
+			}
+			for i := range sampled.Tiles {
+				missedTiles += TileSet{sampled.Tiles[i] &^ v.Tiles[i]}.Len()
+			}
+			for i := range sampled.Faces {
+				missedFaces += TileSet{sampled.Faces[i] &^ v.Faces[i]}.Len()
+			}
+		}
+		if missedTiles > 0 || missedFaces > 0 {
+			t.Errorf("%v: rays hit %v tiles and %v faces the PVS doesn't have", filename, missedTiles, missedFaces)
+		}
+	}
+}
+
+// TestPVSWalls checks a wall between two rooms hides each from the other.
+func TestPVSWalls(t *testing.T) {
+	m := &JsonMap{Version: CurrentVersion}
+	rows := make([][]LayoutDef, 5)
+	for row := range rows {
+		for x := 0; x < 7; x++ {
+			def := Floor("")
+			if row == 0 || row == 4 || x == 0 || x == 3 || x == 6 {
+				def = Wall("stone")
+			}
+			rows[row] = append(rows[row], def)
+		}
+	}
+	m.SetLayout(rows)
+	for _, v := range PVS(m, 50) {
+		for y := 1; y <= 3; y++ {
+			for x := 1; x < 6; x++ {
+				if x != 3 && (x < 3) != (v.Position.X < 3) && v.Tiles.Has(y*7+x) {
+					t.Errorf("%v sees (%v, %v) through the wall", v.Position, x, y)
+				}
+			}
+		}
+	}
+}
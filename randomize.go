@@ -0,0 +1,228 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+func init() {
+	Commands["randomize"] = RandomizeCommand
+}
+
+// ShuffledItems are the entities the randomizer moves around.
+var ShuffledItems = map[string]bool{
+	"RedKey": true, "YellowKey": true, "GreenKey": true, "BlueKey": true,
+	"Potion": true, "Bolt": true, "Nuke": true, "Scroll": true, "Treasure": true,
+}
+
+// Randomizer shuffles where items are in existing levels. Keys stay in the
+// level they open doors in, and scrolls in the level their hints are about,
+// but with Across set, everything else can end up in any level, each level
+// keeping as many items as it had. Treasure moved to another level is worth
+// what that level's treasure is.
+type Randomizer struct {
+	Seed   int64
+	Across bool
+}
+
+// Randomize shuffles the items of maps, which should be in level order so
+// the same seed gives the same levels. Items go on floor the player can
+// reach, and keys are placed again until the level can still be finished.
+// Levels that couldn't be finished to start with keep their keys where they
+// were.
+func (r Randomizer) Randomize(maps []*JsonMap) error {
+	rng := rand.New(rand.NewSource(r.Seed))
+	items := make([][]Entity, len(maps))
+	reaches := make([]KeyedReach, len(maps))
+	for i, m := range maps {
+		kept := []Entity{}
+		reaches[i] = ReachWithKeys(m, false)
+		solvable := reaches[i].Goal
+		for _, e := range m.Entities {
+			if ShuffledItems[e.Type] && (KeyColor(e.Type) == "" || solvable) {
+				items[i] = append(items[i], e)
+			} else {
+				kept = append(kept, e)
+			}
+		}
+		m.Entities = kept
+	}
+	if r.Across {
+		// deal everything but keys and scrolls out again, keeping each
+		// level's count
+		var pool []Entity
+		counts := make([]int, len(maps))
+		for i := range items {
+			var kept []Entity
+			for _, e := range items[i] {
+				if KeyColor(e.Type) != "" || e.Type == "Scroll" {
+					kept = append(kept, e)
+				} else {
+					pool = append(pool, e)
+					counts[i]++
+				}
+			}
+			items[i] = kept
+		}
+		rng.Shuffle(len(pool), func(a, b int) { pool[a], pool[b] = pool[b], pool[a] })
+		for i, n := range counts {
+			for _, e := range pool[:n] {
+				if e.Type == "Treasure" {
+					e.Value = maps[i].LevelNumber * 100 // as Convert does
+				}
+				items[i] = append(items[i], e)
+			}
+			pool = pool[n:]
+		}
+	}
+
+	for i, m := range maps {
+		if err := r.place(rng, m, items[i], reaches[i]); err != nil {
+			return fmt.Errorf("%v: %v", MapName(m.LevelNumber, m.Title), err)
+		}
+		seed := r.Seed
+		m.RandomizerSeed = &seed
+	}
+	return nil
+}
+
+// place puts items on free floor the player could get to before, keys
+// first. Keys are placed again until every key can be picked up and every
+// door that could be opened before still can, so no key ends up behind its
+// own door. The rest go where the player can get to with the keys placed.
+func (r Randomizer) place(rng *rand.Rand, m *JsonMap, items []Entity, before KeyedReach) error {
+	taken := map[Vec2]bool{m.PlayerStart.Position: true}
+	for _, e := range m.Entities {
+		taken[e.Position] = true
+	}
+	var free []Vec2
+	for p := range before.Tiles {
+		if def, _ := m.Tile(p); def.Type == "floor" && !taken[p] {
+			free = append(free, p)
+		}
+	}
+	if len(free) < len(items) {
+		return fmt.Errorf("only has room for %v of its %v items", len(free), len(items))
+	}
+	// map order is random, so sort before shuffling
+	sort.Slice(free, func(a, b int) bool {
+		if free[a].Y != free[b].Y {
+			return free[a].Y > free[b].Y
+		}
+		return free[a].X < free[b].X
+	})
+	sort.SliceStable(items, func(a, b int) bool {
+		return KeyColor(items[a].Type) != "" && KeyColor(items[b].Type) == ""
+	})
+	keys := 0
+	for keys < len(items) && KeyColor(items[keys].Type) != "" {
+		keys++
+	}
+
+	others := m.Entities
+	var after KeyedReach
+	for attempt := 0; ; attempt++ {
+		if attempt == 1000 {
+			return fmt.Errorf("couldn't place its keys so every door can still be opened")
+		}
+		rng.Shuffle(len(free), func(a, b int) { free[a], free[b] = free[b], free[a] })
+		m.Entities = append([]Entity(nil), others...)
+		for n, e := range items[:keys] {
+			e.Position = free[n]
+			m.Entities = append(m.Entities, e)
+		}
+		after = ReachWithKeys(m, false)
+		if keys == 0 || stillReachable(before, after, free[:keys]) {
+			break
+		}
+	}
+	var rest []Vec2
+	for _, p := range free[keys:] {
+		if after.Tiles[p] {
+			rest = append(rest, p)
+		}
+	}
+	if len(rest) < len(items)-keys {
+		return fmt.Errorf("only has room for %v of its %v items", keys+len(rest), len(items))
+	}
+	for n, e := range items[keys:] {
+		e.Position = rest[n]
+		m.Entities = append(m.Entities, e)
+	}
+	return nil
+}
+
+// stillReachable reports whether the keys placed at keys can all be picked
+// up, and the level can still be finished and its doors opened as before.
+func stillReachable(before, after KeyedReach, keys []Vec2) bool {
+	if before.Goal && !after.Goal {
+		return false
+	}
+	for _, p := range keys {
+		if !after.Keys[p] {
+			return false
+		}
+	}
+	for p := range before.Doors {
+		if !after.Doors[p] {
+			return false
+		}
+	}
+	return true
+}
+
+func RandomizeCommand(args []string) {
+	flags := flag.NewFlagSet("randomize", flag.ExitOnError)
+	outdir := flags.String("o", ".", "output directory")
+	seed := flags.Int64("seed", 0, "random seed (default one from the clock, which is reported)")
+	across := flags.Bool("across", false, "move items other than keys and scrolls between levels too")
+	v1 := flags.Bool("v1", false, "write the version 1 format, which is limited to 52 kinds of tile")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json randomize [flags] map-or-dir...")
+		fmt.Fprintln(flags.Output(), "Writes each level with its items shuffled as <name>.map.json in the output directory.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano() & (1<<53 - 1) // so JavaScript can read it exactly
+		fmt.Fprintln(os.Stderr, "seed:", *seed)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	maps, err := loader.LoadAll(flags.Args())
+	if err != nil {
+		panic(err)
+	}
+	for _, m := range maps {
+		if !Solvable(m) {
+			fmt.Fprintf(os.Stderr, "warning: %v can't be finished, so its keys stay where they are\n", MapName(m.LevelNumber, m.Title))
+		}
+	}
+	if err := (Randomizer{*seed, *across}).Randomize(maps); err != nil {
+		panic(err)
+	}
+	if err := os.MkdirAll(*outdir, 0755); err != nil {
+		panic(err)
+	}
+	for _, m := range maps {
+		if *v1 {
+			m.Version = 1
+		}
+		if err := CheckMap(m); err != nil {
+			panic(err)
+		}
+		if err := writeJSONFile(filepath.Join(*outdir, MapName(m.LevelNumber, m.Title)+".map.json"), m); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"testing"
+)
+
+// TestRandomizeKeepsDoorsOpenable checks no seed leaves a key where it can't
+// be picked up or a door that could be opened shut for good, as seed 7 once
+// did with Third_Floor's red key.
+func TestRandomizeKeepsDoorsOpenable(t *testing.T) {
+	loader := MapLoader{Assets: "extracted_assets"}
+	for _, seed := range []int64{1, 7, 42} {
+		maps, err := loader.LoadAll([]string{"build/maps"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		before := make([]KeyedReach, len(maps))
+		for i, m := range maps {
+			before[i] = ReachWithKeys(m, false)
+		}
+		if err := (Randomizer{Seed: seed}).Randomize(maps); err != nil {
+			t.Fatal(err)
+		}
+		for i, m := range maps {
+			after := ReachWithKeys(m, false)
+			name := MapName(m.LevelNumber, m.Title)
+			if before[i].Goal && !after.Goal {
+				t.Errorf("seed %v: %v can't be finished", seed, name)
+			}
+			for p := range before[i].Doors {
+				if !after.Doors[p] {
+					t.Errorf("seed %v: %v's door at %v can't be opened", seed, name, p)
+				}
+			}
+			for _, e := range m.Entities {
+				if KeyColor(e.Type) != "" && before[i].Goal && !after.Keys[e.Position] {
+					t.Errorf("seed %v: %v's %v at %v can't be picked up", seed, name, e.Type, e.Position)
+				}
+			}
+		}
+	}
+}
+
+// TestRandomizeAcross checks items moved between levels are worth what the
+// level they end up in gives, and scrolls stay with their hints' level.
+func TestRandomizeAcross(t *testing.T) {
+	loader := MapLoader{Assets: "extracted_assets"}
+	maps, err := loader.LoadAll([]string{"build/maps"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scrolls := make(map[string]int)
+	for _, m := range maps {
+		for _, e := range m.Entities {
+			if e.Type == "Scroll" {
+				scrolls[m.Title+e.Text]++
+			}
+		}
+	}
+	if err := (Randomizer{Seed: 7, Across: true}).Randomize(maps); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range maps {
+		for _, e := range m.Entities {
+			switch e.Type {
+			case "Treasure":
+				if e.Value != m.LevelNumber*100 {
+					t.Errorf("%v has treasure worth %v", m.Title, e.Value)
+				}
+			case "Scroll":
+				if scrolls[m.Title+e.Text]--; scrolls[m.Title+e.Text] < 0 {
+					t.Errorf("%v has a scroll from another level: %q", m.Title, e.Text)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import "sort"
+
+// Region is an area of connected floor with the same description, such as a
+// room, for showing the player's location and for navigation. Positions
+// count Y up from the last row like Entity.Position.
+type Region struct {
+	ID          int          `json:"id"`
+	Description string       `json:"description"`
+	Min         Vec2         `json:"min"` // bounding box corners, inclusive
+	Max         Vec2         `json:"max"`
+	Runs        [][3]int     `json:"runs"` // y, first x and last x of each run of tiles along a row
+	Links       []RegionLink `json:"links"`
+}
+
+// Tiles returns the positions of the region's tiles.
+func (r Region) Tiles() []Vec2 {
+	var tiles []Vec2
+	for _, run := range r.Runs {
+		for x := run[1]; x <= run[2]; x++ {
+			tiles = append(tiles, Vec2{x, run[0]})
+		}
+	}
+	return tiles
+}
+
+// RegionLink is a way from one region into another.
+type RegionLink struct {
+	Region int    `json:"region"`
+	Via    string `json:"via"`             // open, door or exploding_wall
+	Color  string `json:"color,omitempty"` // of the door
+}
+
+var regionNeighbors = []Vec2{{0, 1}, {1, 0}, {0, -1}, {-1, 0}}
+
+// flood returns the connected tiles from start that match.
+func flood(m *JsonMap, start Vec2, match func(LayoutDef) bool) []Vec2 {
+	seen := map[Vec2]bool{start: true}
+	tiles := []Vec2{start}
+	for i := 0; i < len(tiles); i++ {
+		for _, d := range regionNeighbors {
+			p := Vec2{tiles[i].X + d.X, tiles[i].Y + d.Y}
+			if def, ok := m.Tile(p); ok && !seen[p] && match(def) {
+				seen[p] = true
+				tiles = append(tiles, p)
+			}
+		}
+	}
+	return tiles
+}
+
+// Segment divides a map's floor into regions, numbered in reading order from
+// the top left. Regions are linked where they touch, and through doors and
+// exploding walls that have floor on both sides; a door or wall made of
+// several tiles counts as one.
+func Segment(m *JsonMap) []Region {
+	regions := []Region{}
+	regionAt := make(map[Vec2]int)
+	for y := int(m.Height) - 1; y >= 0; y-- {
+		for x := 0; x < int(m.Width); x++ {
+			p := Vec2{x, y}
+			def, _ := m.Tile(p)
+			if _, done := regionAt[p]; done || def.Type != "floor" {
+				continue
+			}
+			r := Region{ID: len(regions), Description: def.Value, Min: p, Max: p, Links: []RegionLink{}}
+			tiles := flood(m, p, func(other LayoutDef) bool {
+				return other.Type == "floor" && other.Value == def.Value
+			})
+			sort.Slice(tiles, func(i, j int) bool {
+				if tiles[i].Y != tiles[j].Y {
+					return tiles[i].Y > tiles[j].Y
+				}
+				return tiles[i].X < tiles[j].X
+			})
+			for _, t := range tiles {
+				regionAt[t] = r.ID
+				if n := len(r.Runs) - 1; n >= 0 && r.Runs[n][0] == t.Y && r.Runs[n][2] == t.X-1 {
+					r.Runs[n][2] = t.X
+				} else {
+					r.Runs = append(r.Runs, [3]int{t.Y, t.X, t.X})
+				}
+				if t.X < r.Min.X {
+					r.Min.X = t.X
+				}
+				if t.Y < r.Min.Y {
+					r.Min.Y = t.Y
+				}
+				if t.X > r.Max.X {
+					r.Max.X = t.X
+				}
+				if t.Y > r.Max.Y {
+					r.Max.Y = t.Y
+				}
+			}
+			regions = append(regions, r)
+		}
+	}
+
+	linked := make(map[RegionLink]map[int]bool)
+	link := func(a, b int, via, color string) {
+		if a == b {
+			return
+		}
+		for _, l := range [][2]int{{a, b}, {b, a}} {
+			key := RegionLink{l[1], via, color}
+			if linked[key] == nil {
+				linked[key] = make(map[int]bool)
+			}
+			if !linked[key][l[0]] {
+				linked[key][l[0]] = true
+				regions[l[0]].Links = append(regions[l[0]].Links, key)
+			}
+		}
+	}
+	crossed := make(map[Vec2]bool)
+	for y := int(m.Height) - 1; y >= 0; y-- {
+		for x := 0; x < int(m.Width); x++ {
+			p := Vec2{x, y}
+			def, _ := m.Tile(p)
+			switch def.Type {
+			case "floor":
+				for _, d := range regionNeighbors {
+					if other, ok := regionAt[Vec2{x + d.X, y + d.Y}]; ok {
+						link(regionAt[p], other, "open", "")
+					}
+				}
+			case "door", "exploding_wall":
+				if crossed[p] {
+					continue
+				}
+				var touching []int
+				for _, t := range flood(m, p, func(other LayoutDef) bool { return other == def }) {
+					crossed[t] = true
+					for _, d := range regionNeighbors {
+						if r, ok := regionAt[Vec2{t.X + d.X, t.Y + d.Y}]; ok {
+							touching = append(touching, r)
+						}
+					}
+				}
+				color := ""
+				if def.Type == "door" {
+					color = def.Value
+				}
+				for _, a := range touching {
+					for _, b := range touching {
+						link(a, b, def.Type, color)
+					}
+				}
+			}
+		}
+	}
+	for i := range regions {
+		links := regions[i].Links
+		sort.SliceStable(links, func(a, b int) bool { return links[a].Region < links[b].Region })
+	}
+	return regions
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+)
+
+// TestSegmentRuns checks the runs of a level's regions cover each floor tile
+// once, with its own description, inside the region's bounding box.
+func TestSegmentRuns(t *testing.T) {
+	loader := MapLoader{Assets: "extracted_assets"}
+	maps, err := loader.LoadAll([]string{"build/maps"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range maps {
+		covered := make(map[Vec2]bool)
+		for _, r := range Segment(m) {
+			for _, p := range r.Tiles() {
+				def, _ := m.Tile(p)
+				if covered[p] || def != Floor(r.Description) {
+					t.Errorf("%v: region %v has %v, which is %v", m.Title, r.ID, p, def)
+				}
+				if p.X < r.Min.X || p.Y < r.Min.Y || p.X > r.Max.X || p.Y > r.Max.Y {
+					t.Errorf("%v: region %v has %v outside %v to %v", m.Title, r.ID, p, r.Min, r.Max)
+				}
+				covered[p] = true
+			}
+		}
+		for y := 0; y < int(m.Height); y++ {
+			for x := 0; x < int(m.Width); x++ {
+				if def, _ := m.Tile(Vec2{x, y}); def.Type == "floor" && !covered[Vec2{x, y}] {
+					t.Errorf("%v: %v isn't in a region", m.Title, Vec2{x, y})
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Schema validates JSON documents against a JSON Schema (draft-07). Only the
+// keywords map.schema.json uses are implemented: $ref to local definitions,
+// type, enum, const, properties, required, additionalProperties,
+// propertyNames, items, minItems, maxItems, minimum, maximum, minLength,
+// pattern, allOf, anyOf, oneOf, not and if/then/else.
+type Schema struct {
+	root interface{}
+}
+
+// ValidationError is a violation at a JSON Pointer within the document.
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	path := e.Path
+	if path == "" {
+		path = "/"
+	}
+	return path + ": " + e.Message
+}
+
+func decodeJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func ParseSchema(data []byte) (*Schema, error) {
+	root, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{root}, nil
+}
+
+// Validate returns every violation in a JSON document.
+func (s *Schema) Validate(data []byte) ([]ValidationError, error) {
+	doc, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return s.validate(s.root, doc, ""), nil
+}
+
+func pointer(path string, token interface{}) string {
+	s := fmt.Sprint(token)
+	s = strings.Replace(s, "~", "~0", -1)
+	s = strings.Replace(s, "/", "~1", -1)
+	return path + "/" + s
+}
+
+func (s *Schema) resolve(ref string) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("only local $ref is supported, not %q", ref)
+	}
+	node := s.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		token = strings.Replace(token, "~1", "/", -1)
+		token = strings.Replace(token, "~0", "~", -1)
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("$ref %q does not resolve", ref)
+		}
+		if node, ok = obj[token]; !ok {
+			return nil, fmt.Errorf("$ref %q does not resolve", ref)
+		}
+	}
+	return node, nil
+}
+
+func jsonType(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	case json.Number:
+		if f, err := v.Float64(); err == nil && f == math.Trunc(f) {
+			return "integer"
+		}
+		return "number"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func hasType(v interface{}, want string) bool {
+	got := jsonType(v)
+	return got == want || want == "number" && got == "integer"
+}
+
+// equal compares JSON values, treating numbers by value.
+func equal(a, b interface{}) bool {
+	an, aok := a.(json.Number)
+	bn, bok := b.(json.Number)
+	if aok && bok {
+		af, _ := an.Float64()
+		bf, _ := bn.Float64()
+		return af == bf
+	}
+	if aok != bok {
+		return false
+	}
+	switch a := a.(type) {
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k := range a {
+			if !equal(a[k], b[k]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func compact(v interface{}) string {
+	out, _ := json.Marshal(v)
+	return string(out)
+}
+
+func number(v interface{}) (float64, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	return f, err == nil
+}
+
+func (s *Schema) valid(schema, doc interface{}) bool {
+	return len(s.validate(schema, doc, "")) == 0
+}
+
+func (s *Schema) validate(schema, doc interface{}, path string) (errs []ValidationError) {
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, ValidationError{path, fmt.Sprintf(format, args...)})
+	}
+	switch schema := schema.(type) {
+	case bool:
+		if !schema {
+			fail("is not allowed")
+		}
+		return
+	case map[string]interface{}:
+	default:
+		fail("schema is not an object")
+		return
+	}
+	sch := schema.(map[string]interface{})
+
+	if ref, ok := sch["$ref"].(string); ok {
+		target, err := s.resolve(ref)
+		if err != nil {
+			fail("%v", err)
+			return
+		}
+		return s.validate(target, doc, path) // $ref overrides siblings in draft-07
+	}
+
+	if t, ok := sch["type"]; ok {
+		var types []string
+		switch t := t.(type) {
+		case string:
+			types = []string{t}
+		case []interface{}:
+			for _, name := range t {
+				types = append(types, fmt.Sprint(name))
+			}
+		}
+		matched := false
+		for _, name := range types {
+			matched = matched || hasType(doc, name)
+		}
+		if !matched {
+			fail("expected %v, got %v", strings.Join(types, " or "), jsonType(doc))
+			return // further keywords would only repeat the mismatch
+		}
+	}
+
+	if values, ok := sch["enum"].([]interface{}); ok {
+		found := false
+		for _, value := range values {
+			found = found || equal(doc, value)
+		}
+		if !found {
+			var options []string
+			for _, value := range values {
+				options = append(options, compact(value))
+			}
+			fail("%v is not one of %v", compact(doc), strings.Join(options, ", "))
+		}
+	}
+	if value, ok := sch["const"]; ok && !equal(doc, value) {
+		fail("expected %v, got %v", compact(value), compact(doc))
+	}
+
+	if f, ok := number(doc); ok {
+		if min, ok := number(sch["minimum"]); ok && f < min {
+			fail("%v is less than the minimum of %v", doc, sch["minimum"])
+		}
+		if max, ok := number(sch["maximum"]); ok && f > max {
+			fail("%v is greater than the maximum of %v", doc, sch["maximum"])
+		}
+	}
+
+	if str, ok := doc.(string); ok {
+		if min, ok := number(sch["minLength"]); ok && float64(len([]rune(str))) < min {
+			fail("%q is shorter than %v characters", str, sch["minLength"])
+		}
+		if pattern, ok := sch["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err != nil {
+				fail("bad pattern %q: %v", pattern, err)
+			} else if !re.MatchString(str) {
+				fail("%q does not match %v", str, pattern)
+			}
+		}
+	}
+
+	if arr, ok := doc.([]interface{}); ok {
+		if items, ok := sch["items"]; ok {
+			for i, item := range arr {
+				errs = append(errs, s.validate(items, item, pointer(path, i))...)
+			}
+		}
+		if min, ok := number(sch["minItems"]); ok && float64(len(arr)) < min {
+			fail("has %v items, fewer than %v", len(arr), sch["minItems"])
+		}
+		if max, ok := number(sch["maxItems"]); ok && float64(len(arr)) > max {
+			fail("has %v items, more than %v", len(arr), sch["maxItems"])
+		}
+	}
+
+	if obj, ok := doc.(map[string]interface{}); ok {
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		if required, ok := sch["required"].([]interface{}); ok {
+			for _, name := range required {
+				if _, ok := obj[name.(string)]; !ok {
+					fail("missing required property %q", name)
+				}
+			}
+		}
+		props, _ := sch["properties"].(map[string]interface{})
+		for _, key := range keys {
+			if prop, ok := props[key]; ok {
+				errs = append(errs, s.validate(prop, obj[key], pointer(path, key))...)
+			} else if additional, ok := sch["additionalProperties"]; ok {
+				if additional == false {
+					fail("unexpected property %q", key)
+				} else {
+					errs = append(errs, s.validate(additional, obj[key], pointer(path, key))...)
+				}
+			}
+		}
+		if names, ok := sch["propertyNames"]; ok {
+			for _, key := range keys {
+				for _, err := range s.validate(names, key, pointer(path, key)) {
+					errs = append(errs, ValidationError{err.Path, "property name " + err.Message})
+				}
+			}
+		}
+	}
+
+	if all, ok := sch["allOf"].([]interface{}); ok {
+		for _, sub := range all {
+			errs = append(errs, s.validate(sub, doc, path)...)
+		}
+	}
+	if any, ok := sch["anyOf"].([]interface{}); ok {
+		matched := false
+		for _, sub := range any {
+			matched = matched || s.valid(sub, doc)
+		}
+		if !matched {
+			fail("does not match any of the allowed schemas")
+		}
+	}
+	if one, ok := sch["oneOf"].([]interface{}); ok {
+		matches := 0
+		for _, sub := range one {
+			if s.valid(sub, doc) {
+				matches++
+			}
+		}
+		if matches != 1 {
+			fail("matches %v of the allowed schemas instead of exactly one", matches)
+		}
+	}
+	if not, ok := sch["not"]; ok && s.valid(not, doc) {
+		if n, ok := not.(map[string]interface{}); ok && n["required"] != nil {
+			fail("must not have %v", compact(n["required"]))
+		} else {
+			fail("matches a schema it must not")
+		}
+	}
+	if cond, ok := sch["if"]; ok {
+		if s.valid(cond, doc) {
+			if then, ok := sch["then"]; ok {
+				errs = append(errs, s.validate(then, doc, path)...)
+			}
+		} else if els, ok := sch["else"]; ok {
+			errs = append(errs, s.validate(els, doc, path)...)
+		}
+	}
+	return errs
+}
+
+// lineOf returns the 1-based line of a byte offset, for messages about
+// documents that are not valid JSON.
+func lineOf(data []byte, offset int64) string {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	return strconv.Itoa(bytes.Count(data[:offset], []byte("\n")) + 1)
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+// solveLimit is how many combinations of opened doors Solvable tries
+// before giving up.
+const solveLimit = 100000
+
+// Goals are where the player has to get to finish a level: its warp gates,
+// or Nemesis on the last level, which has none.
+func Goals(m *JsonMap) []Vec2 {
+	var goals, nemesis []Vec2
+	for _, e := range m.Entities {
+		switch e.Type {
+		case "WarpGate":
+			goals = append(goals, e.Position)
+		case "Nemesis":
+			nemesis = append(nemesis, e.Position)
+		}
+	}
+	if len(goals) == 0 {
+		return nemesis
+	}
+	return goals
+}
+
+// KeyColor returns the colour of door a key entity opens, or "".
+func KeyColor(entityType string) string {
+	if !strings.HasSuffix(entityType, "Key") {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSuffix(entityType, "Key"))
+}
+
+// Solvable reports whether the player can get from the start to a goal.
+// Levels where the doors can be opened in too many ways are reported as not
+// solvable.
+func Solvable(m *JsonMap) bool {
+	return ReachWithKeys(m, true).Goal
+}
+
+// KeyedReach is what the player can get to in a level, in at least one of
+// the orders of opening its doors.
+type KeyedReach struct {
+	Tiles map[Vec2]bool // including the tiles of doors opened
+	Doors map[Vec2]bool // the tiles of doors that can be opened
+	Keys  map[Vec2]bool // where the keys that can be picked up are
+	Goal  bool
+}
+
+// ReachWithKeys finds what the player can get to. Keys are used up by the
+// door they open, as in src/player.js, so it tries opening doors in
+// different orders; a door made of several tiles opens all at once.
+// Exploding walls can always be shot down, and warp gates end the level, so
+// nothing is reached through them. With stopAtGoal, it gives up looking as
+// soon as it reaches a goal.
+func ReachWithKeys(m *JsonMap, stopAtGoal bool) KeyedReach {
+	reach := KeyedReach{make(map[Vec2]bool), make(map[Vec2]bool), make(map[Vec2]bool), false}
+	goals := make(map[Vec2]bool)
+	for _, p := range Goals(m) {
+		goals[p] = true
+	}
+	gates := make(map[Vec2]Vec2)
+	keys := make(map[Vec2]string)
+	warps := make(map[Vec2]bool)
+	for _, e := range m.Entities {
+		if dest, ok := ValueVec2(e.Value); ok && e.Type == "JumpGate" {
+			gates[e.Position] = dest
+		}
+		if color := KeyColor(e.Type); color != "" {
+			keys[e.Position] = color
+		}
+		if e.Type == "WarpGate" {
+			warps[e.Position] = true
+		}
+	}
+	doorAt := make(map[Vec2]int)
+	var doorColors []string
+	var doorTiles [][]Vec2
+	for y := 0; y < int(m.Height); y++ {
+		for x := 0; x < int(m.Width); x++ {
+			p := Vec2{x, y}
+			def, _ := m.Tile(p)
+			if _, done := doorAt[p]; done || def.Type != "door" {
+				continue
+			}
+			tiles := flood(m, p, func(other LayoutDef) bool { return other == def })
+			for _, t := range tiles {
+				doorAt[t] = len(doorColors)
+			}
+			doorColors = append(doorColors, def.Value)
+			doorTiles = append(doorTiles, tiles)
+		}
+	}
+
+	// walk goes everywhere from start the open doors allow, marking tiles in
+	// reached and noting the closed doors it comes to
+	walk := func(start []Vec2, opened []bool, reached map[Vec2]bool, closed map[int]bool) {
+		queue := append([]Vec2(nil), start...)
+		for len(queue) > 0 {
+			p := queue[0]
+			queue = queue[1:]
+			def, ok := m.Tile(p)
+			if reached[p] || !ok || !Passable(def) {
+				continue
+			}
+			if door, isDoor := doorAt[p]; isDoor && !opened[door] {
+				closed[door] = true
+				continue
+			}
+			reached[p] = true
+			if warps[p] {
+				continue
+			}
+			queue = append(queue, Vec2{p.X - 1, p.Y}, Vec2{p.X + 1, p.Y}, Vec2{p.X, p.Y - 1}, Vec2{p.X, p.Y + 1})
+			if dest, ok := gates[p]; ok {
+				queue = append(queue, dest)
+			}
+		}
+	}
+
+	tried := make(map[string]bool)
+	var try func(opened []bool) bool
+	try = func(opened []bool) bool {
+		state := make([]byte, len(opened))
+		for i, o := range opened {
+			if o {
+				state[i] = 1
+			}
+		}
+		if tried[string(state)] || len(tried) >= solveLimit {
+			return false
+		}
+		tried[string(state)] = true
+
+		reached := make(map[Vec2]bool)
+		closed := make(map[int]bool)
+		walk([]Vec2{m.PlayerStart.Position}, opened, reached, closed)
+		spare := make(map[string]int)
+		for p := range reached {
+			reach.Tiles[p] = true
+			if goals[p] {
+				reach.Goal = true
+			}
+			if color, ok := keys[p]; ok {
+				spare[color]++
+				reach.Keys[p] = true
+			}
+		}
+		if reach.Goal && stopAtGoal {
+			return true
+		}
+		for door, o := range opened {
+			if o {
+				spare[doorColors[door]]--
+			}
+		}
+
+		var openable []int
+		for door := range closed {
+			if spare[doorColors[door]] > 0 {
+				openable = append(openable, door)
+			}
+		}
+		sort.Ints(openable)
+		for _, door := range openable {
+			for _, t := range doorTiles[door] {
+				reach.Doors[t] = true
+			}
+		}
+		var branches []int
+		for _, door := range openable {
+			next := append([]bool(nil), opened...)
+			next[door] = true
+			behind := make(map[Vec2]bool)
+			for p := range reached {
+				behind[p] = true
+			}
+			beyond := make(map[int]bool)
+			walk(doorTiles[door], next, behind, beyond)
+			keysBehind := false
+			for p := range behind {
+				if reached[p] {
+					continue
+				}
+				if color, ok := keys[p]; ok {
+					keysBehind = true
+					if color == doorColors[door] {
+						// a door with a key of its colour behind it pays
+						// for itself, so there's nothing to lose by opening
+						// it before the others
+						return try(next)
+					}
+				}
+			}
+			if !keysBehind && len(beyond) == 0 {
+				// nothing behind the door opens anything else, so note what
+				// it leads to without using a key on it
+				for p := range behind {
+					reach.Tiles[p] = true
+					reach.Goal = reach.Goal || goals[p]
+				}
+				if reach.Goal && stopAtGoal {
+					return true
+				}
+				continue
+			}
+			branches = append(branches, door)
+		}
+		for _, door := range branches {
+			next := append([]bool(nil), opened...)
+			next[door] = true
+			if try(next) {
+				return true
+			}
+		}
+		return false
+	}
+	try(make([]bool, len(doorColors)))
+	return reach
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"testing"
+)
+
+// testLevel builds a level from rows of glyphs, north at the top: # is a
+// wall, r and y red and yellow doors, k and K red and yellow keys, @ the
+// start, W a warp gate and 1 and 2 a pair of jump gates. Everything else,
+// and the tiles under entities, is floor.
+func testLevel(rows ...string) *JsonMap {
+	m := &JsonMap{Version: CurrentVersion, Title: "Test", LevelNumber: 1, Entities: []Entity{}}
+	layout := make([][]LayoutDef, len(rows))
+	gates := make(map[rune]int)
+	for row, line := range rows {
+		for col, c := range line {
+			p := Vec2{col, len(rows) - 1 - row}
+			def := Floor("")
+			switch c {
+			case '#':
+				def = Wall("stone")
+			case 'r':
+				def = Door("red")
+			case 'y':
+				def = Door("yellow")
+			case 'k':
+				m.Entities = append(m.Entities, Entity{Type: "RedKey", Position: p})
+			case 'K':
+				m.Entities = append(m.Entities, Entity{Type: "YellowKey", Position: p})
+			case '@':
+				m.PlayerStart = Entity{Position: p, Direction: &Vec2{1, 0}}
+			case 'W':
+				m.Entities = append(m.Entities, Entity{Type: "WarpGate", Position: p, Value: MapNames[1]})
+			case '1', '2':
+				gates[c] = len(m.Entities)
+				m.Entities = append(m.Entities, Entity{Type: "JumpGate", Position: p})
+			}
+			layout[row] = append(layout[row], def)
+		}
+	}
+	if len(gates) == 2 {
+		a, b := &m.Entities[gates['1']], &m.Entities[gates['2']]
+		a.Value, b.Value = b.Position, a.Position
+	}
+	m.SetLayout(layout)
+	return m
+}
+
+func TestSolvable(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     []string
+		solvable bool
+	}{
+		{"open", []string{
+			"#######",
+			"#@...W#",
+			"#######",
+		}, true},
+		{"walled off", []string{
+			"#######",
+			"#@.#.W#",
+			"#######",
+		}, false},
+		{"key before its door", []string{
+			"########",
+			"#@k.r.W#",
+			"########",
+		}, true},
+		{"key behind its own door", []string{
+			"########",
+			"#@.r.kW#",
+			"########",
+		}, false},
+		{"key behind its own door with a way round", []string{
+			"########",
+			"#@.r.kW#",
+			"#......#",
+			"########",
+		}, true},
+		{"wrong key", []string{
+			"########",
+			"#@K.r.W#",
+			"########",
+		}, false},
+		{"multi-tile door opens with one key", []string{
+			"#########",
+			"#@k.rr.W#",
+			"#########",
+		}, true},
+		{"multi-tile door across a wide corridor", []string{
+			"#######",
+			"#@kr.W#",
+			"#..r..#",
+			"#######",
+		}, true},
+		{"one key for two doors", []string{
+			"##########",
+			"#@k.r.r.W#",
+			"##########",
+		}, false},
+		{"keys used in the right order", []string{
+			"###########",
+			"#@k.r.K.yW#",
+			"#.#########",
+			"#y.k#######",
+			"###########",
+		}, true},
+		{"door to a dead end leaves the key", []string{
+			"########",
+			"#@k.r.W#",
+			"#r######",
+			"#.######",
+			"########",
+		}, true},
+		{"jump gate", []string{
+			"#########",
+			"#@.1#2.W#",
+			"#########",
+		}, true},
+		{"jump gate behind a door", []string{
+			"#########",
+			"#@r1#2.W#",
+			"#########",
+		}, false},
+		{"jump gate to a key", []string{
+			"#########",
+			"#@1#2krW#",
+			"#########",
+		}, true},
+	}
+	for _, test := range tests {
+		m := testLevel(test.rows...)
+		if got := Solvable(m); got != test.solvable {
+			t.Errorf("%v: Solvable = %v, want %v", test.name, got, test.solvable)
+		}
+	}
+}
@@ -1,4 +1,5 @@
 import * as THREE from "three"
+import { audioListener, audioLoader } from "./audio"
 import { Clock } from "./clock"
 import { SetHQXFactor } from "./config"
 import { Enemy } from "./enemies"
@@ -240,10 +241,31 @@ export class Game {
 			that.player.position.copy(map.playerStart.position)
 			that.player.direction = map.playerStart.direction
 			that.huntPlayer()
+			that.playMusic(map.music)
 			that.loading = false
 		})
 	}
 
+	/** Loop a level's music track, carrying on if the last level had the same one. **/
+	playMusic(file) {
+		if (file == this.musicFile) {
+			return
+		}
+		if (this.music && this.music.isPlaying) {
+			this.music.stop()
+		}
+		this.musicFile = file
+		if (!file) {
+			return
+		}
+		this.music = this.music || new THREE.Audio(audioListener)
+		audioLoader.load(file, buffer => {
+			if (file == this.musicFile) {
+				this.music.setBuffer(buffer).setLoop(true).play()
+			}
+		})
+	}
+
 	huntPlayer() {
 		this.scene.traverse(obj => {
 			if ("hunt" in obj) {
@@ -258,9 +280,22 @@ export class Game {
 			const map = new Map(JSON.parse(savedState))
 			return Promise.resolve(map)
 		} else {
-			const path = "maps/" + name + ".map.json"
-			return fetch(path).then(r => r.json()).then(o => new Map(o))
+			return this.loadMapIndex().then(index => {
+				const entry = index.maps.find(m => m.name == name)
+				const path = "maps/" + (entry ? entry.file : name + ".map.json")
+				return fetch(path)
+			}).then(r => r.json()).then(o => new Map(o))
+		}
+	}
+
+	/** Map listing written by map2json's batch command. **/
+	loadMapIndex() {
+		if (!this.mapIndex) {
+			this.mapIndex = fetch("maps/index.json")
+				.then(r => r.json())
+				.catch(() => ({maps: []}))
 		}
+		return this.mapIndex
 	}
 
 	getGlobalState() {
@@ -337,6 +372,7 @@ export class Game {
 				that.player.direction = map.playerStart.direction
 			}
 			that.huntPlayer()
+			that.playMusic(map.music)
 			that.play()
 		})
 	}
@@ -73,7 +73,7 @@ export class Map {
 
 	toScene() {
 		const scene = new Scene()
-		scene.add(new AmbientLight())
+		scene.add(new AmbientLight(this.ambientLight !== undefined ? this.ambientLight : 0xffffff))
 		if (this.fog) {
 			scene.fog = new Fog(this.fog.color, this.fog.near, this.fog.far)
 		}
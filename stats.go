@@ -0,0 +1,273 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+func init() {
+	Commands["stats"] = StatsCommand
+}
+
+// Difficulties is how many difficulty settings MinDifficulty chooses between.
+const Difficulties = 3
+
+var KeyColors = []string{"red", "yellow", "green", "blue"}
+
+// LevelStats summarizes a level for balancing.
+type LevelStats struct {
+	LevelNumber int    `json:"levelNumber"`
+	Name        string `json:"name"`
+	Title       string `json:"title"`
+
+	// Entities counts each type of entity found at each difficulty.
+	Entities      map[string][Difficulties]int `json:"entities"`
+	TreasureScore int                          `json:"treasureScore"`
+	Potions       int                          `json:"potions"`
+	Bolts         int                          `json:"bolts"`
+	Nukes         int                          `json:"nukes"`
+	Keys          map[string]int               `json:"keys"`
+	Doors         map[string]int               `json:"doors"` // adjacent door tiles open together, so count as one
+
+	Area           int `json:"area"`
+	OpenArea       int `json:"openArea"` // tiles that aren't solid walls
+	ReachableArea  int `json:"reachableArea"`
+	LockedArea     int `json:"lockedArea"` // behind doors there aren't keys enough for
+	ExplodingWalls int `json:"explodingWalls"`
+}
+
+// Passable reports whether the player can get through a tile, given the
+// keys and bolts or nukes to do it with.
+func Passable(def LayoutDef) bool {
+	return def.Type != "wall"
+}
+
+// Reachable returns the tiles the player could get to from the start of the
+// level with every key they needed, through doors, exploding walls and jump
+// gates but not warp gates. ReachWithKeys finds what the keys in the level
+// really get to.
+func Reachable(m *JsonMap) map[Vec2]bool {
+	gates := make(map[Vec2]Vec2)
+	warps := make(map[Vec2]bool)
+	for _, e := range m.Entities {
+		if dest, ok := ValueVec2(e.Value); ok && e.Type == "JumpGate" {
+			gates[e.Position] = dest
+		}
+		if e.Type == "WarpGate" {
+			warps[e.Position] = true
+		}
+	}
+	reached := make(map[Vec2]bool)
+	queue := []Vec2{m.PlayerStart.Position}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		if def, ok := m.Tile(p); reached[p] || !ok || !Passable(def) {
+			continue
+		}
+		reached[p] = true
+		if warps[p] {
+			continue
+		}
+		queue = append(queue, Vec2{p.X - 1, p.Y}, Vec2{p.X + 1, p.Y}, Vec2{p.X, p.Y - 1}, Vec2{p.X, p.Y + 1})
+		if dest, ok := gates[p]; ok {
+			queue = append(queue, dest)
+		}
+	}
+	return reached
+}
+
+func NewLevelStats(m *JsonMap) *LevelStats {
+	stats := &LevelStats{
+		LevelNumber: m.LevelNumber,
+		Name:        MapName(m.LevelNumber, m.Title),
+		Title:       m.Title,
+		Entities:    make(map[string][Difficulties]int),
+		Keys:        make(map[string]int),
+		Doors:       make(map[string]int),
+		Area:        int(m.Width * m.Height),
+	}
+	for _, color := range KeyColors {
+		stats.Keys[color] = 0
+		stats.Doors[color] = 0
+	}
+	for _, e := range m.Entities {
+		counts := stats.Entities[e.Type]
+		for d := e.MinDifficulty; d < Difficulties; d++ {
+			counts[d]++
+		}
+		stats.Entities[e.Type] = counts
+		switch e.Type {
+		case "Treasure":
+			switch v := e.Value.(type) {
+			case int:
+				stats.TreasureScore += v
+			case float64:
+				stats.TreasureScore += int(v)
+			}
+		case "Potion":
+			stats.Potions++
+		case "Bolt":
+			stats.Bolts++
+		case "Nuke":
+			stats.Nukes++
+		case "RedKey", "YellowKey", "GreenKey", "BlueKey":
+			stats.Keys[strings.ToLower(strings.TrimSuffix(e.Type, "Key"))]++
+		}
+	}
+
+	counted := make(map[Vec2]bool)
+	for y := 0; y < int(m.Height); y++ {
+		for x := 0; x < int(m.Width); x++ {
+			p := Vec2{x, y}
+			def, _ := m.Tile(p)
+			if Passable(def) {
+				stats.OpenArea++
+			}
+			if def.Type == "exploding_wall" {
+				stats.ExplodingWalls++
+			}
+			if def.Type != "door" || counted[p] {
+				continue
+			}
+			stats.Doors[def.Value]++
+			// mark the rest of the door so it isn't counted again
+			queue := []Vec2{p}
+			for len(queue) > 0 {
+				q := queue[0]
+				queue = queue[1:]
+				if other, ok := m.Tile(q); counted[q] || !ok || other != def {
+					continue
+				}
+				counted[q] = true
+				queue = append(queue, Vec2{q.X - 1, q.Y}, Vec2{q.X + 1, q.Y}, Vec2{q.X, q.Y - 1}, Vec2{q.X, q.Y + 1})
+			}
+		}
+	}
+	stats.ReachableArea = len(ReachWithKeys(m, false).Tiles)
+	stats.LockedArea = len(Reachable(m)) - stats.ReachableArea
+	return stats
+}
+
+// EntityTypes returns every entity type in the stats, sorted.
+func EntityTypes(stats []*LevelStats) []string {
+	seen := make(map[string]bool)
+	var types []string
+	for _, s := range stats {
+		for t := range s.Entities {
+			if !seen[t] {
+				seen[t] = true
+				types = append(types, t)
+			}
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+func (s *LevelStats) Monsters(difficulty int) int {
+	n := 0
+	for t, counts := range s.Entities {
+		if IsMonster(t) {
+			n += counts[difficulty]
+		}
+	}
+	return n
+}
+
+func WriteStatsTable(stats []*LevelStats) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "level\ttitle\tarea\topen\treachable\tlocked\texploding\ttreasure\tpotions\tbolts\tnukes\tkeys/doors r y g b\tmonsters easy/normal/hard\t")
+	for _, s := range stats {
+		var keys []string
+		for _, color := range KeyColors {
+			keys = append(keys, fmt.Sprintf("%v/%v", s.Keys[color], s.Doors[color]))
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v/%v/%v\t\n",
+			s.LevelNumber, s.Title, s.Area, s.OpenArea, s.ReachableArea, s.LockedArea, s.ExplodingWalls,
+			s.TreasureScore, s.Potions, s.Bolts, s.Nukes, strings.Join(keys, " "),
+			s.Monsters(0), s.Monsters(1), s.Monsters(2))
+	}
+	w.Flush()
+}
+
+func WriteStatsCSV(stats []*LevelStats) error {
+	types := EntityTypes(stats)
+	header := []string{"level", "name", "title", "area", "openArea", "reachableArea", "lockedArea", "explodingWalls", "treasureScore", "potions", "bolts", "nukes"}
+	for _, color := range KeyColors {
+		header = append(header, color+"Keys", color+"Doors")
+	}
+	for _, t := range types {
+		for d := 0; d < Difficulties; d++ {
+			header = append(header, fmt.Sprintf("%v%v", t, d))
+		}
+	}
+	w := csv.NewWriter(os.Stdout)
+	w.Write(header)
+	for _, s := range stats {
+		record := []string{strconv.Itoa(s.LevelNumber), s.Name, s.Title}
+		for _, n := range []int{s.Area, s.OpenArea, s.ReachableArea, s.LockedArea, s.ExplodingWalls, s.TreasureScore, s.Potions, s.Bolts, s.Nukes} {
+			record = append(record, strconv.Itoa(n))
+		}
+		for _, color := range KeyColors {
+			record = append(record, strconv.Itoa(s.Keys[color]), strconv.Itoa(s.Doors[color]))
+		}
+		for _, t := range types {
+			for _, n := range s.Entities[t] {
+				record = append(record, strconv.Itoa(n))
+			}
+		}
+		w.Write(record)
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func StatsCommand(args []string) {
+	flags := flag.NewFlagSet("stats", flag.ExitOnError)
+	format := flags.String("format", "table", "output format: table, csv or json")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json stats [flags] map-or-dir...")
+		fmt.Fprintln(flags.Output(), "Entities are counted at each difficulty, so hard includes those on easy and normal.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	maps, err := loader.LoadAll(flags.Args())
+	if err != nil {
+		panic(err)
+	}
+	var stats []*LevelStats
+	for _, m := range maps {
+		stats = append(stats, NewLevelStats(m))
+	}
+
+	switch *format {
+	case "table":
+		WriteStatsTable(stats)
+	case "csv":
+		err = WriteStatsCSV(stats)
+	case "json":
+		var out []byte
+		if out, err = MarshalIndent(stats); err == nil {
+			fmt.Println(string(out))
+		}
+	default:
+		err = fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"image/color"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+func init() {
+	Commands["svg"] = SVGCommand
+}
+
+// SVGLayers are the groups an SVG map is drawn in, in drawing order, with
+// the label each has in the legend.
+var SVGLayers = []struct{ ID, Label string }{
+	{"floors", "Floors"},
+	{"walls", "Walls"},
+	{"doors", "Doors"},
+	{"items", "Items"},
+	{"enemies-0", "Enemies (easy)"},
+	{"enemies-1", "Enemies (normal)"},
+	{"enemies-2", "Enemies (hard)"},
+	{"links", "Warp and jump gates"},
+	{"player-start", "Player start"},
+}
+
+const svgScript = `
+function toggle(id, label) {
+	var g = document.getElementById(id)
+	var hidden = g.style.display != "none"
+	g.style.display = hidden ? "none" : ""
+	label.style.opacity = hidden ? 0.4 : 1
+}
+`
+
+// ValueVec2 returns an entity value that holds a position, such as where a
+// jump gate leads, whether it was made by Convert or decoded from JSON.
+func ValueVec2(value interface{}) (Vec2, bool) {
+	switch v := value.(type) {
+	case Vec2:
+		return v, true
+	case *Vec2:
+		return *v, v != nil
+	case map[string]interface{}:
+		x, xok := v["x"].(float64)
+		y, yok := v["y"].(float64)
+		return Vec2{int(x), int(y)}, xok && yok
+	}
+	return Vec2{}, false
+}
+
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func escapeXML(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// TileTitle describes a legend entry for tooltips.
+func TileTitle(def LayoutDef) string {
+	switch def.Type {
+	case "wall":
+		return def.Value + " wall"
+	case "exploding_wall":
+		return "exploding " + def.Value + " wall"
+	case "door":
+		return def.Value + " door"
+	}
+	if def.Value != "" {
+		return def.Value
+	}
+	return "floor"
+}
+
+// EntityTitle describes an entity and its value for tooltips.
+func EntityTitle(e Entity) string {
+	title := e.Type
+	if e.Text != "" {
+		title += ": " + e.Text
+	} else if v, ok := ValueVec2(e.Value); ok {
+		title += " to " + v.String()
+	} else if e.Value != nil {
+		title += fmt.Sprintf(": %v", e.Value)
+	}
+	if e.MinDifficulty > 0 {
+		title += fmt.Sprintf(" (difficulty %v)", e.MinDifficulty)
+	}
+	return fmt.Sprintf("%v at %v", title, e.Position)
+}
+
+// SVGMap draws levels as SVG with each tile Scale units wide.
+type SVGMap struct {
+	Scale int
+}
+
+func (sm SVGMap) Write(w io.Writer, m *JsonMap) error {
+	bw := bufio.NewWriter(w)
+	s := sm.Scale
+	width, height := int(m.Width)*s, int(m.Height)*s
+	legendWidth := 10 * s
+
+	fmt.Fprintf(bw, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:inkscape=\"http://www.inkscape.org/namespaces/inkscape\" width=\"%v\" height=\"%v\" viewBox=\"0 0 %v %v\">\n",
+		width+legendWidth, height, width+legendWidth, height)
+	fmt.Fprintf(bw, "<title>%v: %v</title>\n", m.LevelNumber, escapeXML(m.Title))
+	fmt.Fprintf(bw, "<script><![CDATA[%v]]></script>\n", svgScript)
+
+	layers := make(map[string]*bytes.Buffer)
+	for _, layer := range SVGLayers {
+		layers[layer.ID] = new(bytes.Buffer)
+	}
+
+	regions := map[string]int{"": 0}
+	for _, def := range m.Legend {
+		if _, ok := regions[def.Value]; def.Type == "floor" && !ok {
+			regions[def.Value] = len(regions)
+		}
+	}
+	for row, line := range m.Layout {
+		// join runs of the same tile so big maps stay small
+		for col := 0; col < len(line); {
+			run := 1
+			for col+run < len(line) && line[col+run] == line[col] {
+				run++
+			}
+			def := m.Legend[line[col]]
+			var layer, fill string
+			switch def.Type {
+			case "wall", "exploding_wall":
+				layer, fill = "walls", hexColor(WallColors[def.Value])
+			case "door":
+				layer, fill = "doors", hexColor(DoorColors[def.Value])
+			default:
+				layer, fill = "floors", hexColor(RegionColor(regions[def.Value]))
+			}
+			fmt.Fprintf(layers[layer], "<rect x=\"%v\" y=\"%v\" width=\"%v\" height=\"%v\" fill=\"%v\"", col*s, row*s, run*s, s, fill)
+			if def.Type == "exploding_wall" {
+				fmt.Fprintf(layers[layer], " stroke=\"%v\" stroke-dasharray=\"2\"", hexColor(FloorColor))
+			}
+			fmt.Fprintf(layers[layer], "><title>%v</title></rect>\n", escapeXML(TileTitle(def)))
+			col += run
+		}
+	}
+
+	center := func(p Vec2) (float64, float64) {
+		return (float64(p.X) + 0.5) * float64(s), (float64(int(m.Height)-1-p.Y) + 0.5) * float64(s)
+	}
+	// number each pair of jump gates so the ends can be matched up where the
+	// lines between them cross
+	pairs := make(map[[2]Vec2]int)
+	pairOf := func(a, b Vec2) [2]Vec2 {
+		if b.Y > a.Y || b.Y == a.Y && b.X < a.X {
+			a, b = b, a
+		}
+		return [2]Vec2{a, b}
+	}
+	for _, e := range m.Entities {
+		if dest, ok := ValueVec2(e.Value); ok && e.Type == "JumpGate" {
+			if _, seen := pairs[pairOf(e.Position, dest)]; !seen {
+				pairs[pairOf(e.Position, dest)] = len(pairs) + 1
+			}
+		}
+	}
+	linked := make(map[[2]Vec2]bool)
+
+	start := m.PlayerStart
+	start.Type = "PlayerStart"
+	for _, e := range append([]Entity{start}, m.Entities...) {
+		icon, ok := EntityIcons[e.Type]
+		layer := "items"
+		switch {
+		case IsMonster(e.Type):
+			icon, ok = Icon{"circle", 0.35, DifficultyColors[e.MinDifficulty%len(DifficultyColors)]}, true
+			layer = fmt.Sprintf("enemies-%v", e.MinDifficulty)
+		case e.Type == "Grelminar" || e.Type == "Nemesis" || e.Type == "Fireball":
+			layer = fmt.Sprintf("enemies-%v", e.MinDifficulty)
+		case e.Type == "WarpGate" || e.Type == "JumpGate":
+			layer = "links"
+		case e.Type == "PlayerStart":
+			layer = "player-start"
+		}
+		buf, exists := layers[layer]
+		if !ok || !exists {
+			continue
+		}
+		x, y := center(e.Position)
+		r := icon.Size * float64(s)
+		title := escapeXML(EntityTitle(e))
+		fill := hexColor(icon.Color)
+		switch icon.Shape {
+		case "ring":
+			fmt.Fprintf(buf, "<circle cx=\"%v\" cy=\"%v\" r=\"%v\" fill=\"none\" stroke=\"%v\" stroke-width=\"%v\"><title>%v</title></circle>\n", x, y, r*0.8, fill, r*0.4, title)
+		case "square":
+			fmt.Fprintf(buf, "<rect x=\"%v\" y=\"%v\" width=\"%v\" height=\"%v\" fill=\"%v\"><title>%v</title></rect>\n", x-r, y-r, 2*r, 2*r, fill, title)
+		case "diamond":
+			fmt.Fprintf(buf, "<polygon points=\"%v,%v %v,%v %v,%v %v,%v\" fill=\"%v\"><title>%v</title></polygon>\n", x, y-r, x+r, y, x, y+r, x-r, y, fill, title)
+		case "arrow":
+			dx, dy := 0.0, -1.0
+			if e.Direction != nil {
+				dx, dy = float64(e.Direction.X), -float64(e.Direction.Y) // SVG Y points south
+			}
+			fmt.Fprintf(buf, "<polygon points=\"%v,%v %v,%v %v,%v\" fill=\"%v\"><title>%v</title></polygon>\n",
+				x+dx*r, y+dy*r, x-dx*r-dy*r, y-dy*r+dx*r, x-dx*r+dy*r, y-dy*r-dx*r, fill, title)
+		default:
+			fmt.Fprintf(buf, "<circle cx=\"%v\" cy=\"%v\" r=\"%v\" fill=\"%v\"><title>%v</title></circle>\n", x, y, r, fill, title)
+		}
+		if dest, ok := ValueVec2(e.Value); ok && e.Type == "JumpGate" {
+			pair := pairOf(e.Position, dest)
+			if !linked[pair] {
+				linked[pair] = true
+				x2, y2 := center(dest)
+				fmt.Fprintf(buf, "<line x1=\"%v\" y1=\"%v\" x2=\"%v\" y2=\"%v\" stroke=\"%v\" stroke-dasharray=\"%v\"><title>%v</title></line>\n", x, y, x2, y2, fill, s/4, title)
+			}
+			fmt.Fprintf(buf, "<text x=\"%v\" y=\"%v\" font-family=\"sans-serif\" font-size=\"%v\" text-anchor=\"middle\" fill=\"%v\">%v<title>%v</title></text>\n",
+				x, y-r-float64(s)/8, s/2, fill, pairs[pair], title)
+		}
+	}
+
+	for _, layer := range SVGLayers {
+		fmt.Fprintf(bw, "<g id=\"%v\" inkscape:groupmode=\"layer\" inkscape:label=\"%v\">\n", layer.ID, layer.Label)
+		bw.Write(layers[layer.ID].Bytes())
+		fmt.Fprintln(bw, "</g>")
+	}
+
+	fmt.Fprintf(bw, "<g id=\"legend\" font-family=\"sans-serif\" font-size=\"%v\" fill=\"black\">\n", s*3/4)
+	for i, layer := range SVGLayers {
+		fmt.Fprintf(bw, "<text x=\"%v\" y=\"%v\" style=\"cursor: pointer\" onclick=\"toggle('%v', this)\">%v</text>\n",
+			width+s/2, (i+1)*s*3/2, layer.ID, layer.Label)
+	}
+	fmt.Fprintln(bw, "</g>")
+	fmt.Fprintln(bw, "</svg>")
+	return bw.Flush()
+}
+
+func SVGCommand(args []string) {
+	flags := flag.NewFlagSet("svg", flag.ExitOnError)
+	outdir := flags.String("o", ".", "output directory")
+	scale := flags.Int("scale", 16, "SVG units per tile")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json svg [flags] map-or-dir...")
+		fmt.Fprintln(flags.Output(), "Draws each map as <name>.svg in the output directory. Click a layer's name in the legend to hide or show it.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 || *scale < 4 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	filenames, err := loader.Expand(flags.Args())
+	if err != nil {
+		panic(err)
+	}
+	if err := os.MkdirAll(*outdir, 0755); err != nil {
+		panic(err)
+	}
+	svg := SVGMap{Scale: *scale}
+	for _, filename := range filenames {
+		m, err := loader.Load(filename)
+		if err != nil {
+			panic(err)
+		}
+		f, err := os.Create(filepath.Join(*outdir, MapName(m.LevelNumber, m.Title)+".svg"))
+		if err != nil {
+			panic(err)
+		}
+		if err := svg.Write(f, m); err != nil {
+			panic(err)
+		}
+		if err := f.Close(); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,509 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	Commands["text-import"] = TextImportCommand
+	Commands["text-export"] = TextExportCommand
+}
+
+// A text map is a level written out for editing by hand. Settings and the
+// legend come first, then "map:" and a row of characters for each line of
+// the map, north at the top. Lines starting with // are comments.
+//
+//	title: The Approach
+//	level: 1
+//
+//	# = wall stone
+//	% = exploding_wall stone
+//	r = door red
+//	. = floor
+//	a = floor A long, dark corridor
+//	@ = PlayerStart north
+//	O = Orc difficulty 1
+//	W = WarpGate Nemesis's_Keep
+//	1 = JumpGate 1
+//	? = Scroll 3
+//	f = Fireball east on a
+//
+//	map:
+//	#########
+//	#@.%.1W.#
+//	#..r.O..#
+//	#########
+//
+// Entities stand on the floor next to them, unless "on" says which. The two
+// jump gates with the same number lead to each other, scrolls say what their
+// number's ScrollText does, and treasure is worth 100 times the level number
+// unless given a value.
+
+var textDirections = map[string]Vec2{"north": {0, 1}, "east": {1, 0}, "south": {0, -1}, "west": {-1, 0}}
+
+// textEntity is an entity's legend entry.
+type textEntity struct {
+	Entity
+	On rune // glyph of the floor under it, if not the one next to it
+}
+
+func ReadTextMap(r io.Reader) (*JsonMap, error) {
+	m := &JsonMap{Version: CurrentVersion, Entities: []Entity{}}
+	tiles := make(map[rune]LayoutDef)
+	entities := make(map[rune]textEntity)
+	entityTypes := make(map[string]bool)
+	for _, e := range EntityDict {
+		entityTypes[e.Type] = true
+	}
+
+	scanner := bufio.NewScanner(r)
+	var rows [][]rune
+	line, inMap := 0, false
+	for scanner.Scan() {
+		line++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if inMap {
+			rows = append(rows, []rune(text))
+			continue
+		}
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" || strings.HasPrefix(trimmed, "//") {
+			continue
+		}
+		if trimmed == "map:" {
+			inMap = true
+			continue
+		}
+		runes := []rune(trimmed)
+		if len(runes) < 4 || string(runes[1:4]) != " = " {
+			field := strings.SplitN(trimmed, ":", 2)
+			if len(field) != 2 {
+				return nil, fmt.Errorf("line %v: expected a setting, like title: The Approach, or a legend entry, like # = wall stone", line)
+			}
+			value := strings.TrimSpace(field[1])
+			switch field[0] {
+			case "title":
+				m.Title = value
+			case "level":
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("line %v: level %q isn't a number", line, value)
+				}
+				m.LevelNumber = n
+			case "music":
+				m.Music = value
+			default:
+				return nil, fmt.Errorf("line %v: unknown setting %q", line, field[0])
+			}
+			continue
+		}
+
+		glyph, rest := runes[0], strings.TrimSpace(string(runes[4:]))
+		if _, ok := tiles[glyph]; ok || entities[glyph].Type != "" {
+			return nil, fmt.Errorf("line %v: %q is already in the legend", line, glyph)
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("line %v: %q needs a tile or entity type", line, glyph)
+		}
+		switch fields[0] {
+		case "floor":
+			tiles[glyph] = Floor(strings.TrimSpace(strings.TrimPrefix(rest, "floor")))
+		case "wall", "exploding_wall", "door":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("line %v: %v needs one value, like %v stone", line, fields[0], fields[0])
+			}
+			tiles[glyph] = LayoutDef{fields[0], fields[1]}
+		default:
+			if !entityTypes[fields[0]] {
+				return nil, fmt.Errorf("line %v: unknown tile or entity type %q", line, fields[0])
+			}
+			e := textEntity{Entity: Entity{Type: fields[0]}}
+			for i := 1; i < len(fields); i++ {
+				field := fields[i]
+				if d, ok := textDirections[field]; ok {
+					e.Direction = &d
+				} else if (field == "difficulty" || field == "on") && i+1 < len(fields) {
+					i++
+					if field == "on" && len([]rune(fields[i])) == 1 {
+						e.On = []rune(fields[i])[0]
+					} else if n, err := strconv.Atoi(fields[i]); field == "difficulty" && err == nil {
+						e.MinDifficulty = n
+					} else {
+						return nil, fmt.Errorf("line %v: bad %v %q", line, field, fields[i])
+					}
+				} else if e.Value != nil {
+					return nil, fmt.Errorf("line %v: %q has more than one value", line, glyph)
+				} else if n, err := strconv.Atoi(field); err == nil {
+					e.Value = n
+				} else {
+					e.Value = field
+				}
+			}
+			if _, ok := e.Value.(int); e.Type == "JumpGate" && !ok {
+				return nil, fmt.Errorf("line %v: jump gates need a number to pair them by", line)
+			}
+			if e.Type == "Scroll" {
+				n, _ := e.Value.(int)
+				if ScrollText[n] == "" {
+					return nil, fmt.Errorf("line %v: scrolls are numbered 1 to %v", line, len(ScrollText))
+				}
+				e.Text = ScrollText[n]
+			}
+			entities[glyph] = e
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for len(rows) > 0 && strings.TrimSpace(string(rows[len(rows)-1])) == "" {
+		rows = rows[:len(rows)-1]
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no map: section")
+	}
+	for _, e := range entities {
+		if _, ok := tiles[e.On]; e.On != 0 && (!ok || tiles[e.On].Type != "floor") {
+			return nil, fmt.Errorf("%v is on %q, which isn't a floor", e.Type, e.On)
+		}
+	}
+
+	m.Width, m.Height = uint(len(rows[0])), uint(len(rows))
+	layout := make([][]LayoutDef, len(rows))
+	var placed []textEntity
+	for row, glyphs := range rows {
+		if len(glyphs) != len(rows[0]) {
+			return nil, fmt.Errorf("map row %v is %v wide instead of %v", row+1, len(glyphs), len(rows[0]))
+		}
+		layout[row] = make([]LayoutDef, len(glyphs))
+		for x, glyph := range glyphs {
+			if def, ok := tiles[glyph]; ok {
+				layout[row][x] = def
+			} else if e, ok := entities[glyph]; ok {
+				e.Position = Vec2{x, len(rows) - 1 - row}
+				placed = append(placed, e)
+			} else {
+				return nil, fmt.Errorf("map row %v, column %v: %q isn't in the legend", row+1, x+1, glyph)
+			}
+		}
+	}
+
+	starts := 0
+	floors := make([]LayoutDef, len(placed))
+	for i, e := range placed {
+		floors[i] = tiles[e.On]
+		if e.On == 0 {
+			floors[i] = textFloorNear(layout, e.Position)
+		}
+	}
+	for i, e := range placed {
+		layout[len(rows)-1-e.Position.Y][e.Position.X] = floors[i]
+		switch e.Type {
+		case "PlayerStart":
+			starts++
+			e.Type = ""
+			if e.Direction == nil {
+				e.Direction = &Vec2{0, 1}
+			}
+			m.PlayerStart = e.Entity
+			continue
+		case "Fireball":
+			if e.Direction == nil {
+				e.Direction = &Vec2{0, 1}
+			}
+		case "Treasure":
+			if e.Value == nil {
+				e.Value = m.LevelNumber * 100 // as Convert does
+			}
+		}
+		m.Entities = append(m.Entities, e.Entity)
+	}
+	if starts != 1 {
+		return nil, fmt.Errorf("the map has %v PlayerStarts instead of one", starts)
+	}
+	if err := PairJumpGates(m.Entities); err != nil {
+		return nil, err
+	}
+
+	m.SetLayout(layout)
+	m.Fog = DefaultFog(m)
+	m.Regions = Segment(m)
+	return m, CheckMap(m)
+}
+
+// PairJumpGates links up jump gates that have the same number as their
+// value, so each leads to the other.
+func PairJumpGates(entities []Entity) error {
+	pairs := make(map[string][]int)
+	var order []string
+	for i, e := range entities {
+		if _, isVec := ValueVec2(e.Value); e.Type == "JumpGate" && !isVec {
+			pair := fmt.Sprint(e.Value)
+			if pairs[pair] == nil {
+				order = append(order, pair)
+			}
+			pairs[pair] = append(pairs[pair], i)
+		}
+	}
+	for _, pair := range order {
+		gates := pairs[pair]
+		if len(gates) != 2 {
+			return fmt.Errorf("jump gate %v has %v gates instead of two", pair, len(gates))
+		}
+		a, b := &entities[gates[0]], &entities[gates[1]]
+		a.Value, b.Value = b.Position, a.Position
+	}
+	return nil
+}
+
+// textFloorNear returns the first floor next to a position, going clockwise
+// from the north, or bare floor. Entities are left out of layout as zero
+// LayoutDefs, so don't count.
+func textFloorNear(layout [][]LayoutDef, p Vec2) LayoutDef {
+	for _, d := range regionNeighbors {
+		row, x := len(layout)-1-(p.Y+d.Y), p.X+d.X
+		if row >= 0 && row < len(layout) && x >= 0 && x < len(layout[row]) && layout[row][x].Type == "floor" {
+			return layout[row][x]
+		}
+	}
+	return Floor("")
+}
+
+// textGlyphs are handed out once the glyph an entry would rather have is taken.
+const textGlyphs = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789&+-=<>^~|_:;,'\"()[]{}"
+
+func WriteTextMap(w io.Writer, m *JsonMap) error {
+	start := m.PlayerStart
+	start.Type = "PlayerStart"
+	entityAt := make(map[Vec2]Entity)
+	pairs := make(map[Vec2]int)
+	for _, e := range append([]Entity{start}, m.Entities...) {
+		if _, ok := entityAt[e.Position]; ok {
+			return fmt.Errorf("more than one entity at %v", e.Position)
+		}
+		if def, _ := m.Tile(e.Position); def.Type != "floor" {
+			return fmt.Errorf("%v isn't on floor", EntityTitle(e))
+		}
+		entityAt[e.Position] = e
+		if dest, ok := ValueVec2(e.Value); ok && e.Type == "JumpGate" {
+			if n, ok := pairs[dest]; ok {
+				pairs[e.Position] = n
+			} else {
+				pairs[e.Position] = len(pairs) + 1
+			}
+		}
+	}
+
+	// the layout as ReadTextMap sees it before entities are placed
+	layout := make([][]LayoutDef, m.Height)
+	for row := range layout {
+		layout[row] = make([]LayoutDef, m.Width)
+		for x := range layout[row] {
+			p := Vec2{x, int(m.Height) - 1 - row}
+			if _, ok := entityAt[p]; !ok {
+				layout[row][x], _ = m.Tile(p)
+			}
+		}
+	}
+
+	used := make(map[rune]bool)
+	glyphs := make(map[string]rune)
+	exhausted := false
+	var tileLegend, entityLegend []string
+	glyph := func(entry string, preferred rune, isTile bool) rune {
+		if g, ok := glyphs[entry]; ok {
+			return g
+		}
+		g := preferred
+		for _, candidate := range textGlyphs {
+			if !used[g] && g != 0 {
+				break
+			}
+			g = candidate
+		}
+		if used[g] {
+			exhausted = true
+			return 0
+		}
+		used[g] = true
+		glyphs[entry] = g
+		if isTile {
+			tileLegend = append(tileLegend, fmt.Sprintf("%c = %v", g, entry))
+		} else {
+			entityLegend = append(entityLegend, fmt.Sprintf("%c = %v", g, entry))
+		}
+		return g
+	}
+	tileGlyph := func(def LayoutDef) rune {
+		preferred := map[string]rune{"wall": '#', "exploding_wall": '%', "door": 0, "floor": 0}[def.Type]
+		if def == Floor("") {
+			preferred = '.'
+		}
+		return glyph(strings.TrimSpace(def.Type+" "+def.Value), preferred, true)
+	}
+
+	var out [][]rune
+	for row := range layout {
+		out = append(out, make([]rune, m.Width))
+		for x := range layout[row] {
+			p := Vec2{x, int(m.Height) - 1 - row}
+			e, isEntity := entityAt[p]
+			if !isEntity {
+				out[row][x] = tileGlyph(layout[row][x])
+				continue
+			}
+			entry := []string{e.Type}
+			preferred := EntityGlyphs[e.Type]
+			if e.Direction != nil {
+				for word, d := range textDirections {
+					if d == *e.Direction {
+						entry = append(entry, word)
+					}
+				}
+			}
+			switch e.Type {
+			case "JumpGate":
+				entry = append(entry, fmt.Sprint(pairs[p]))
+				preferred = rune('0' + pairs[p]%10)
+			case "Treasure":
+				if v := fmt.Sprint(e.Value); v != fmt.Sprint(m.LevelNumber*100) {
+					entry = append(entry, v)
+				}
+			default:
+				if e.Value != nil {
+					entry = append(entry, fmt.Sprint(e.Value))
+				}
+			}
+			if e.MinDifficulty > 0 {
+				entry = append(entry, "difficulty", fmt.Sprint(e.MinDifficulty))
+				preferred = 0
+			}
+			if def, _ := m.Tile(p); def != textFloorNear(layout, p) {
+				entry = append(entry, "on", string(tileGlyph(def)))
+			}
+			out[row][x] = glyph(strings.Join(entry, " "), preferred, false)
+		}
+	}
+	if exhausted {
+		return fmt.Errorf("the level needs more than %v characters", len(textGlyphs))
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "title: %v\nlevel: %v\n", m.Title, m.LevelNumber)
+	if m.Music != "" {
+		fmt.Fprintf(bw, "music: %v\n", m.Music)
+	}
+	for _, legend := range [][]string{tileLegend, entityLegend} {
+		fmt.Fprintln(bw)
+		for _, entry := range legend {
+			fmt.Fprintln(bw, entry)
+		}
+	}
+	fmt.Fprintln(bw, "\nmap:")
+	for _, row := range out {
+		fmt.Fprintln(bw, string(row))
+	}
+	return bw.Flush()
+}
+
+func TextImportCommand(args []string) {
+	flags := flag.NewFlagSet("text-import", flag.ExitOnError)
+	outdir := flags.String("o", ".", "output directory")
+	v1 := flags.Bool("v1", false, "write the version 1 format, which is limited to 52 kinds of tile")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json text-import [flags] map.txt")
+		fmt.Fprintln(flags.Output(), "Writes <name>.map.json and <level>_<title>.c3dmap in the output directory. Floor")
+		fmt.Fprintln(flags.Output(), "descriptions in the .c3dmap are numbered by the level's text in EGAGRAPH.C3D.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(flags.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	m, err := ReadTextMap(f)
+	if err != nil {
+		panic(fmt.Errorf("%v: %v", flags.Arg(0), err))
+	}
+	var descriptions []string
+	if m.LevelNumber >= 1 && m.LevelNumber <= len(MapNames) {
+		graphics, err := OpenEGAGraph(*assets)
+		if err != nil {
+			panic(err)
+		}
+		if descriptions, err = graphics.LevelText(m.LevelNumber); err != nil {
+			panic(err)
+		}
+	}
+	c3dmap, text, err := Encode(m, descriptions)
+	if err != nil {
+		panic(fmt.Errorf("%v: %v", flags.Arg(0), err))
+	}
+	if added := text[len(descriptions):]; len(descriptions) > 0 && len(added) > 0 {
+		sort.Strings(added)
+		fmt.Fprintf(os.Stderr, "warning: level %v's text needs these lines for the .c3dmap: %q\n", m.LevelNumber, added)
+	}
+
+	if err := os.MkdirAll(*outdir, 0755); err != nil {
+		panic(err)
+	}
+	if *v1 {
+		m.Version = 1
+	}
+	if err := writeJSONFile(filepath.Join(*outdir, MapName(m.LevelNumber, m.Title)+".map.json"), m); err != nil {
+		panic(err)
+	}
+	c3dname := fmt.Sprintf("%v_%v.c3dmap", m.LevelNumber, strings.Replace(m.Title, " ", "_", -1))
+	if err := ioutil.WriteFile(filepath.Join(*outdir, c3dname), c3dmap.Bytes(), 0666); err != nil {
+		panic(err)
+	}
+}
+
+func TextExportCommand(args []string) {
+	flags := flag.NewFlagSet("text-export", flag.ExitOnError)
+	output := flags.String("o", "", "output file (default standard output)")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json text-export [flags] map")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	m, err := loader.Load(flags.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+	w := io.Writer(os.Stdout)
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			panic(err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := WriteTextMap(w, m); err != nil {
+		panic(fmt.Errorf("%v: %v", flags.Arg(0), err))
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestTextMapRoundTrip checks every level comes back the same after being
+// written out as a text map and read in again.
+func TestTextMapRoundTrip(t *testing.T) {
+	loader := MapLoader{Assets: "extracted_assets"}
+	maps, err := loader.LoadAll([]string{"build/maps"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range maps {
+		var text bytes.Buffer
+		if err := WriteTextMap(&text, m); err != nil {
+			t.Fatalf("%v: %v", m.Title, err)
+		}
+		back, err := ReadTextMap(&text)
+		if err != nil {
+			t.Fatalf("%v: %v", m.Title, err)
+		}
+		if d := DiffMaps(m, back); !d.Empty() {
+			var diff bytes.Buffer
+			d.WriteText(&diff)
+			t.Errorf("%v came back different:\n%v", m.Title, diff.String())
+		}
+	}
+}
+
+func TestReadTextMapErrors(t *testing.T) {
+	tests := []struct {
+		name, text string
+	}{
+		{"no map", "title: Test\n# = wall stone\n"},
+		{"unknown setting", "colour: red\nmap:\n###\n"},
+		{"unknown type", "# = brick\nmap:\n#\n"},
+		{"glyph used twice", "# = wall stone\n# = wall tar\nmap:\n#\n"},
+		{"glyph not in legend", "# = wall stone\nmap:\n#x#\n"},
+		{"wall without a value", "# = wall\nmap:\n#\n"},
+		{"one jump gate", "# = wall stone\n. = floor\n@ = PlayerStart north\n1 = JumpGate 1\nmap:\n#####\n#@.1#\n#####\n"},
+	}
+	for _, test := range tests {
+		if _, err := ReadTextMap(strings.NewReader(test.text)); err == nil {
+			t.Errorf("%v: no error", test.name)
+		}
+	}
+}
@@ -0,0 +1,735 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	Commands["tiled-export"] = TiledExportCommand
+	Commands["tiled-import"] = TiledImportCommand
+}
+
+// TiledTileSize is the size of tiles in Tiled maps, the size of the wall
+// textures.
+const TiledTileSize = 64
+
+// TiledMap is a map in the Tiled editor's TMX format. The same structure is
+// converted to and from TMJ, Tiled's JSON format.
+//
+// Each tile in the tileset is a legend entry, with the entry's type and
+// value as properties, so maps convert back exactly. Entities are objects
+// named after their type.
+type TiledMap struct {
+	XMLName      xml.Name           `xml:"map"`
+	Version      string             `xml:"version,attr"`
+	Orientation  string             `xml:"orientation,attr"`
+	RenderOrder  string             `xml:"renderorder,attr"`
+	Width        int                `xml:"width,attr"`
+	Height       int                `xml:"height,attr"`
+	TileWidth    int                `xml:"tilewidth,attr"`
+	TileHeight   int                `xml:"tileheight,attr"`
+	Infinite     int                `xml:"infinite,attr"`
+	NextLayerID  int                `xml:"nextlayerid,attr"`
+	NextObjectID int                `xml:"nextobjectid,attr"`
+	Properties   []TiledProperty    `xml:"properties>property"`
+	Tilesets     []TiledTileset     `xml:"tileset"`
+	Layers       []TiledLayer       `xml:"layer"`
+	ObjectGroups []TiledObjectGroup `xml:"objectgroup"`
+}
+
+type TiledProperty struct {
+	Name  string `xml:"name,attr"`
+	Type  string `xml:"type,attr,omitempty"` // string if empty
+	Value string `xml:"value,attr"`
+}
+
+type TiledTileset struct {
+	FirstGID   int         `xml:"firstgid,attr"`
+	Source     string      `xml:"source,attr,omitempty"`
+	Name       string      `xml:"name,attr"`
+	TileWidth  int         `xml:"tilewidth,attr"`
+	TileHeight int         `xml:"tileheight,attr"`
+	TileCount  int         `xml:"tilecount,attr"`
+	Columns    int         `xml:"columns,attr"`
+	Tiles      []TiledTile `xml:"tile"`
+}
+
+type TiledTile struct {
+	ID         int             `xml:"id,attr"`
+	Properties []TiledProperty `xml:"properties>property"`
+	Image      TiledImage      `xml:"image"`
+}
+
+type TiledImage struct {
+	Source string `xml:"source,attr"`
+	Width  int    `xml:"width,attr"`
+	Height int    `xml:"height,attr"`
+}
+
+type TiledLayer struct {
+	ID     int       `xml:"id,attr"`
+	Name   string    `xml:"name,attr"`
+	Width  int       `xml:"width,attr"`
+	Height int       `xml:"height,attr"`
+	Data   TiledData `xml:"data"`
+}
+
+type TiledData struct {
+	Encoding string `xml:"encoding,attr"`
+	CSV      string `xml:",chardata"`
+}
+
+type TiledObjectGroup struct {
+	ID      int           `xml:"id,attr"`
+	Name    string        `xml:"name,attr"`
+	Objects []TiledObject `xml:"object"`
+}
+
+type TiledObject struct {
+	ID         int             `xml:"id,attr"`
+	Name       string          `xml:"name,attr,omitempty"`
+	Type       string          `xml:"type,attr,omitempty"`
+	Class      string          `xml:"class,attr,omitempty"` // what Tiled 1.9 calls type
+	X          float64         `xml:"x,attr"`
+	Y          float64         `xml:"y,attr"`
+	Width      float64         `xml:"width,attr,omitempty"`
+	Height     float64         `xml:"height,attr,omitempty"`
+	Properties []TiledProperty `xml:"properties>property"`
+}
+
+func (p TiledProperty) MarshalJSON() ([]byte, error) {
+	var value interface{} = p.Value
+	switch p.Type {
+	case "int", "float", "bool":
+		value = json.RawMessage(p.Value)
+	case "":
+		p.Type = "string"
+	}
+	return json.Marshal(map[string]interface{}{"name": p.Name, "type": p.Type, "value": value})
+}
+
+func (p *TiledProperty) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Name  string          `json:"name"`
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	p.Name, p.Type, p.Value = raw.Name, raw.Type, string(raw.Value)
+	if len(raw.Value) > 0 && raw.Value[0] == '"' {
+		return json.Unmarshal(raw.Value, &p.Value)
+	}
+	return nil
+}
+
+func (p TiledProperty) Int() (int, error) {
+	f, err := strconv.ParseFloat(p.Value, 64)
+	return int(f), err
+}
+
+// tmj* are how Tiled's JSON format differs from TMX: tile and object layers
+// share a list, and tile data is an array.
+type tmjMap struct {
+	Type         string          `json:"type"`
+	Version      string          `json:"version"`
+	Orientation  string          `json:"orientation"`
+	RenderOrder  string          `json:"renderorder"`
+	Width        int             `json:"width"`
+	Height       int             `json:"height"`
+	TileWidth    int             `json:"tilewidth"`
+	TileHeight   int             `json:"tileheight"`
+	Infinite     bool            `json:"infinite"`
+	NextLayerID  int             `json:"nextlayerid"`
+	NextObjectID int             `json:"nextobjectid"`
+	Properties   []TiledProperty `json:"properties,omitempty"`
+	Tilesets     []tmjTileset    `json:"tilesets"`
+	Layers       []tmjLayer      `json:"layers"`
+}
+
+type tmjTileset struct {
+	FirstGID   int       `json:"firstgid"`
+	Source     string    `json:"source,omitempty"`
+	Name       string    `json:"name"`
+	TileWidth  int       `json:"tilewidth"`
+	TileHeight int       `json:"tileheight"`
+	TileCount  int       `json:"tilecount"`
+	Columns    int       `json:"columns"`
+	Tiles      []tmjTile `json:"tiles"`
+}
+
+type tmjTile struct {
+	ID          int             `json:"id"`
+	Image       string          `json:"image"`
+	ImageWidth  int             `json:"imagewidth"`
+	ImageHeight int             `json:"imageheight"`
+	Properties  []TiledProperty `json:"properties,omitempty"`
+}
+
+type tmjLayer struct {
+	ID      int         `json:"id"`
+	Name    string      `json:"name"`
+	Type    string      `json:"type"`
+	Width   int         `json:"width,omitempty"`
+	Height  int         `json:"height,omitempty"`
+	Data    []int       `json:"data,omitempty"`
+	Objects []tmjObject `json:"objects,omitempty"`
+	Opacity float64     `json:"opacity"`
+	Visible bool        `json:"visible"`
+	X       int         `json:"x"`
+	Y       int         `json:"y"`
+}
+
+type tmjObject struct {
+	ID         int             `json:"id"`
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	Class      string          `json:"class,omitempty"`
+	X          float64         `json:"x"`
+	Y          float64         `json:"y"`
+	Width      float64         `json:"width"`
+	Height     float64         `json:"height"`
+	Rotation   float64         `json:"rotation"`
+	Visible    bool            `json:"visible"`
+	Properties []TiledProperty `json:"properties,omitempty"`
+}
+
+func (t *TiledMap) toTMJ() (*tmjMap, error) {
+	out := &tmjMap{
+		Type:         "map",
+		Version:      t.Version,
+		Orientation:  t.Orientation,
+		RenderOrder:  t.RenderOrder,
+		Width:        t.Width,
+		Height:       t.Height,
+		TileWidth:    t.TileWidth,
+		TileHeight:   t.TileHeight,
+		NextLayerID:  t.NextLayerID,
+		NextObjectID: t.NextObjectID,
+		Properties:   t.Properties,
+	}
+	for _, ts := range t.Tilesets {
+		tileset := tmjTileset{ts.FirstGID, ts.Source, ts.Name, ts.TileWidth, ts.TileHeight, ts.TileCount, ts.Columns, nil}
+		for _, tile := range ts.Tiles {
+			tileset.Tiles = append(tileset.Tiles, tmjTile{tile.ID, tile.Image.Source, tile.Image.Width, tile.Image.Height, tile.Properties})
+		}
+		out.Tilesets = append(out.Tilesets, tileset)
+	}
+	for _, layer := range t.Layers {
+		data, err := layer.Data.GIDs()
+		if err != nil {
+			return nil, err
+		}
+		out.Layers = append(out.Layers, tmjLayer{ID: layer.ID, Name: layer.Name, Type: "tilelayer",
+			Width: layer.Width, Height: layer.Height, Data: data, Opacity: 1, Visible: true})
+	}
+	for _, group := range t.ObjectGroups {
+		layer := tmjLayer{ID: group.ID, Name: group.Name, Type: "objectgroup", Objects: []tmjObject{}, Opacity: 1, Visible: true}
+		for _, o := range group.Objects {
+			layer.Objects = append(layer.Objects, tmjObject{o.ID, o.Name, o.Type, o.Class, o.X, o.Y, o.Width, o.Height, 0, true, o.Properties})
+		}
+		out.Layers = append(out.Layers, layer)
+	}
+	return out, nil
+}
+
+func (in *tmjMap) toTMX() *TiledMap {
+	t := &TiledMap{
+		Version:      in.Version,
+		Orientation:  in.Orientation,
+		RenderOrder:  in.RenderOrder,
+		Width:        in.Width,
+		Height:       in.Height,
+		TileWidth:    in.TileWidth,
+		TileHeight:   in.TileHeight,
+		NextLayerID:  in.NextLayerID,
+		NextObjectID: in.NextObjectID,
+		Properties:   in.Properties,
+	}
+	for _, ts := range in.Tilesets {
+		tileset := TiledTileset{ts.FirstGID, ts.Source, ts.Name, ts.TileWidth, ts.TileHeight, ts.TileCount, ts.Columns, nil}
+		for _, tile := range ts.Tiles {
+			tileset.Tiles = append(tileset.Tiles, TiledTile{tile.ID, tile.Properties, TiledImage{tile.Image, tile.ImageWidth, tile.ImageHeight}})
+		}
+		t.Tilesets = append(t.Tilesets, tileset)
+	}
+	for _, layer := range in.Layers {
+		switch layer.Type {
+		case "tilelayer":
+			t.Layers = append(t.Layers, TiledLayer{layer.ID, layer.Name, layer.Width, layer.Height, NewTiledData(layer.Data, layer.Width)})
+		case "objectgroup":
+			group := TiledObjectGroup{ID: layer.ID, Name: layer.Name}
+			for _, o := range layer.Objects {
+				group.Objects = append(group.Objects, TiledObject{o.ID, o.Name, o.Type, o.Class, o.X, o.Y, o.Width, o.Height, o.Properties})
+			}
+			t.ObjectGroups = append(t.ObjectGroups, group)
+		}
+	}
+	return t
+}
+
+// NewTiledData lays out tile data as CSV with a line for each row, like
+// Tiled does.
+func NewTiledData(gids []int, width int) TiledData {
+	var buf bytes.Buffer
+	buf.WriteByte('\n')
+	for i, gid := range gids {
+		buf.WriteString(strconv.Itoa(gid))
+		if i < len(gids)-1 {
+			buf.WriteByte(',')
+		}
+		if (i+1)%width == 0 {
+			buf.WriteByte('\n')
+		}
+	}
+	return TiledData{"csv", buf.String()}
+}
+
+func (d TiledData) GIDs() ([]int, error) {
+	if d.Encoding != "csv" {
+		return nil, fmt.Errorf("tile layer data is %q, but only csv is supported", d.Encoding)
+	}
+	var gids []int
+	for _, field := range strings.Split(d.CSV, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+		gid, err := strconv.ParseUint(field, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("tile layer data: %v", err)
+		}
+		gids = append(gids, int(gid&0x1FFFFFFF)) // ignore the flip flags
+	}
+	return gids, nil
+}
+
+func tiledColor(c uint32) string {
+	return fmt.Sprintf("#ff%06x", c&0xFFFFFF)
+}
+
+func parseTiledColor(s string) (uint32, error) {
+	c, err := strconv.ParseUint(strings.TrimPrefix(s, "#"), 16, 32)
+	return uint32(c) & 0xFFFFFF, err
+}
+
+func tiledFloat(f float32) string {
+	return strconv.FormatFloat(float64(f), 'g', -1, 32)
+}
+
+func tiledVec2(v Vec2) string {
+	return fmt.Sprintf("%v,%v", v.X, v.Y)
+}
+
+func parseTiledVec2(s string) (Vec2, error) {
+	var v Vec2
+	_, err := fmt.Sscanf(strings.Replace(s, " ", "", -1), "%d,%d", &v.X, &v.Y)
+	return v, err
+}
+
+// TiledImages are where tile images are, relative to the Tiled map.
+type TiledImages struct {
+	Walls  string // textures from build/walls
+	Floors string // swatches written by WriteFloorSwatches
+}
+
+func (images TiledImages) Image(def LayoutDef, region int) TiledImage {
+	var source string
+	switch def.Type {
+	case "wall":
+		source = filepath.Join(images.Walls, def.Value+"_light.png")
+	case "exploding_wall":
+		source = filepath.Join(images.Walls, def.Value+"_dark.png")
+	case "door":
+		source = filepath.Join(images.Walls, def.Value+"_door.png")
+	default:
+		source = filepath.Join(images.Floors, fmt.Sprintf("%v.png", region))
+	}
+	return TiledImage{filepath.ToSlash(source), TiledTileSize, TiledTileSize}
+}
+
+// WriteFloorSwatches writes a plain tile image in each region color from
+// the minimap, since floors have no texture of their own.
+func WriteFloorSwatches(dir string, n int) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		img := image.NewRGBA(image.Rect(0, 0, TiledTileSize, TiledTileSize))
+		for p := 0; p < len(img.Pix); p += 4 {
+			c := RegionColor(i)
+			copy(img.Pix[p:], []byte{c.R, c.G, c.B, c.A})
+		}
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("%v.png", i)), buf.Bytes(), 0666); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func entityProperties(e Entity) []TiledProperty {
+	var props []TiledProperty
+	if v, ok := ValueVec2(e.Value); ok {
+		props = append(props, TiledProperty{"Value", "", tiledVec2(v)})
+	} else {
+		switch v := e.Value.(type) {
+		case int:
+			props = append(props, TiledProperty{"Value", "int", strconv.Itoa(v)})
+		case float64:
+			props = append(props, TiledProperty{"Value", "int", strconv.Itoa(int(v))})
+		case string:
+			props = append(props, TiledProperty{"Value", "", v})
+		}
+	}
+	if e.Direction != nil {
+		props = append(props, TiledProperty{"Direction", "", tiledVec2(*e.Direction)})
+	}
+	props = append(props, TiledProperty{"MinDifficulty", "int", strconv.Itoa(e.MinDifficulty)})
+	if e.Text != "" {
+		props = append(props, TiledProperty{"Text", "", e.Text})
+	}
+	return props
+}
+
+// ToTiled converts a map for Tiled. It returns how many floor regions the
+// tileset has swatches for.
+func ToTiled(m *JsonMap, images TiledImages) (*TiledMap, int) {
+	t := &TiledMap{
+		Version:     "1.8",
+		Orientation: "orthogonal",
+		RenderOrder: "right-down",
+		Width:       int(m.Width),
+		Height:      int(m.Height),
+		TileWidth:   TiledTileSize,
+		TileHeight:  TiledTileSize,
+		NextLayerID: 3,
+		Properties: []TiledProperty{
+			{"title", "", m.Title},
+			{"levelNumber", "int", strconv.Itoa(m.LevelNumber)},
+		},
+	}
+	if m.Fog != nil {
+		t.Properties = append(t.Properties,
+			TiledProperty{"fogColor", "color", tiledColor(m.Fog.Color)},
+			TiledProperty{"fogNear", "float", tiledFloat(m.Fog.Near)},
+			TiledProperty{"fogFar", "float", tiledFloat(m.Fog.Far)})
+	}
+	if m.AmbientLight != nil {
+		t.Properties = append(t.Properties, TiledProperty{"ambientLight", "color", tiledColor(*m.AmbientLight)})
+	}
+	if m.Music != "" {
+		t.Properties = append(t.Properties, TiledProperty{"music", "", m.Music})
+	}
+
+	regions := map[string]int{"": 0}
+	tileset := TiledTileset{FirstGID: 1, Name: "legend", TileWidth: TiledTileSize, TileHeight: TiledTileSize, TileCount: len(m.Legend)}
+	for i, def := range m.Legend {
+		if _, ok := regions[def.Value]; def.Type == "floor" && !ok {
+			regions[def.Value] = len(regions)
+		}
+		tileset.Tiles = append(tileset.Tiles, TiledTile{
+			ID:         i,
+			Properties: []TiledProperty{{"type", "", def.Type}, {"value", "", def.Value}},
+			Image:      images.Image(def, regions[def.Value]),
+		})
+	}
+	t.Tilesets = []TiledTileset{tileset}
+
+	var gids []int
+	for _, row := range m.Layout {
+		for _, i := range row {
+			gids = append(gids, i+tileset.FirstGID)
+		}
+	}
+	t.Layers = []TiledLayer{{1, "layout", t.Width, t.Height, NewTiledData(gids, t.Width)}}
+
+	group := TiledObjectGroup{ID: 2, Name: "entities"}
+	start := m.PlayerStart
+	start.Type = "PlayerStart"
+	for i, e := range append([]Entity{start}, m.Entities...) {
+		row := int(m.Height) - 1 - e.Position.Y
+		group.Objects = append(group.Objects, TiledObject{
+			ID:         i + 1,
+			Name:       e.Type,
+			Type:       e.Type,
+			X:          float64(e.Position.X * TiledTileSize),
+			Y:          float64(row * TiledTileSize),
+			Width:      TiledTileSize,
+			Height:     TiledTileSize,
+			Properties: entityProperties(e),
+		})
+	}
+	t.ObjectGroups = []TiledObjectGroup{group}
+	t.NextObjectID = len(group.Objects) + 1
+	return t, len(regions)
+}
+
+// FromTiled converts a map edited in Tiled back. Empty tiles become bare
+// floor, and objects are placed on the tile their center is over.
+func FromTiled(t *TiledMap) (*JsonMap, error) {
+	if len(t.Tilesets) != 1 || len(t.Layers) != 1 {
+		return nil, fmt.Errorf("map must have one tileset and one tile layer, not %v and %v", len(t.Tilesets), len(t.Layers))
+	}
+	tileset := t.Tilesets[0]
+	if tileset.Source != "" {
+		return nil, fmt.Errorf("tileset %v is external; embed it in the map", tileset.Source)
+	}
+	m := &JsonMap{Width: uint(t.Width), Height: uint(t.Height), Entities: []Entity{}}
+	for _, p := range t.Properties {
+		var err error
+		switch p.Name {
+		case "title":
+			m.Title = p.Value
+		case "levelNumber":
+			m.LevelNumber, err = p.Int()
+		case "fogColor", "fogNear", "fogFar":
+			if m.Fog == nil {
+				m.Fog = &Fog{}
+			}
+			var f float64
+			if p.Name == "fogColor" {
+				m.Fog.Color, err = parseTiledColor(p.Value)
+			} else if f, err = strconv.ParseFloat(p.Value, 32); p.Name == "fogNear" {
+				m.Fog.Near = float32(f)
+			} else {
+				m.Fog.Far = float32(f)
+			}
+		case "ambientLight":
+			var c uint32
+			c, err = parseTiledColor(p.Value)
+			m.AmbientLight = &c
+		case "music":
+			m.Music = p.Value
+		}
+		if err != nil {
+			return nil, fmt.Errorf("map property %v: %v", p.Name, err)
+		}
+	}
+
+	defs := make(map[int]LayoutDef)
+	for _, tile := range tileset.Tiles {
+		var def LayoutDef
+		for _, p := range tile.Properties {
+			switch p.Name {
+			case "type":
+				def.Type = p.Value
+			case "value":
+				def.Value = p.Value
+			}
+		}
+		defs[tile.ID+tileset.FirstGID] = def
+	}
+	defs[0] = Floor("")
+
+	gids, err := t.Layers[0].Data.GIDs()
+	if err != nil {
+		return nil, err
+	}
+	if len(gids) != t.Width*t.Height {
+		return nil, fmt.Errorf("tile layer has %v tiles, not %vx%v", len(gids), t.Width, t.Height)
+	}
+	legend := make(map[int]int)
+	for row := 0; row < t.Height; row++ {
+		line := make([]int, t.Width)
+		for col := range line {
+			gid := gids[row*t.Width+col]
+			def, ok := defs[gid]
+			if !ok || def.Type == "" {
+				return nil, fmt.Errorf("tile at row %v, column %v has no type property", row, col)
+			}
+			i, ok := legend[gid]
+			if !ok {
+				i = len(m.Legend)
+				legend[gid] = i
+				m.Legend = append(m.Legend, def)
+			}
+			line[col] = i
+		}
+		m.Layout = append(m.Layout, line)
+	}
+
+	for _, group := range t.ObjectGroups {
+		for _, o := range group.Objects {
+			e := Entity{Type: o.Type}
+			if e.Type == "" {
+				e.Type = o.Class
+			}
+			col := int(math.Floor((o.X + o.Width/2) / float64(t.TileWidth)))
+			row := int(math.Floor((o.Y + o.Height/2) / float64(t.TileHeight)))
+			e.Position = Vec2{col, t.Height - 1 - row}
+			for _, p := range o.Properties {
+				var err error
+				switch p.Name {
+				case "Value":
+					if p.Type == "int" || p.Type == "float" {
+						e.Value, err = p.Int()
+					} else if e.Type == "JumpGate" {
+						e.Value, err = parseTiledVec2(p.Value)
+					} else {
+						e.Value = p.Value
+					}
+				case "Direction":
+					var dir Vec2
+					dir, err = parseTiledVec2(p.Value)
+					e.Direction = &dir
+				case "MinDifficulty":
+					e.MinDifficulty, err = p.Int()
+				case "Text":
+					e.Text = p.Value
+				}
+				if err != nil {
+					return nil, fmt.Errorf("object %v (%v) property %v: %v", o.ID, e.Type, p.Name, err)
+				}
+			}
+			if e.Type == "PlayerStart" {
+				e.Type = ""
+				m.PlayerStart = e
+			} else {
+				m.Entities = append(m.Entities, e)
+			}
+		}
+	}
+	m.Regions = Segment(m)
+	return m, nil
+}
+
+func WriteTiled(filename string, t *TiledMap) error {
+	var out []byte
+	var err error
+	if strings.EqualFold(filepath.Ext(filename), ".tmj") {
+		var tmj *tmjMap
+		if tmj, err = t.toTMJ(); err == nil {
+			out, err = json.MarshalIndent(tmj, "", " ")
+		}
+	} else {
+		if out, err = xml.MarshalIndent(t, "", " "); err == nil {
+			out = append([]byte(xml.Header), out...)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, append(out, '\n'), 0666)
+}
+
+func ReadTiled(filename string) (*TiledMap, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(filepath.Ext(filename), ".tmj") || strings.EqualFold(filepath.Ext(filename), ".json") {
+		var tmj tmjMap
+		if err := json.Unmarshal(data, &tmj); err != nil {
+			return nil, fmt.Errorf("%v: %v", filename, err)
+		}
+		return tmj.toTMX(), nil
+	}
+	var t TiledMap
+	if err := xml.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("%v: %v", filename, err)
+	}
+	return &t, nil
+}
+
+func TiledExportCommand(args []string) {
+	flags := flag.NewFlagSet("tiled-export", flag.ExitOnError)
+	output := flags.String("o", "", "output .tmx or .tmj file (default <name>.tmx)")
+	walls := flags.String("walls", "build/walls", "directory of wall textures")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json tiled-export [flags] map")
+		fmt.Fprintln(flags.Output(), "Floor tile images are written to a floors directory next to the output.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	m, err := loader.Load(flags.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+	if *output == "" {
+		*output = MapName(m.LevelNumber, m.Title) + ".tmx"
+	}
+	dir := filepath.Dir(*output)
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		panic(err)
+	}
+	absWalls, err := filepath.Abs(*walls)
+	if err != nil {
+		panic(err)
+	}
+	wallsDir, err := filepath.Rel(absDir, absWalls)
+	if err != nil {
+		panic(err)
+	}
+	t, regions := ToTiled(m, TiledImages{Walls: wallsDir, Floors: "floors"})
+	if err := WriteFloorSwatches(filepath.Join(dir, "floors"), regions); err != nil {
+		panic(err)
+	}
+	if err := WriteTiled(*output, t); err != nil {
+		panic(err)
+	}
+}
+
+func TiledImportCommand(args []string) {
+	flags := flag.NewFlagSet("tiled-import", flag.ExitOnError)
+	output := flags.String("o", "", "output map JSON file (default standard output)")
+	v1 := flags.Bool("v1", false, "write the version 1 format, which is limited to 52 kinds of tile")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json tiled-import [flags] map.tmx")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	t, err := ReadTiled(flags.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+	m, err := FromTiled(t)
+	if err != nil {
+		panic(fmt.Errorf("%v: %v", flags.Arg(0), err))
+	}
+	if *v1 {
+		m.Version = 1
+	}
+	if err := CheckMap(m); err != nil {
+		panic(fmt.Errorf("%v: %v", flags.Arg(0), err))
+	}
+	if *output != "" {
+		err = writeJSONFile(*output, m)
+	} else {
+		var out []byte
+		if out, err = MarshalIndent(m); err == nil {
+			fmt.Println(string(out))
+		}
+	}
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,408 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	Commands["transform"] = TransformCommand
+}
+
+// Transform moves every tile of a level somewhere new. Map gives where a
+// position goes, or false if it is cut off, and Dir turns a direction the
+// same way. Positions count Y up from the last row like Entity.Position.
+type Transform struct {
+	Width, Height uint // of the level afterwards
+	Map           func(p Vec2) (Vec2, bool)
+	Dir           func(d Vec2) Vec2
+}
+
+func sameDir(d Vec2) Vec2 {
+	return d
+}
+
+// Crop keeps the width by height tiles with min at their bottom left.
+func Crop(width, height uint, min Vec2, w, h uint) (Transform, error) {
+	if min.X < 0 || min.Y < 0 || w < 3 || h < 3 || uint(min.X)+w > width || uint(min.Y)+h > height {
+		return Transform{}, fmt.Errorf("can't crop %vx%v from %v of a %vx%v map", w, h, min, width, height)
+	}
+	return Transform{w, h, func(p Vec2) (Vec2, bool) {
+		q := Vec2{p.X - min.X, p.Y - min.Y}
+		return q, q.X >= 0 && q.Y >= 0 && q.X < int(w) && q.Y < int(h)
+	}, sameDir}, nil
+}
+
+// Pad adds rows and columns of wall around the edges.
+func Pad(width, height uint, top, right, bottom, left uint) Transform {
+	return Transform{width + left + right, height + top + bottom, func(p Vec2) (Vec2, bool) {
+		return Vec2{p.X + int(left), p.Y + int(bottom)}, true
+	}, sameDir}
+}
+
+// Rotate turns a level clockwise by 90, 180 or 270 degrees.
+func Rotate(width, height uint, degrees int) (Transform, error) {
+	w, h := int(width), int(height)
+	switch (degrees%360 + 360) % 360 {
+	case 0:
+		return Transform{width, height, func(p Vec2) (Vec2, bool) { return p, true }, sameDir}, nil
+	case 90:
+		return Transform{height, width, func(p Vec2) (Vec2, bool) {
+			return Vec2{p.Y, w - 1 - p.X}, true
+		}, func(d Vec2) Vec2 { return Vec2{d.Y, -d.X} }}, nil
+	case 180:
+		return Transform{width, height, func(p Vec2) (Vec2, bool) {
+			return Vec2{w - 1 - p.X, h - 1 - p.Y}, true
+		}, func(d Vec2) Vec2 { return Vec2{-d.X, -d.Y} }}, nil
+	case 270:
+		return Transform{height, width, func(p Vec2) (Vec2, bool) {
+			return Vec2{h - 1 - p.Y, p.X}, true
+		}, func(d Vec2) Vec2 { return Vec2{-d.Y, d.X} }}, nil
+	}
+	return Transform{}, fmt.Errorf("can only rotate by a multiple of 90 degrees, not %v", degrees)
+}
+
+// Mirror flips a level left to right across axis "x", or top to bottom
+// across "y".
+func Mirror(width, height uint, axis string) (Transform, error) {
+	w, h := int(width), int(height)
+	switch axis {
+	case "x":
+		return Transform{width, height, func(p Vec2) (Vec2, bool) {
+			return Vec2{w - 1 - p.X, p.Y}, true
+		}, func(d Vec2) Vec2 { return Vec2{-d.X, d.Y} }}, nil
+	case "y":
+		return Transform{width, height, func(p Vec2) (Vec2, bool) {
+			return Vec2{p.X, h - 1 - p.Y}, true
+		}, func(d Vec2) Vec2 { return Vec2{d.X, -d.Y} }}, nil
+	}
+	return Transform{}, fmt.Errorf("can only mirror across x or y, not %q", axis)
+}
+
+// turnDir turns an entity's direction. Fireballs only go back and forth
+// along an axis, so theirs stays pointing north or east.
+func (t Transform) turnDir(entityType string, d Vec2) Vec2 {
+	d = t.Dir(d)
+	if entityType == "Fireball" {
+		d = Vec2{int(abs(float64(d.X))), int(abs(float64(d.Y)))}
+	}
+	return d
+}
+
+// turnByte turns the direction of an entity byte, if it has one.
+func (t Transform) turnByte(b byte) byte {
+	for _, group := range c3dDirections {
+		for _, from := range group {
+			if b != from {
+				continue
+			}
+			entity := EntityDict[b]
+			d := t.turnDir(entity.Type, *entity.Direction)
+			for _, to := range group {
+				if *EntityDict[to].Direction == d {
+					return to
+				}
+			}
+		}
+	}
+	return b
+}
+
+func onBorder(p Vec2, width, height uint) bool {
+	return p.X == 0 || p.Y == 0 || p.X == int(width)-1 || p.Y == int(height)-1
+}
+
+// Transform moves a level's tiles and entities, turning directions and
+// following jump gates to where their partners went. Tiles left on the edge
+// that could be walked or blown through become the wall most used around
+// the edge before, as do padded tiles, and entities on them are dropped
+// along with any cropped off.
+func (m *JsonMap) Transform(t Transform) error {
+	counts := make(map[string]int)
+	for y := 0; y < int(m.Height); y++ {
+		for x := 0; x < int(m.Width); x++ {
+			if def, _ := m.Tile(Vec2{x, y}); onBorder(Vec2{x, y}, m.Width, m.Height) && def.Type == "wall" {
+				counts[def.Value]++
+			}
+		}
+	}
+	seal := Wall(sealWall(counts))
+	defaultFog := m.Fog != nil && *m.Fog == *DefaultFog(m)
+
+	// build the new layout from legend entries
+	layout := make([][]LayoutDef, t.Height)
+	for row := range layout {
+		layout[row] = make([]LayoutDef, t.Width)
+		for x := range layout[row] {
+			layout[row][x] = seal
+		}
+	}
+	for y := 0; y < int(m.Height); y++ {
+		for x := 0; x < int(m.Width); x++ {
+			if q, ok := t.Map(Vec2{x, y}); ok {
+				layout[int(t.Height)-1-q.Y][q.X], _ = m.Tile(Vec2{x, y})
+			}
+		}
+	}
+	sealed := make(map[Vec2]bool)
+	for row := range layout {
+		for x := range layout[row] {
+			p := Vec2{x, int(t.Height) - 1 - row}
+			if onBorder(p, t.Width, t.Height) && layout[row][x].Type != "wall" {
+				layout[row][x] = seal
+				sealed[p] = true
+			}
+		}
+	}
+	moveTo := func(p Vec2) (Vec2, bool) {
+		q, ok := t.Map(p)
+		return q, ok && !sealed[q]
+	}
+
+	start := m.PlayerStart
+	var ok bool
+	if start.Position, ok = moveTo(start.Position); !ok {
+		return fmt.Errorf("the player would start outside the map")
+	}
+	if start.Direction != nil {
+		d := t.turnDir("PlayerStart", *start.Direction)
+		start.Direction = &d
+	}
+	entities := []Entity{}
+	for _, e := range m.Entities {
+		from := e.Position
+		if e.Position, ok = moveTo(e.Position); !ok {
+			continue
+		}
+		if e.Direction != nil {
+			d := t.turnDir(e.Type, *e.Direction)
+			e.Direction = &d
+		}
+		if dest, isVec := ValueVec2(e.Value); isVec && e.Type == "JumpGate" {
+			if e.Value, ok = moveTo(dest); !ok {
+				return fmt.Errorf("the jump gate at %v would lead outside the map", from)
+			}
+		}
+		entities = append(entities, e)
+	}
+
+	m.SetLayout(layout)
+	m.PlayerStart = start
+	m.Entities = entities
+	if defaultFog {
+		// the default depends on the size, so keep to it unless it was set
+		m.Fog = DefaultFog(m)
+	}
+	m.Regions = Segment(m)
+	return nil
+}
+
+// sealWall returns the wall counted most, or the first of them in
+// wallNames, which is the order of their bytes, so both formats pick the
+// same one.
+func sealWall(counts map[string]int) string {
+	seal := wallNames[0]
+	for _, name := range wallNames {
+		if counts[name] > counts[seal] {
+			seal = name
+		}
+	}
+	return seal
+}
+
+// c3dDirections are the entity bytes that differ only by direction.
+var c3dDirections = [][]byte{{0x01, 0x02, 0x03, 0x04}, {0x1D, 0x1E}}
+
+// Transform is like JsonMap's, for the original format, where jump gates
+// are paired by their byte rather than by position. A warp gate's
+// destination is in the layout under it, so moves with it.
+func (m C3DMap) Transform(t Transform) (C3DMap, error) {
+	isWall := func(b byte) bool { return b >= 0x01 && b <= 0x07 }
+	index := func(p Vec2, width, height uint) int {
+		return p.X + (int(height)-1-p.Y)*int(width)
+	}
+	counts := make(map[string]int)
+	for y := 0; y < int(m.Height); y++ {
+		for x := 0; x < int(m.Width); x++ {
+			if b := m.Layout[index(Vec2{x, y}, m.Width, m.Height)]; onBorder(Vec2{x, y}, m.Width, m.Height) && isWall(b) {
+				counts[LayoutDict[b].Value]++
+			}
+		}
+	}
+	seal := byte(0x01)
+	for b := byte(0x01); isWall(b); b++ {
+		if LayoutDict[b] == Wall(sealWall(counts)) {
+			seal = b
+		}
+	}
+
+	out := C3DMap{t.Width, t.Height, make([]byte, t.Width*t.Height), make([]byte, t.Width*t.Height)}
+	for i := range out.Layout {
+		out.Layout[i] = seal
+	}
+	for y := 0; y < int(m.Height); y++ {
+		for x := 0; x < int(m.Width); x++ {
+			q, ok := t.Map(Vec2{x, y})
+			if !ok {
+				continue
+			}
+			i, j := index(Vec2{x, y}, m.Width, m.Height), index(q, t.Width, t.Height)
+			out.Layout[j], out.Entities[j] = m.Layout[i], m.Entities[i]
+			out.Entities[j] = t.turnByte(out.Entities[j])
+		}
+	}
+	for y := 0; y < int(t.Height); y++ {
+		for x := 0; x < int(t.Width); x++ {
+			if i := index(Vec2{x, y}, t.Width, t.Height); onBorder(Vec2{x, y}, t.Width, t.Height) && !isWall(out.Layout[i]) {
+				out.Layout[i], out.Entities[i] = seal, 0
+			}
+		}
+	}
+
+	starts := 0
+	gates := make(map[byte]int)
+	for _, b := range out.Entities {
+		if EntityDict[b].Type == "PlayerStart" {
+			starts++
+		} else if EntityDict[b].Type == "JumpGate" {
+			gates[b]++
+		}
+	}
+	if starts == 0 {
+		return C3DMap{}, fmt.Errorf("the player would start outside the map")
+	}
+	for b, n := range gates {
+		if n == 1 {
+			return C3DMap{}, fmt.Errorf("jump gate %v would lead outside the map", EntityDict[b].Value)
+		}
+	}
+	return out, nil
+}
+
+// parseInts parses n comma-separated numbers.
+func parseInts(s string, n int) ([]int, error) {
+	fields := strings.Split(s, ",")
+	if len(fields) != n {
+		return nil, fmt.Errorf("%q should be %v numbers separated by commas", s, n)
+	}
+	numbers := make([]int, n)
+	for i, f := range fields {
+		number, err := strconv.Atoi(strings.TrimSpace(f))
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("%q should be %v numbers separated by commas", s, n)
+		}
+		numbers[i] = number
+	}
+	return numbers, nil
+}
+
+func TransformCommand(args []string) {
+	flags := flag.NewFlagSet("transform", flag.ExitOnError)
+	output := flags.String("o", "", "output file, .c3dmap if the input is, otherwise map JSON (default standard output for JSON)")
+	crop := flags.String("crop", "", "x,y,width,height of the part to keep, with x,y its bottom left tile")
+	pad := flags.String("pad", "", "top,right,bottom,left rows and columns of wall to add")
+	rotate := flags.Int("rotate", 0, "degrees to turn clockwise: 90, 180 or 270")
+	mirror := flags.String("mirror", "", "flip across x (left to right) or y (top to bottom)")
+	v1 := flags.Bool("v1", false, "write the version 1 format, which is limited to 52 kinds of tile")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json transform [flags] map")
+		fmt.Fprintln(flags.Output(), "Crops, then pads, then rotates, then mirrors.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	filename := flags.Arg(0)
+	isC3D := func(name string) bool { return strings.EqualFold(filepath.Ext(name), ".c3dmap") }
+	if isC3D(*output) && !isC3D(filename) {
+		panic(fmt.Errorf("can only write a .c3dmap from a .c3dmap"))
+	}
+
+	// each step is made for the size the previous one leaves
+	steps := []func(width, height uint) (Transform, error){}
+	if *crop != "" {
+		n, err := parseInts(*crop, 4)
+		if err != nil {
+			panic(err)
+		}
+		steps = append(steps, func(width, height uint) (Transform, error) {
+			return Crop(width, height, Vec2{n[0], n[1]}, uint(n[2]), uint(n[3]))
+		})
+	}
+	if *pad != "" {
+		n, err := parseInts(*pad, 4)
+		if err != nil {
+			panic(err)
+		}
+		steps = append(steps, func(width, height uint) (Transform, error) {
+			return Pad(width, height, uint(n[0]), uint(n[1]), uint(n[2]), uint(n[3])), nil
+		})
+	}
+	if *rotate != 0 {
+		steps = append(steps, func(width, height uint) (Transform, error) {
+			return Rotate(width, height, *rotate)
+		})
+	}
+	if *mirror != "" {
+		steps = append(steps, func(width, height uint) (Transform, error) {
+			return Mirror(width, height, *mirror)
+		})
+	}
+
+	if isC3D(filename) && isC3D(*output) {
+		m := ReadC3DMap(filename)
+		for _, step := range steps {
+			t, err := step(m.Width, m.Height)
+			if err == nil {
+				m, err = m.Transform(t)
+			}
+			if err != nil {
+				panic(fmt.Errorf("%v: %v", filename, err))
+			}
+		}
+		if err := ioutil.WriteFile(*output, m.Bytes(), 0666); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	loader := MapLoader{Assets: *assets}
+	m, err := loader.Load(filename)
+	if err != nil {
+		panic(err)
+	}
+	for _, step := range steps {
+		t, err := step(m.Width, m.Height)
+		if err == nil {
+			err = m.Transform(t)
+		}
+		if err != nil {
+			panic(fmt.Errorf("%v: %v", filename, err))
+		}
+	}
+	if *v1 {
+		m.Version = 1
+	}
+	if err := CheckMap(m); err != nil {
+		panic(fmt.Errorf("%v: %v", filename, err))
+	}
+	if *output != "" {
+		err = writeJSONFile(*output, m)
+	} else {
+		var out []byte
+		if out, err = MarshalIndent(m); err == nil {
+			fmt.Println(string(out))
+		}
+	}
+	if err != nil {
+		panic(err)
+	}
+}
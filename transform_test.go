@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+type transformStep func(width, height uint) (Transform, error)
+
+// transformSteps are transforms that together leave a level as it was.
+var transformSteps = []struct {
+	name  string
+	steps []transformStep
+}{
+	{"rotate 90 four times", []transformStep{rotateBy(90), rotateBy(90), rotateBy(90), rotateBy(90)}},
+	{"rotate 90 then 270", []transformStep{rotateBy(90), rotateBy(270)}},
+	{"rotate 180 twice", []transformStep{rotateBy(180), rotateBy(180)}},
+	{"rotate -90 then 90", []transformStep{rotateBy(-90), rotateBy(90)}},
+	{"mirror x twice", []transformStep{mirrorAcross("x"), mirrorAcross("x")}},
+	{"mirror y twice", []transformStep{mirrorAcross("y"), mirrorAcross("y")}},
+	{"mirror x and y, rotate 180", []transformStep{mirrorAcross("x"), mirrorAcross("y"), rotateBy(180)}},
+	{"rotate 90, mirror x, rotate 90, mirror x", []transformStep{rotateBy(90), mirrorAcross("x"), rotateBy(90), mirrorAcross("x")}},
+}
+
+func rotateBy(degrees int) transformStep {
+	return func(width, height uint) (Transform, error) { return Rotate(width, height, degrees) }
+}
+
+func mirrorAcross(axis string) transformStep {
+	return func(width, height uint) (Transform, error) { return Mirror(width, height, axis) }
+}
+
+func TestTransformRoundTrip(t *testing.T) {
+	loader := MapLoader{Assets: "extracted_assets"}
+	for _, filename := range []string{"build/maps/Approach.map.json", "build/maps/Chaos_Corridors.map.json"} {
+		for _, test := range transformSteps {
+			before, err := loader.Load(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			after, err := loader.Load(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, step := range test.steps {
+				transform, err := step(after.Width, after.Height)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := after.Transform(transform); err != nil {
+					t.Fatalf("%v: %v: %v", filename, test.name, err)
+				}
+			}
+			if d := DiffMaps(before, after); !d.Empty() {
+				var text bytes.Buffer
+				d.WriteText(&text)
+				t.Errorf("%v: %v changed the level:\n%v", filename, test.name, text.String())
+			}
+		}
+	}
+}
+
+func TestTransformC3DMapRoundTrip(t *testing.T) {
+	before := ReadC3DMap("extracted_assets/maps/17_Chaos_Corridors.c3dmap")
+	for _, test := range transformSteps {
+		after := before
+		for _, step := range test.steps {
+			transform, err := step(after.Width, after.Height)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if after, err = after.Transform(transform); err != nil {
+				t.Fatalf("%v: %v", test.name, err)
+			}
+		}
+		if !bytes.Equal(before.Bytes(), after.Bytes()) {
+			t.Errorf("%v changed the level", test.name)
+		}
+	}
+}
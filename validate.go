@@ -0,0 +1,225 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+)
+
+//go:embed map.schema.json
+var mapSchema []byte
+
+func init() {
+	Commands["validate"] = ValidateCommand
+}
+
+// ValidateMap checks map JSON against the schema, then checks what the
+// schema cannot express: that the layout matches the map size, that every
+// tile has a legend entry, and that positions are on the map. A schema given
+// with -schema may allow maps these checks can't make sense of, so they
+// report what they need that is missing or the wrong type.
+func ValidateMap(schema *Schema, data []byte) ([]ValidationError, error) {
+	errs, err := schema.Validate(data)
+	if err != nil || len(errs) > 0 {
+		return errs, err
+	}
+	doc, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	fail := func(path, format string, args ...interface{}) {
+		errs = append(errs, ValidationError{path, fmt.Sprintf(format, args...)})
+	}
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		fail("", "should be an object")
+		return errs, nil
+	}
+	width, wok := number(m["width"])
+	height, hok := number(m["height"])
+	if !wok || !hok {
+		fail("", "should have a numeric width and height")
+		return errs, nil
+	}
+
+	layout, ok := m["layout"].([]interface{})
+	if !ok {
+		fail("/layout", "should be an array of rows")
+		layout = nil
+	} else if len(layout) != int(height) {
+		fail("/layout", "has %v rows but height is %v", len(layout), height)
+	}
+	for h, row := range layout {
+		path := pointer("/layout", h)
+		var length int
+		switch row := row.(type) {
+		case string:
+			legend, ok := m["legend"].(map[string]interface{})
+			if !ok {
+				fail("/legend", "should be an object, as %v is a string", path)
+				return errs, nil
+			}
+			for w, symbol := range []rune(row) {
+				if _, ok := legend[string(symbol)]; !ok && symbol != ' ' {
+					fail(path, "column %v uses %q, which is not in the legend", w, symbol)
+				}
+			}
+			length = len([]rune(row))
+		case []interface{}:
+			legend, ok := m["legend"].([]interface{})
+			if !ok {
+				fail("/legend", "should be an array, as %v is an array", path)
+				return errs, nil
+			}
+			for w, kind := range row {
+				if k, ok := number(kind); !ok || k < 0 || k != math.Trunc(k) || int(k) >= len(legend) {
+					fail(pointer(path, w), "legend has no entry %v", kind)
+				}
+			}
+			length = len(row)
+		default:
+			fail(path, "should be a string or an array")
+			continue
+		}
+		if length != int(width) {
+			fail(path, "has %v columns but width is %v", length, width)
+		}
+	}
+
+	onMap := func(path string, v interface{}) (Vec2, bool) {
+		pos, ok := v.(map[string]interface{})
+		if !ok {
+			fail(path, "should be a position")
+			return Vec2{}, false
+		}
+		x, xok := number(pos["x"])
+		y, yok := number(pos["y"])
+		if !xok || !yok {
+			fail(path, "should have a numeric x and y")
+			return Vec2{}, false
+		}
+		if x < 0 || y < 0 || x >= width || y >= height {
+			fail(path, "(%v, %v) is outside the %vx%v map", x, y, width, height)
+			return Vec2{}, false
+		}
+		return Vec2{int(x), int(y)}, true
+	}
+	if start, ok := m["playerStart"].(map[string]interface{}); ok {
+		onMap("/playerStart/position", start["position"])
+	} else {
+		fail("/playerStart", "should be an object")
+	}
+	entities, ok := m["entities"].([]interface{})
+	if !ok {
+		fail("/entities", "should be an array")
+		return errs, nil
+	}
+	gates := make(map[Vec2]Vec2)
+	positions := make(map[int]Vec2)
+	for i, e := range entities {
+		path := pointer("/entities", i)
+		entity, ok := e.(map[string]interface{})
+		if !ok {
+			fail(path, "should be an object")
+			continue
+		}
+		pos, ok := onMap(path+"/position", entity["position"])
+		if ok {
+			positions[i] = pos
+		}
+		if ok && entity["type"] == "JumpGate" {
+			if dest, ok := onMap(path+"/value", entity["value"]); ok {
+				gates[pos] = dest
+			}
+		}
+	}
+	for i, e := range entities {
+		if entity, ok := e.(map[string]interface{}); !ok || entity["type"] != "JumpGate" {
+			continue
+		}
+		pos, placed := positions[i]
+		if dest, ok := gates[pos]; placed && ok {
+			if back, ok := gates[dest]; !ok {
+				fail(pointer("/entities", i)+"/value", "there is no jump gate at %v", dest)
+			} else if back != pos {
+				fail(pointer("/entities", i)+"/value", "the jump gate at %v leads to %v instead of back here", dest, back)
+			}
+		}
+	}
+	return errs, nil
+}
+
+// CheckMap validates a map built by one of the other commands against the
+// built-in schema, so they never write a map the game can't load.
+func CheckMap(m *JsonMap) error {
+	schema, err := ParseSchema(mapSchema)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	errs, err := ValidateMap(schema, data)
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func ValidateCommand(args []string) {
+	flags := flag.NewFlagSet("validate", flag.ExitOnError)
+	schemaFile := flags.String("schema", "", "validate against this schema instead of the built-in map.schema.json")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json validate [flags] map.json...")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	data := mapSchema
+	if *schemaFile != "" {
+		var err error
+		if data, err = ioutil.ReadFile(*schemaFile); err != nil {
+			panic(err)
+		}
+	}
+	schema, err := ParseSchema(data)
+	if err != nil {
+		panic(fmt.Errorf("schema: %v", err))
+	}
+
+	invalid := 0
+	for _, name := range flags.Args() {
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			panic(err)
+		}
+		errs, err := ValidateMap(schema, data)
+		if syntax, ok := err.(*json.SyntaxError); ok {
+			fmt.Printf("%v:%v: %v\n", name, lineOf(data, syntax.Offset), err)
+			invalid++
+			continue
+		} else if err != nil {
+			fmt.Printf("%v: %v\n", name, err)
+			invalid++
+			continue
+		}
+		for _, err := range errs {
+			fmt.Printf("%v: %v\n", name, err)
+		}
+		if len(errs) > 0 {
+			invalid++
+		}
+	}
+	if invalid > 0 {
+		fmt.Fprintf(os.Stderr, "%v of %v maps are invalid\n", invalid, flags.NArg())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"testing"
+)
+
+// TestValidateMapLooseSchema checks maps a schema lets through but the
+// other checks can't make sense of are reported rather than panicking.
+func TestValidateMapLooseSchema(t *testing.T) {
+	schema, err := ParseSchema([]byte(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name, doc string
+	}{
+		{"not an object", `[]`},
+		{"no size", `{}`},
+		{"no layout", `{"width": 1, "height": 1, "legend": [], "playerStart": {"position": {"x": 0, "y": 0}}, "entities": []}`},
+		{"string rows, array legend", `{"width": 1, "height": 1, "layout": ["a"], "legend": [], "playerStart": {"position": {"x": 0, "y": 0}}, "entities": []}`},
+		{"array rows, object legend", `{"width": 1, "height": 1, "layout": [[0]], "legend": {}, "playerStart": {"position": {"x": 0, "y": 0}}, "entities": []}`},
+		{"row a number", `{"width": 1, "height": 1, "layout": [0], "legend": [], "playerStart": {"position": {"x": 0, "y": 0}}, "entities": []}`},
+		{"no player start", `{"width": 1, "height": 1, "layout": [[0]], "legend": [{}], "entities": []}`},
+		{"player start not a position", `{"width": 1, "height": 1, "layout": [[0]], "legend": [{}], "playerStart": {"position": 3}, "entities": []}`},
+		{"no entities", `{"width": 1, "height": 1, "layout": [[0]], "legend": [{}], "playerStart": {"position": {"x": 0, "y": 0}}}`},
+		{"entity not an object", `{"width": 1, "height": 1, "layout": [[0]], "legend": [{}], "playerStart": {"position": {"x": 0, "y": 0}}, "entities": [1]}`},
+		{"jump gate without a position", `{"width": 1, "height": 1, "layout": [[0]], "legend": [{}], "playerStart": {"position": {"x": 0, "y": 0}}, "entities": [{"type": "JumpGate", "value": {"x": 0, "y": 0}}]}`},
+	} {
+		errs, err := ValidateMap(schema, []byte(test.doc))
+		if err != nil {
+			t.Errorf("%v: %v", test.name, err)
+		} else if len(errs) == 0 {
+			t.Errorf("%v: no errors", test.name)
+		}
+	}
+}
+
+// TestValidateMapLegendIndex checks version 2 layouts only use whole legend
+// indices that are in range, whether or not the schema checks them too.
+func TestValidateMapLegendIndex(t *testing.T) {
+	loose, err := ParseSchema([]byte(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	strict, err := ParseSchema(mapSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name, kind string
+		valid      bool
+	}{
+		{"first", "0", true},
+		{"last", "1", true},
+		{"past the end", "2", false},
+		{"negative", "-1", false},
+		{"fractional", "0.5", false},
+		{"not a number", `"a"`, false},
+	} {
+		doc := `{"version": 2, "title": "Test", "levelNumber": 1, "width": 1, "height": 1, "layout": [[` + test.kind + `]],
+			"legend": [{"type": "floor", "value": ""}, {"type": "floor", "value": "Hall"}],
+			"playerStart": {"position": {"x": 0, "y": 0}, "direction": {"x": 0, "y": 1}}, "entities": []}`
+		for _, schema := range []*Schema{loose, strict} {
+			errs, err := ValidateMap(schema, []byte(doc))
+			if err != nil {
+				t.Fatalf("%v: %v", test.name, err)
+			}
+			if valid := len(errs) == 0; valid != test.valid {
+				t.Errorf("%v: valid = %v, want %v: %v", test.name, valid, test.valid, errs)
+			}
+		}
+	}
+}
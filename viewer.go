@@ -0,0 +1,335 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"image/color"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+func init() {
+	Commands["view"] = ViewCommand
+}
+
+// EntityGlyphs are the characters the viewer shows entities as.
+var EntityGlyphs = map[string]rune{
+	"PlayerStart": '@',
+	"Player":      '@',
+	"Bolt":        '/',
+	"Nuke":        '*',
+	"Potion":      '!',
+	"RedKey":      'k',
+	"YellowKey":   'k',
+	"GreenKey":    'k',
+	"BlueKey":     'k',
+	"Scroll":      '?',
+	"Treasure":    '$',
+	"WarpGate":    'W',
+	"JumpGate":    'J',
+	"Fireball":    'f',
+	"Grelminar":   'G',
+	"Nemesis":     'N',
+	"Troll":       'T',
+	"Orc":         'O',
+	"Bat":         'B',
+	"Demon":       'D',
+	"Mage":        'M',
+}
+
+// Viewer shows a level in a terminal with a cursor that can be moved around
+// it. Cursor is in the file's row order, like Layout.
+type Viewer struct {
+	Map        *JsonMap
+	Difficulty int
+	CursorCol  int
+	CursorRow  int
+	Rows, Cols int // terminal size
+	top, left  int
+}
+
+func fg(c color.RGBA) string {
+	return fmt.Sprintf("\x1b[38;2;%v;%v;%vm", c.R, c.G, c.B)
+}
+
+func bg(c color.RGBA) string {
+	return fmt.Sprintf("\x1b[48;2;%v;%v;%vm", c.R, c.G, c.B)
+}
+
+// EntitiesAt returns the entities on a tile that appear at the viewer's
+// difficulty, with the player start first.
+func (v *Viewer) EntitiesAt(row, col int) []Entity {
+	m := v.Map
+	pos := Vec2{col, int(m.Height) - 1 - row}
+	var entities []Entity
+	if m.PlayerStart.Position == pos {
+		start := m.PlayerStart
+		start.Type = "PlayerStart"
+		entities = append(entities, start)
+	}
+	for _, e := range m.Entities {
+		if e.Position == pos && e.MinDifficulty <= v.Difficulty {
+			entities = append(entities, e)
+		}
+	}
+	return entities
+}
+
+func (v *Viewer) cell(row, col int, regions map[string]int) string {
+	def := v.Map.Legend[v.Map.Layout[row][col]]
+	var back color.RGBA
+	glyph := " "
+	switch def.Type {
+	case "wall":
+		back, glyph = WallColors[def.Value], "#"
+	case "exploding_wall":
+		back, glyph = WallColors[def.Value], "%"
+	case "door":
+		back, glyph = DoorColors[def.Value], "+"
+	default:
+		back = RegionColor(regions[def.Value])
+	}
+	front := color.RGBA{0, 0, 0, 0xFF}
+	if entities := v.EntitiesAt(row, col); len(entities) > 0 {
+		e := entities[0]
+		glyph = string(EntityGlyphs[e.Type])
+		if icon, ok := EntityIcons[e.Type]; ok {
+			front = icon.Color
+		}
+		if IsMonster(e.Type) {
+			front = DifficultyColors[e.MinDifficulty%len(DifficultyColors)]
+		}
+		if e.Direction != nil && e.Type == "PlayerStart" {
+			glyph = map[Vec2]string{{0, 1}: "^", {1, 0}: ">", {0, -1}: "v", {-1, 0}: "<"}[*e.Direction]
+		}
+	}
+	if row == v.CursorRow && col == v.CursorCol {
+		return "\x1b[7m" + bg(back) + fg(front) + "[" + glyph + "\x1b[0m"
+	}
+	return bg(back) + fg(front) + glyph + " "
+}
+
+// Status describes the tile under the cursor.
+func (v *Viewer) Status() []string {
+	m := v.Map
+	def := m.Legend[m.Layout[v.CursorRow][v.CursorCol]]
+	lines := []string{
+		fmt.Sprintf("%v: %v  difficulty %v", m.LevelNumber, m.Title, v.Difficulty),
+		fmt.Sprintf("row %v, column %v  position %v  %v", v.CursorRow, v.CursorCol, Vec2{v.CursorCol, int(m.Height) - 1 - v.CursorRow}, TileTitle(def)),
+	}
+	for _, e := range v.EntitiesAt(v.CursorRow, v.CursorCol) {
+		lines = append(lines, EntityTitle(e))
+	}
+	return lines
+}
+
+// Legend lists what the glyphs and floor colors on screen mean.
+func (v *Viewer) Legend(regions map[string]int) []string {
+	var descs []string
+	for desc := range regions {
+		if desc != "" {
+			descs = append(descs, desc)
+		}
+	}
+	sort.Slice(descs, func(i, j int) bool { return regions[descs[i]] < regions[descs[j]] })
+	line, width := "", 0
+	var lines []string
+	for _, desc := range descs {
+		if width > 0 && width+len(desc)+5 > v.Cols {
+			lines = append(lines, line)
+			line, width = "", 0
+		}
+		line += bg(RegionColor(regions[desc])) + "  \x1b[0m " + desc + "  "
+		width += len(desc) + 5
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return append(lines, "@ start  k key  $ treasure  ! potion  / bolt  * nuke  ? scroll  W warp  J jump  # wall  % exploding  + door  TOBDM monsters")
+}
+
+func (v *Viewer) Draw() string {
+	m := v.Map
+	regions := map[string]int{"": 0}
+	for _, def := range m.Legend {
+		if _, ok := regions[def.Value]; def.Type == "floor" && !ok {
+			regions[def.Value] = len(regions)
+		}
+	}
+	status := v.Status()
+	legend := v.Legend(regions)
+
+	// scroll to keep the cursor in view
+	viewRows := v.Rows - len(status) - len(legend) - 1
+	viewCols := v.Cols / 2
+	if viewRows < 1 {
+		viewRows = 1
+	}
+	if v.CursorRow < v.top {
+		v.top = v.CursorRow
+	} else if v.CursorRow >= v.top+viewRows {
+		v.top = v.CursorRow - viewRows + 1
+	}
+	if v.CursorCol < v.left {
+		v.left = v.CursorCol
+	} else if v.CursorCol >= v.left+viewCols {
+		v.left = v.CursorCol - viewCols + 1
+	}
+
+	var out bytes.Buffer
+	out.WriteString("\x1b[H\x1b[2J")
+	for row := v.top; row < int(m.Height) && row < v.top+viewRows; row++ {
+		for col := v.left; col < int(m.Width) && col < v.left+viewCols; col++ {
+			out.WriteString(v.cell(row, col, regions))
+		}
+		out.WriteString("\x1b[0m\r\n")
+	}
+	for _, line := range append(status, legend...) {
+		out.WriteString(line + "\x1b[0m\r\n")
+	}
+	return out.String()
+}
+
+// Key moves the cursor or changes the difficulty, and reports false when
+// the viewer should close.
+func (v *Viewer) Key(key string) bool {
+	switch key {
+	case "\x1b[A", "k", "w":
+		v.CursorRow--
+	case "\x1b[B", "j", "s":
+		v.CursorRow++
+	case "\x1b[C", "l", "d":
+		v.CursorCol++
+	case "\x1b[D", "h", "a":
+		v.CursorCol--
+	case "0", "1", "2":
+		v.Difficulty = int(key[0] - '0')
+	case "q", "\x1b", "\x03":
+		return false
+	}
+	if v.CursorRow < 0 {
+		v.CursorRow = 0
+	} else if v.CursorRow >= int(v.Map.Height) {
+		v.CursorRow = int(v.Map.Height) - 1
+	}
+	if v.CursorCol < 0 {
+		v.CursorCol = 0
+	} else if v.CursorCol >= int(v.Map.Width) {
+		v.CursorCol = int(v.Map.Width) - 1
+	}
+	return true
+}
+
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
+
+// splitKeys splits what was typed into keys, keeping together the escape
+// sequence each arrow key sends.
+func splitKeys(typed string) []string {
+	var keys []string
+	for len(typed) > 0 {
+		_, n := utf8.DecodeRuneInString(typed)
+		if len(typed) >= 3 && typed[0] == '\x1b' && (typed[1] == '[' || typed[1] == 'O') && typed[2] >= 'A' && typed[2] <= 'D' {
+			// terminals in application cursor mode send ESC O instead of ESC [
+			keys = append(keys, "\x1b["+typed[2:3])
+			typed = typed[3:]
+			continue
+		}
+		keys = append(keys, typed[:n])
+		typed = typed[n:]
+	}
+	return keys
+}
+
+// readKeys waits for the next keys pressed. In raw mode it takes what has
+// been typed so far; otherwise, as when stty isn't there to turn raw mode
+// on, such as on Windows, it reads a line.
+func readKeys(in *bufio.Reader, raw bool) ([]string, error) {
+	if raw {
+		buf := make([]byte, 64)
+		n, err := in.Read(buf)
+		return splitKeys(string(buf[:n])), err
+	}
+	line, err := in.ReadString('\n')
+	line = strings.TrimRight(line, "\r\n")
+	if err != nil && line == "" {
+		return nil, err
+	}
+	return splitKeys(line), nil
+}
+
+func ViewCommand(args []string) {
+	flags := flag.NewFlagSet("view", flag.ExitOnError)
+	difficulty := flags.Int("difficulty", 2, "leave out entities that need a higher difficulty (0 to 2)")
+	printOnce := flags.Bool("print", false, "print the map once instead of viewing it interactively")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json view [flags] map")
+		fmt.Fprintln(flags.Output(), "Move the cursor with the arrow keys, hjkl or wasd, set the difficulty with 0, 1 or 2, and quit with q.")
+		fmt.Fprintln(flags.Output(), "Where stty can't turn on raw mode, as on Windows, type the keys and press Enter.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	m, err := loader.Load(flags.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+	v := &Viewer{Map: m, Difficulty: *difficulty, Rows: 24, Cols: 80}
+	v.CursorCol = m.PlayerStart.Position.X
+	v.CursorRow = int(m.Height) - 1 - m.PlayerStart.Position.Y
+	if size, err := stty("size"); err == nil {
+		fmt.Sscan(size, &v.Rows, &v.Cols)
+	}
+
+	if *printOnce {
+		v.Rows = int(m.Height) + 100
+		v.Cols = int(m.Width) * 2
+		fmt.Print(strings.Replace(strings.TrimPrefix(v.Draw(), "\x1b[H\x1b[2J"), "\r\n", "\n", -1))
+		return
+	}
+
+	state, err := stty("-g")
+	raw := err == nil
+	if raw {
+		_, err = stty("raw", "-echo")
+		raw = err == nil
+	}
+	if raw {
+		defer stty(state)
+		fmt.Print("\x1b[?25l")
+		defer fmt.Print("\x1b[?25h")
+	}
+	defer fmt.Print("\x1b[0m\x1b[H\x1b[2J")
+
+	in := bufio.NewReader(os.Stdin)
+	for {
+		fmt.Print(v.Draw())
+		if !raw {
+			fmt.Print("keys> ")
+		}
+		keys, err := readKeys(in, raw)
+		if err != nil {
+			return
+		}
+		for _, key := range keys {
+			if !v.Key(key) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitKeys(t *testing.T) {
+	for _, test := range []struct {
+		typed string
+		keys  []string
+	}{
+		{"", nil},
+		{"j", []string{"j"}},
+		{"jjj", []string{"j", "j", "j"}},
+		{"\x1b[A", []string{"\x1b[A"}},
+		{"\x1bOB", []string{"\x1b[B"}},
+		{"l\x1b[Ck\x1b[D", []string{"l", "\x1b[C", "k", "\x1b[D"}},
+		{"\x1b", []string{"\x1b"}},
+		{"\x1b[Z", []string{"\x1b", "[", "Z"}},
+		{"é2", []string{"é", "2"}},
+	} {
+		if keys := splitKeys(test.typed); !reflect.DeepEqual(keys, test.keys) {
+			t.Errorf("splitKeys(%q) = %q, want %q", test.typed, keys, test.keys)
+		}
+	}
+}
+
+// TestViewerKeys checks arrow keys move the cursor rather than quitting, as
+// the escape that starts them would on its own.
+func TestViewerKeys(t *testing.T) {
+	m := &JsonMap{Version: CurrentVersion}
+	m.SetLayout([][]LayoutDef{
+		{Wall("stone"), Wall("stone"), Wall("stone"), Wall("stone")},
+		{Wall("stone"), Floor(""), Floor(""), Wall("stone")},
+		{Wall("stone"), Floor(""), Floor(""), Wall("stone")},
+	})
+	v := &Viewer{Map: m, CursorRow: 0, CursorCol: 0}
+	for _, key := range splitKeys("\x1b[C\x1b[Bl\x1b[C") {
+		if !v.Key(key) {
+			t.Fatalf("%q closed the viewer", key)
+		}
+	}
+	if v.CursorRow != 1 || v.CursorCol != 3 {
+		t.Errorf("cursor at row %v, column %v, want row 1, column 3", v.CursorRow, v.CursorCol)
+	}
+	if v.Key("q") {
+		t.Error("q didn't close the viewer")
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+func init() {
+	Commands["warps"] = WarpsCommand
+}
+
+// WarpGraph is the directed graph of which levels warp gates lead to.
+type WarpGraph struct {
+	Levels     []WarpLevel `json:"levels"`
+	Warps      []Warp      `json:"warps"`
+	NoInbound  []string    `json:"noInbound"`  // levels no warp leads to
+	NoOutbound []string    `json:"noOutbound"` // levels with no warp out
+}
+
+type WarpLevel struct {
+	LevelNumber int    `json:"levelNumber"`
+	Name        string `json:"name"`
+	Title       string `json:"title"`
+}
+
+type Warp struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Kind      string `json:"kind"` // next, skip (jumps ahead past levels), back, or missing
+	Positions []Vec2 `json:"positions"`
+}
+
+func BuildWarpGraph(maps []*JsonMap) *WarpGraph {
+	g := &WarpGraph{NoInbound: []string{}, NoOutbound: []string{}}
+	levels := make(map[string]int)
+	for _, m := range maps {
+		name := MapName(m.LevelNumber, m.Title)
+		levels[name] = m.LevelNumber
+		g.Levels = append(g.Levels, WarpLevel{m.LevelNumber, name, m.Title})
+	}
+	inbound := make(map[string]bool)
+	for _, m := range maps {
+		from := MapName(m.LevelNumber, m.Title)
+		edges := make(map[string]*Warp)
+		var order []string
+		for _, e := range m.Entities {
+			to, ok := e.Value.(string)
+			if e.Type != "WarpGate" || !ok {
+				continue
+			}
+			if warp, ok := edges[to]; ok {
+				warp.Positions = append(warp.Positions, e.Position)
+				continue
+			}
+			warp := &Warp{From: from, To: to, Positions: []Vec2{e.Position}}
+			if levelNo, ok := levels[to]; !ok {
+				warp.Kind = "missing"
+			} else if levelNo == m.LevelNumber+1 {
+				warp.Kind = "next"
+			} else if levelNo > m.LevelNumber {
+				warp.Kind = "skip"
+			} else {
+				warp.Kind = "back"
+			}
+			edges[to] = warp
+			order = append(order, to)
+		}
+		if len(order) == 0 {
+			g.NoOutbound = append(g.NoOutbound, from)
+		}
+		for _, to := range order {
+			g.Warps = append(g.Warps, *edges[to])
+			if to != from {
+				inbound[to] = true
+			}
+		}
+	}
+	for _, level := range g.Levels {
+		if !inbound[level.Name] {
+			g.NoInbound = append(g.NoInbound, level.Name)
+		}
+	}
+	return g
+}
+
+func (g *WarpGraph) WriteDOT(w io.Writer) {
+	flagged := make(map[string]bool)
+	for _, name := range append(g.NoInbound, g.NoOutbound...) {
+		flagged[name] = true
+	}
+	fmt.Fprintln(w, "digraph warps {")
+	fmt.Fprintln(w, "\tnode [shape=box];")
+	for _, level := range g.Levels {
+		attrs := fmt.Sprintf("label=%q", fmt.Sprintf("%v: %v", level.LevelNumber, level.Title))
+		if flagged[level.Name] {
+			attrs += ", color=orange, style=bold"
+		}
+		fmt.Fprintf(w, "\t%q [%v];\n", level.Name, attrs)
+	}
+	styles := map[string]string{
+		"next":    "",
+		"skip":    " [style=dashed, color=blue]",
+		"back":    " [color=red]",
+		"missing": " [style=dotted, color=gray]",
+	}
+	for _, warp := range g.Warps {
+		fmt.Fprintf(w, "\t%q -> %q%v;\n", warp.From, warp.To, styles[warp.Kind])
+	}
+	fmt.Fprintln(w, "}")
+}
+
+func WarpsCommand(args []string) {
+	flags := flag.NewFlagSet("warps", flag.ExitOnError)
+	format := flags.String("format", "json", "output format: json or dot")
+	assets := flags.String("assets", "extracted_assets", "directory containing EGAGRAPH.C3D, EGAHEAD.C3D and EGADICT.C3D")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: map2json warps [flags] map-or-dir...")
+		fmt.Fprintln(flags.Output(), "Levels that no warp leads to or that have no warp out are reported on stderr.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	loader := MapLoader{Assets: *assets}
+	maps, err := loader.LoadAll(flags.Args())
+	if err != nil {
+		panic(err)
+	}
+	g := BuildWarpGraph(maps)
+	sort.Strings(g.NoInbound)
+	sort.Strings(g.NoOutbound)
+
+	switch *format {
+	case "json":
+		out, err := MarshalIndent(g)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(string(out))
+	case "dot":
+		g.WriteDOT(os.Stdout)
+	default:
+		panic(fmt.Errorf("unknown format %q", *format))
+	}
+	for _, name := range g.NoInbound {
+		fmt.Fprintf(os.Stderr, "%v: no warp leads here\n", name)
+	}
+	for _, name := range g.NoOutbound {
+		fmt.Fprintf(os.Stderr, "%v: no warp leads out\n", name)
+	}
+}